@@ -207,6 +207,9 @@ test-unit-fuzz: ## run fuzz tests
 	go test ./mod/payload/pkg/cache/... -fuzz=FuzzPayloadIDCacheConcurrency -fuzztime=${SHORT_FUZZ_TIME}
 	go test -fuzz=FuzzHashTreeRoot ./mod/primitives/pkg/merkle -fuzztime=${MEDIUM_FUZZ_TIME}
 
+test-simulation: ## run the in-process multi-node simulation tests
+	go test ./testing/simulation/... -v
+
 test-e2e: ## run e2e tests
 	@$(MAKE) build-docker VERSION=kurtosis-local test-e2e-no-build
 
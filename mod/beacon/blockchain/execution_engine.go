@@ -17,14 +17,14 @@
 // EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
-
 package blockchain
 
 import (
 	"context"
-	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // sendPostBlockFCU sends a forkchoice update to the execution client.
@@ -34,21 +34,25 @@ func (s *Service[
 	ctx context.Context,
 	st BeaconStateT,
 	blk BeaconBlockT,
-) {
+) error {
 	lph, err := st.GetLatestExecutionPayloadHeader()
 	if err != nil {
-		s.logger.Error(
-			"failed to get latest execution payload in postBlockProcess",
-			"error", err,
-		)
-		return
+		return errors.Wrap(err, "failed to get latest execution payload")
 	}
 
 	if !s.shouldBuildOptimisticPayloads() && s.lb.Enabled() {
-		s.sendNextFCUWithAttributes(ctx, st, blk, lph)
-	} else {
-		s.sendNextFCUWithoutAttributes(ctx, blk, lph)
+		err = s.sendNextFCUWithAttributes(ctx, st, blk, lph)
+		if err == nil {
+			return nil
+		}
+		// The head must move even if no payload is built for the next
+		// slot.
+		s.logger.Error(
+			"failed to send forkchoice update with attributes",
+			"error", err,
+		)
 	}
+	return s.sendFCUWithoutAttributes(ctx, blk.GetSlot(), lph)
 }
 
 // sendNextFCUWithAttributes sends a forkchoice update to the execution
@@ -61,53 +65,43 @@ func (s *Service[
 	st BeaconStateT,
 	blk BeaconBlockT,
 	lph ExecutionPayloadHeaderT,
-) {
+) error {
 	stCopy := st.Copy()
 	if _, err := s.sp.ProcessSlots(stCopy, blk.GetSlot()+1); err != nil {
-		s.logger.Error(
-			"failed to process slots in non-optimistic payload",
-			"error", err,
-		)
-		return
+		return errors.Wrap(err, "failed to process slots")
 	}
 
 	prevBlockRoot, err := blk.HashTreeRoot()
 	if err != nil {
-		s.logger.Error(
-			"failed to get block root in non-optimistic payload",
-			"error", err,
-		)
-		return
+		return errors.Wrap(err, "failed to get block root")
 	}
 
-	if _, err = s.lb.RequestPayloadAsync(
+	_, err = s.lb.RequestPayloadAsync(
 		ctx,
 		stCopy,
 		blk.GetSlot()+1,
-		s.calculateNextTimestamp(blk),
+		s.slotClock.PayloadTimestamp(
+			blk.GetSlot()+1,
+			blk.GetBody().GetExecutionPayload().GetTimestamp().Unwrap(),
+		),
 		prevBlockRoot,
 		lph.GetBlockHash(),
 		lph.GetParentHash(),
-	); err != nil {
-		s.logger.Error(
-			"failed to send forkchoice update with attributes in non-optimistic payload",
-			"error",
-			err,
-		)
-	}
+	)
+	return err
 }
 
-// sendNextFCUWithoutAttributes sends a forkchoice update to the
-// execution client without attributes.
+// sendFCUWithoutAttributes sends a forkchoice update to the execution
+// client without attributes, moving its head to the given execution payload.
 func (s *Service[
-	_, BeaconBlockT, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _,
 	ExecutionPayloadHeaderT, _, PayloadAttributesT, _,
-]) sendNextFCUWithoutAttributes(
+]) sendFCUWithoutAttributes(
 	ctx context.Context,
-	blk BeaconBlockT,
+	slot math.Slot,
 	lph ExecutionPayloadHeaderT,
-) {
-	if _, _, err := s.ee.NotifyForkchoiceUpdate(
+) error {
+	_, _, err := s.ee.NotifyForkchoiceUpdate(
 		ctx,
 		// TODO: Switch to New().
 		engineprimitives.
@@ -117,26 +111,8 @@ func (s *Service[
 				SafeBlockHash:      lph.GetParentHash(),
 				FinalizedBlockHash: lph.GetParentHash(),
 			},
-			s.cs.ActiveForkVersionForSlot(blk.GetSlot()),
+			s.cs.ActiveForkVersionForSlot(slot),
 		),
-	); err != nil {
-		s.logger.Error(
-			"failed to send forkchoice update without attributes",
-			"error", err,
-		)
-	}
-}
-
-// calculateNextTimestamp calculates the next timestamp for an execution
-// payload.
-//
-// TODO: This is hood and needs to be improved.
-func (s *Service[
-	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _,
-]) calculateNextTimestamp(blk BeaconBlockT) uint64 {
-	//#nosec:G701 // not an issue in practice.
-	return max(
-		uint64(time.Now().Unix()+int64(s.cs.TargetSecondsPerEth1Block())),
-		uint64(blk.GetBody().GetExecutionPayload().GetTimestamp()+1),
 	)
+	return err
 }
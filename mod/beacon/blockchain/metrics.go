@@ -21,9 +21,23 @@
 package blockchain
 
 import (
+	"context"
 	"time"
 
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// The reasons the failure counters are labelled with. They are kept to a
+// fixed set so that the number of series stays bounded.
+const (
+	reasonTimeout  = "timeout"
+	reasonCanceled = "canceled"
+	reasonSyncing  = "syncing"
+	reasonInvalid  = "invalid"
+	reasonOther    = "other"
 )
 
 // chainMetrics is a struct that contains metrics for the chain.
@@ -55,56 +69,40 @@ func (cm *chainMetrics) measureStateTransitionDuration(
 // markRebuildPayloadForRejectedBlockSuccess increments the counter for the
 // number of times
 // the validator successfully rebuilt the payload for a rejected block.
-func (cm *chainMetrics) markRebuildPayloadForRejectedBlockSuccess(
-	slot math.Slot,
-) {
+func (cm *chainMetrics) markRebuildPayloadForRejectedBlockSuccess() {
 	cm.sink.IncrementCounter(
 		"beacon_kit.blockchain.rebuild_payload_for_rejected_block_success",
-		"slot",
-		slot.Base10(),
 	)
 }
 
 // markRebuildPayloadForRejectedBlockFailure increments the counter for the
 // number of times
 // the validator failed to build an optimistic payload due to a failure.
-func (cm *chainMetrics) markRebuildPayloadForRejectedBlockFailure(
-	slot math.Slot,
-	err error,
-) {
+func (cm *chainMetrics) markRebuildPayloadForRejectedBlockFailure(err error) {
 	cm.sink.IncrementCounter(
 		"beacon_kit.blockchain.rebuild_payload_for_rejected_block_failure",
-		"slot",
-		slot.Base10(),
-		"error",
-		err.Error(),
+		"reason",
+		failureReason(err),
 	)
 }
 
 // markOptimisticPayloadBuildSuccess increments the counter for the number of
 // times
 // the validator successfully built an optimistic payload.
-func (cm *chainMetrics) markOptimisticPayloadBuildSuccess(slot math.Slot) {
+func (cm *chainMetrics) markOptimisticPayloadBuildSuccess() {
 	cm.sink.IncrementCounter(
 		"beacon_kit.blockchain.optimistic_payload_build_success",
-		"slot",
-		slot.Base10(),
 	)
 }
 
 // markOptimisticPayloadBuildFailure increments the counter for the number of
 // times
 // the validator failed to build an optimistic payload.
-func (cm *chainMetrics) markOptimisticPayloadBuildFailure(
-	slot math.Slot,
-	err error,
-) {
+func (cm *chainMetrics) markOptimisticPayloadBuildFailure(err error) {
 	cm.sink.IncrementCounter(
 		"beacon_kit.blockchain.optimistic_payload_build_failure",
-		"slot",
-		slot.Base10(),
-		"error",
-		err.Error(),
+		"reason",
+		failureReason(err),
 	)
 }
 
@@ -116,3 +114,91 @@ func (cm *chainMetrics) measureStateRootVerificationTime(start time.Time) {
 		"beacon_kit.blockchain.state_root_verification_duration", start,
 	)
 }
+
+// markHeadSlot sets the head slot gauge, labelled with the active fork.
+func (cm *chainMetrics) markHeadSlot(slot math.Slot, forkVersion uint32) {
+	cm.sink.SetGauge(
+		"beacon_kit.chain.head_slot",
+		int64(slot),
+		"fork",
+		version.Name(forkVersion),
+	)
+}
+
+// setPostBlockQueueDepth sets the gauge of the number of finalized blocks
+// whose post block work is pending.
+func (cm *chainMetrics) setPostBlockQueueDepth(depth int) {
+	cm.sink.SetGauge(
+		"beacon_kit.blockchain.post_block_queue_depth", int64(depth),
+	)
+}
+
+// measurePostBlockTaskDuration measures the time taken to run the post block
+// work of a finalized block.
+func (cm *chainMetrics) measurePostBlockTaskDuration(start time.Time) {
+	cm.sink.MeasureSince(
+		"beacon_kit.blockchain.post_block_task_duration", start,
+	)
+}
+
+// markPostBlockFCUSuperseded increments the counter for the number of times
+// the forkchoice update following a block was dropped for a later one.
+func (cm *chainMetrics) markPostBlockFCUSuperseded() {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.post_block_fcu_superseded",
+	)
+}
+
+// markPostBlockFinalizedDropped increments the counter for the number of
+// finalized blocks subscribers were not notified of, as they lagged behind.
+func (cm *chainMetrics) markPostBlockFinalizedDropped() {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.post_block_finalized_dropped",
+	)
+}
+
+// markPostBlockFCUFailure increments the counter for the number of times the
+// forkchoice update following a finalized block failed.
+func (cm *chainMetrics) markPostBlockFCUFailure(err error) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.post_block_fcu_failure",
+		"reason",
+		failureReason(err),
+	)
+}
+
+// markForkchoiceRetrySuccess increments the counter for the number of times
+// an owed forkchoice update was delivered on retry.
+func (cm *chainMetrics) markForkchoiceRetrySuccess() {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.fcu_retry_success",
+	)
+}
+
+// markForkchoiceRetryFailure increments the counter for the number of times
+// retrying an owed forkchoice update failed.
+func (cm *chainMetrics) markForkchoiceRetryFailure(err error) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.fcu_retry_failure",
+		"reason",
+		failureReason(err),
+	)
+}
+
+// failureReason returns the reason err is counted under.
+func failureReason(err error) string {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return reasonTimeout
+	case errors.Is(err, context.Canceled):
+		return reasonCanceled
+	case errors.Is(err, engineerrors.ErrSyncingPayloadStatus),
+		errors.Is(err, engineerrors.ErrAcceptedPayloadStatus):
+		return reasonSyncing
+	case errors.Is(err, engineerrors.ErrInvalidPayloadStatus),
+		errors.Is(err, engineerrors.ErrInvalidBlockHashPayloadStatus):
+		return reasonInvalid
+	default:
+		return reasonOther
+	}
+}
@@ -22,10 +22,8 @@ package blockchain
 
 import (
 	"context"
-	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // forceStartupHead sends a force head FCU to the execution client.
@@ -90,7 +88,6 @@ func (s *Service[
 		prevStateRoot common.Root
 		prevBlockRoot common.Root
 		lph           ExecutionPayloadHeaderT
-		slot          math.Slot
 	)
 
 	s.logger.Info("Rebuilding payload for rejected block ⏳ ")
@@ -133,12 +130,7 @@ func (s *Service[
 		st,
 		// We are rebuilding for the current slot.
 		stateSlot,
-		// TODO: this is hood as fuck.
-		max(
-			//#nosec:G701
-			uint64(time.Now().Unix()+1),
-			uint64((lph.GetTimestamp()+1)),
-		),
+		s.slotClock.PayloadTimestamp(stateSlot, lph.GetTimestamp().Unwrap()),
 		// We set the parent root to the previous block root.
 		prevBlockRoot,
 		// We set the head of our chain to the previous finalized block.
@@ -148,10 +140,10 @@ func (s *Service[
 		// and possibly should be made more explicit later on.
 		lph.GetParentHash(),
 	); err != nil {
-		s.metrics.markRebuildPayloadForRejectedBlockFailure(slot, err)
+		s.metrics.markRebuildPayloadForRejectedBlockFailure(err)
 		return err
 	}
-	s.metrics.markRebuildPayloadForRejectedBlockSuccess(slot)
+	s.metrics.markRebuildPayloadForRejectedBlockSuccess()
 	return nil
 }
 
@@ -231,12 +223,7 @@ func (s *Service[
 	if _, err = s.lb.RequestPayloadAsync(
 		ctx, st,
 		slot,
-		// TODO: this is hood as fuck.
-		max(
-			//#nosec:G701
-			uint64(time.Now().Unix()+int64(s.cs.TargetSecondsPerEth1Block())),
-			uint64((payload.GetTimestamp()+1)),
-		),
+		s.slotClock.PayloadTimestamp(slot, payload.GetTimestamp().Unwrap()),
 		// The previous block root is simply the root of the block we just
 		// processed.
 		blkRoot,
@@ -248,9 +235,9 @@ func (s *Service[
 		// just processed.
 		payload.GetParentHash(),
 	); err != nil {
-		s.metrics.markOptimisticPayloadBuildFailure(slot, err)
+		s.metrics.markOptimisticPayloadBuildFailure(err)
 		return err
 	}
-	s.metrics.markOptimisticPayloadBuildSuccess(slot)
+	s.metrics.markOptimisticPayloadBuildSuccess()
 	return nil
 }
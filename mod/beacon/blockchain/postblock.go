@@ -0,0 +1,313 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package blockchain
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// minFCURetryInterval is the lower bound of the interval at which owed
+	// forkchoice updates are retried.
+	minFCURetryInterval = time.Second
+	// maxPendingFinalized is the number of finalized blocks subscribers may
+	// lag behind before the oldest are dropped.
+	maxPendingFinalized = 64
+)
+
+// finalizedBlock is a finalized block subscribers are yet to be notified
+// of.
+type finalizedBlock[BeaconBlockT any] struct {
+	// ctx is the context the block was finalized with.
+	ctx context.Context
+	// blk is the finalized block.
+	blk BeaconBlockT
+}
+
+// postBlockTask is the forkchoice update left once a block is finalized.
+type postBlockTask[BeaconBlockT, BeaconStateT any] struct {
+	// ctx is the context the block was finalized with.
+	ctx context.Context
+	// st is the state after the block.
+	st BeaconStateT
+	// blk is the finalized block.
+	blk BeaconBlockT
+}
+
+// postBlockQueue holds the post block work the worker has yet to run.
+// Pushing to it never blocks. Finalized blocks are kept in order, so that
+// subscribers such as the deposit fetcher see each of them, up to
+// maxPendingFinalized of them, past which the oldest are dropped. Only the
+// forkchoice update of the latest block is kept, along with its state, as
+// it supersedes those of the blocks before it.
+type postBlockQueue[BeaconBlockT, BeaconStateT any] struct {
+	mu sync.Mutex
+	// finalized are the blocks subscribers are yet to be notified of.
+	finalized []finalizedBlock[BeaconBlockT]
+	// head is the task of the latest block whose forkchoice update is yet
+	// to be sent, if any.
+	head *postBlockTask[BeaconBlockT, BeaconStateT]
+	// ready is signalled when tasks are pushed.
+	ready chan struct{}
+}
+
+// newPostBlockQueue returns a new, empty post block queue.
+func newPostBlockQueue[
+	BeaconBlockT, BeaconStateT any,
+]() *postBlockQueue[BeaconBlockT, BeaconStateT] {
+	return &postBlockQueue[BeaconBlockT, BeaconStateT]{
+		finalized: make(
+			[]finalizedBlock[BeaconBlockT], 0, maxPendingFinalized,
+		),
+		ready: make(chan struct{}, 1),
+	}
+}
+
+// push queues the given task, replacing the pending forkchoice update, if
+// any, and dropping the oldest pending block if the queue is full. It
+// returns whether a forkchoice update was replaced, whether a block was
+// dropped and the number of blocks pending.
+func (q *postBlockQueue[BeaconBlockT, BeaconStateT]) push(
+	task *postBlockTask[BeaconBlockT, BeaconStateT],
+) (bool, bool, int) {
+	q.mu.Lock()
+	dropped := len(q.finalized) == maxPendingFinalized
+	if dropped {
+		// Shift in place, so the dropped block is released.
+		copy(q.finalized, q.finalized[1:])
+		q.finalized = q.finalized[:len(q.finalized)-1]
+	}
+	q.finalized = append(q.finalized, finalizedBlock[BeaconBlockT]{
+		ctx: task.ctx,
+		blk: task.blk,
+	})
+	superseded := q.head != nil
+	q.head = task
+	depth := len(q.finalized)
+	q.mu.Unlock()
+
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+	return superseded, dropped, depth
+}
+
+// pop takes the pending blocks, oldest first, and the pending forkchoice
+// update out of the queue.
+func (q *postBlockQueue[BeaconBlockT, BeaconStateT]) pop() (
+	[]finalizedBlock[BeaconBlockT],
+	*postBlockTask[BeaconBlockT, BeaconStateT],
+) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	finalized, head := q.finalized, q.head
+	q.finalized = make(
+		[]finalizedBlock[BeaconBlockT], 0, maxPendingFinalized,
+	)
+	q.head = nil
+	return finalized, head
+}
+
+// owedHead is the latest execution head the execution client has not been
+// moved to. It is retried until a forkchoice update is delivered for it or
+// for a later slot.
+type owedHead[ExecutionPayloadHeaderT any] struct {
+	mu   sync.Mutex
+	slot math.Slot
+	lph  ExecutionPayloadHeaderT
+	owed bool
+}
+
+// set records that the given head is owed for the given slot, unless a head
+// is already owed for a later slot.
+func (h *owedHead[ExecutionPayloadHeaderT]) set(
+	slot math.Slot,
+	lph ExecutionPayloadHeaderT,
+) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.owed && slot < h.slot {
+		return
+	}
+	h.slot, h.lph, h.owed = slot, lph, true
+}
+
+// get returns the owed head and its slot, if any.
+func (h *owedHead[ExecutionPayloadHeaderT]) get() (
+	math.Slot, ExecutionPayloadHeaderT, bool,
+) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.slot, h.lph, h.owed
+}
+
+// clear records that a forkchoice update was delivered for the given slot.
+func (h *owedHead[ExecutionPayloadHeaderT]) clear(slot math.Slot) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.owed && slot >= h.slot {
+		h.owed = false
+	}
+}
+
+// enqueuePostBlockTask queues the post block work of the given finalized
+// block without waiting for the worker. A forkchoice update still pending
+// for an earlier block is dropped, since only the latest head matters, and
+// so is the oldest block subscribers are yet to be notified of if too many
+// are.
+func (s *Service[
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _,
+]) enqueuePostBlockTask(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+) {
+	superseded, dropped, depth := s.postBlockTasks.push(
+		&postBlockTask[BeaconBlockT, BeaconStateT]{
+			ctx: ctx,
+			st:  st,
+			blk: blk,
+		},
+	)
+	if superseded {
+		s.metrics.markPostBlockFCUSuperseded()
+	}
+	if dropped {
+		s.logger.Warn(
+			"subscribers lag behind finalized blocks, dropped the oldest",
+			"pending", depth,
+		)
+		s.metrics.markPostBlockFinalizedDropped()
+	}
+	s.metrics.setPostBlockQueueDepth(depth)
+}
+
+// processPostBlockTasks runs the queued post block tasks until the context
+// is done. Subscribers are notified of the finalized blocks in order, then
+// the head of the execution client is moved to the latest of them. Any
+// owed forkchoice update is retried on every tick.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _,
+]) processPostBlockTasks(ctx context.Context) {
+	ticker := time.NewTicker(s.fcuRetryInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.postBlockTasks.ready:
+			finalized, head := s.postBlockTasks.pop()
+			s.metrics.setPostBlockQueueDepth(0)
+			for _, task := range finalized {
+				s.blockFeed.Send(asynctypes.NewEvent(
+					task.ctx, events.BeaconBlockFinalized, task.blk,
+				))
+			}
+			if head != nil {
+				s.runPostBlockTask(ctx, head)
+			}
+		case <-ticker.C:
+			s.retryOwedForkchoice(ctx)
+		}
+	}
+}
+
+// runPostBlockTask moves the head of the execution client to the block of
+// the given task. A failed forkchoice update is owed and retried on the
+// next tick.
+func (s *Service[
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _,
+]) runPostBlockTask(
+	ctx context.Context,
+	task *postBlockTask[BeaconBlockT, BeaconStateT],
+) {
+	startTime := time.Now()
+	defer s.metrics.measurePostBlockTaskDuration(startTime)
+
+	// The task outlives the block that queued it, so calls to the execution
+	// client are cancelled along with the service.
+	taskCtx, cancel := context.WithCancel(task.ctx)
+	defer cancel()
+	defer context.AfterFunc(ctx, cancel)()
+
+	slot := task.blk.GetSlot()
+	if err := s.sendPostBlockFCU(taskCtx, task.st, task.blk); err != nil {
+		s.logger.Error(
+			"failed to send post block forkchoice update, will retry",
+			"slot", slot.Base10(), "error", err,
+		)
+		s.metrics.markPostBlockFCUFailure(err)
+		lph, lphErr := task.st.GetLatestExecutionPayloadHeader()
+		if lphErr != nil {
+			s.logger.Error(
+				"failed to get latest execution payload for retry",
+				"slot", slot.Base10(), "error", lphErr,
+			)
+			return
+		}
+		s.owedHead.set(slot, lph)
+		return
+	}
+	s.owedHead.clear(slot)
+}
+
+// retryOwedForkchoice sends the owed forkchoice update, if any.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _,
+]) retryOwedForkchoice(ctx context.Context) {
+	slot, lph, owed := s.owedHead.get()
+	if !owed {
+		return
+	}
+
+	if err := s.sendFCUWithoutAttributes(ctx, slot, lph); err != nil {
+		s.logger.Error(
+			"failed to retry forkchoice update",
+			"slot", slot.Base10(), "error", err,
+		)
+		s.metrics.markForkchoiceRetryFailure(err)
+		return
+	}
+	s.logger.Info(
+		"Retried forkchoice update 🔁",
+		"slot", slot.Base10(), "head", lph.GetBlockHash(),
+	)
+	s.metrics.markForkchoiceRetrySuccess()
+	s.owedHead.clear(slot)
+}
+
+// fcuRetryInterval returns the interval at which owed forkchoice updates
+// are retried, one target block time.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _,
+]) fcuRetryInterval() time.Duration {
+	//#nosec:G701 // chain spec durations will never overflow an int64.
+	return max(
+		time.Duration(s.cs.TargetSecondsPerEth1Block())*time.Second,
+		minFCURetryInterval,
+	)
+}
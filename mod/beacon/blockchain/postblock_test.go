@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostBlockQueue(t *testing.T) {
+	q := newPostBlockQueue[int, string]()
+	finalized, head := q.pop()
+	require.Empty(t, finalized)
+	require.Nil(t, head)
+
+	// Only the forkchoice update of the latest block is kept.
+	for blk := range maxPendingFinalized {
+		superseded, dropped, depth := q.push(&postBlockTask[int, string]{
+			ctx: context.Background(), st: "state", blk: blk,
+		})
+		require.Equal(t, blk > 0, superseded)
+		require.False(t, dropped)
+		require.Equal(t, blk+1, depth)
+	}
+
+	// Past the limit, the oldest blocks are dropped.
+	for blk := maxPendingFinalized; blk < maxPendingFinalized+3; blk++ {
+		superseded, dropped, depth := q.push(&postBlockTask[int, string]{
+			ctx: context.Background(), st: "state", blk: blk,
+		})
+		require.True(t, superseded)
+		require.True(t, dropped)
+		require.Equal(t, maxPendingFinalized, depth)
+	}
+
+	finalized, head = q.pop()
+	require.Len(t, finalized, maxPendingFinalized)
+	for i, block := range finalized {
+		require.Equal(t, i+3, block.blk)
+	}
+	require.Equal(t, maxPendingFinalized+2, head.blk)
+	require.Equal(t, "state", head.st)
+
+	finalized, head = q.pop()
+	require.Empty(t, finalized)
+	require.Nil(t, head)
+}
@@ -24,8 +24,7 @@ import (
 	"context"
 	"time"
 
-	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
@@ -48,6 +47,11 @@ func (s *Service[
 	ctx context.Context,
 	genesisData GenesisT,
 ) (transition.ValidatorUpdates, error) {
+	// The genesis deposits are the first leaves of the deposit tree.
+	if err := s.ds.EnqueueDeposits(genesisData.GetDeposits()); err != nil {
+		return nil, err
+	}
+
 	return s.sp.InitializePreminedBeaconStateFromEth1(
 		s.sb.StateFromContext(ctx),
 		genesisData.GetDeposits(),
@@ -86,31 +90,44 @@ func (s *Service[
 	// which is completely fine. This means we were syncing from a
 	// bad peer, and we would likely AppHash anyways.
 	st := s.sb.StateFromContext(ctx)
+
+	// The execution client must be kept on the current head should the
+	// block be rejected.
+	preHead, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+
 	valUpdates, err := s.executeStateTransition(ctx, st, blk)
 	if err != nil {
+		s.owedHead.set(blk.GetSlot(), preHead)
 		return nil, err
 	}
 
 	// If the blobs needed to process the block are not available, we
 	// return an error. It is safe to use the slot off of the beacon block
-	// since it has been verified as correct already.
-	if !s.sb.AvailabilityStore(ctx).IsDataAvailable(
-		ctx, blk.GetSlot(), blk.GetBody(),
-	) {
+	// since it has been verified as correct already. Blobs are only
+	// required of blocks carrying their sidecars, as the availability of
+	// gossiped sidecars was voted on when the block was proposed, so that
+	// every node decides alike whatever its clock or how far behind it is.
+	if s.carriesSidecars(blk.GetSlot()) &&
+		!s.sb.AvailabilityStore(ctx).IsDataAvailable(
+			ctx, blk.GetSlot(), blk.GetBody(),
+		) {
+		s.owedHead.set(blk.GetSlot(), preHead)
 		return nil, ErrDataNotAvailable
 	}
+	s.metrics.markHeadSlot(
+		blk.GetSlot(), s.cs.ActiveForkVersionForSlot(blk.GetSlot()),
+	)
 
-	// If required, we want to forkchoice at the end of post
-	// block processing.
-	// TODO: this is hood as fuck.
-	// We won't send a fcu if the block is bad, should be addressed
-	// via ticker later.
-	go func() {
-		s.blockFeed.Send(
-			asynctypes.NewEvent(ctx, events.BeaconBlockFinalized, blk),
-		)
-		s.sendPostBlockFCU(ctx, st, blk)
-	}()
+	// The deposits processed by a finalized block are no longer needed
+	// to build proofs.
+	s.finalizeDeposits(st)
+
+	// Notifying subscribers and the forkchoice update are left to the post
+	// block worker, off the FinalizeBlock critical path.
+	s.enqueuePostBlockTask(ctx, st, blk)
 
 	return valUpdates, nil
 }
@@ -160,3 +177,50 @@ func (s *Service[
 	)
 	return valUpdates, err
 }
+
+// finalizeDeposits finalizes the deposit tree up to the deposits processed
+// by the given state.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+	GenesisT,
+	PayloadAttributesT,
+	_,
+]) finalizeDeposits(st BeaconStateT) {
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		s.logger.Error("failed to get deposit index", "error", err)
+		return
+	}
+	header, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		s.logger.Error("failed to get latest payload header", "error", err)
+		return
+	}
+	if err = s.ds.FinalizeDeposits(
+		depositIndex, header.GetBlockHash(), header.GetNumber(),
+	); err != nil {
+		s.logger.Error(
+			"failed to finalize deposit tree",
+			"deposit_index", depositIndex, "error", err,
+		)
+	}
+}
+
+// carriesSidecars returns whether the proposal of the given slot carries its
+// blob sidecars, rather than their root from the fork set by the chain spec
+// on. Roots are only carried in transaction envelopes.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _,
+]) carriesSidecars(slot math.Slot) bool {
+	epoch := s.cs.SlotToEpoch(slot)
+	return epoch < s.cs.BlobSidecarsRootForkEpoch() ||
+		epoch < s.cs.TxEnvelopeForkEpoch()
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// CanRollback returns an error if the given stores cannot be rewound to the
+// beacon state of the given context, which holds the state rolled back to.
+func (s *Service[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _,
+]) CanRollback(
+	ctx context.Context,
+	stores StoreRewinder[BeaconStateT],
+) error {
+	return stores.CanRewind(s.sb.StateFromContext(ctx))
+}
+
+// Rollback rewinds the given stores to the beacon state of the given
+// context, which holds the state rolled back to, and moves the head of the
+// execution client back to its latest execution payload.
+func (s *Service[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _,
+]) Rollback(
+	ctx context.Context,
+	stores StoreRewinder[BeaconStateT],
+) error {
+	st := s.sb.StateFromContext(ctx)
+	if err := stores.Rewind(st); err != nil {
+		return err
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return err
+	}
+	if err = s.sendFCUWithoutAttributes(ctx, slot, lph); err != nil {
+		return errors.Wrap(
+			err, "failed to move execution client head back",
+		)
+	}
+
+	s.logger.Info(
+		"Rolled back beacon chain",
+		"slot", slot,
+		"execution_head", lph.GetBlockHash(),
+	)
+	return nil
+}
@@ -59,6 +59,8 @@ type Service[
 		BeaconStateT,
 		BlobSidecarsT,
 	]
+	// ds is the deposit store holding the deposit tree.
+	ds DepositStore[DepositT]
 	// logger is used for logging messages in the service.
 	logger log.Logger[any]
 	// cs holds the chain specifications.
@@ -81,6 +83,8 @@ type Service[
 		DepositT,
 		ExecutionPayloadHeaderT,
 	]
+	// slotClock maps slots to time.
+	slotClock SlotClock
 	// metrics is the metrics for the service.
 	metrics *chainMetrics
 	// blockFeed is the event feed for new blocks.
@@ -90,6 +94,12 @@ type Service[
 	optimisticPayloadBuilds bool
 	// forceStartupSyncOnce is used to force a sync of the startup head.
 	forceStartupSyncOnce *sync.Once
+	// postBlockTasks is the queue of work left once blocks are finalized.
+	postBlockTasks *postBlockQueue[BeaconBlockT, BeaconStateT]
+	// owedHead is the execution head a forkchoice update is owed for.
+	owedHead *owedHead[ExecutionPayloadHeaderT]
+	// workers tracks the post block worker.
+	workers *sync.WaitGroup
 }
 
 // NewService creates a new validator service.
@@ -120,6 +130,7 @@ func NewService[
 		BeaconStateT,
 		BlobSidecarsT,
 	],
+	ds DepositStore[DepositT],
 	logger log.Logger[any],
 	cs common.ChainSpec,
 
@@ -136,6 +147,7 @@ func NewService[
 		DepositT,
 		ExecutionPayloadHeaderT,
 	],
+	slotClock SlotClock,
 	ts TelemetrySink,
 	blockFeed EventFeed[*asynctypes.Event[BeaconBlockT]],
 	optimisticPayloadBuilds bool,
@@ -150,16 +162,23 @@ func NewService[
 		ExecutionPayloadHeaderT, GenesisT, PayloadAttributesT, WithdrawalT,
 	]{
 		sb:                      sb,
+		ds:                      ds,
 		logger:                  logger,
 		cs:                      cs,
 		ee:                      ee,
 		lb:                      lb,
 		bp:                      bp,
 		sp:                      sp,
+		slotClock:               slotClock,
 		metrics:                 newChainMetrics(ts),
 		blockFeed:               blockFeed,
 		optimisticPayloadBuilds: optimisticPayloadBuilds,
 		forceStartupSyncOnce:    new(sync.Once),
+		postBlockTasks: newPostBlockQueue[
+			BeaconBlockT, BeaconStateT,
+		](),
+		owedHead: new(owedHead[ExecutionPayloadHeaderT]),
+		workers:  new(sync.WaitGroup),
 	}
 }
 
@@ -170,10 +189,24 @@ func (s *Service[
 	return "blockchain"
 }
 
+// Start spawns the worker running the post block tasks.
 func (s *Service[
 	_, _, _, _, _, _, _, _, _, _, _, _,
 ]) Start(
-	context.Context,
+	ctx context.Context,
 ) error {
+	s.workers.Add(1)
+	go func() {
+		defer s.workers.Done()
+		s.processPostBlockTasks(ctx)
+	}()
 	return nil
 }
+
+// Wait blocks until the post block worker returns, once the context given
+// to Start is done.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _,
+]) Wait() {
+	s.workers.Wait()
+}
@@ -123,6 +123,19 @@ type EventFeed[EventT any] interface {
 	Send(event EventT) int
 }
 
+// DepositStore defines the interface for the deposit store.
+type DepositStore[DepositT any] interface {
+	// EnqueueDeposits adds a list of deposits to the deposit store.
+	EnqueueDeposits(deposits []DepositT) error
+	// FinalizeDeposits finalizes the first count deposits of the deposit
+	// tree at the given execution block.
+	FinalizeDeposits(
+		count uint64,
+		executionBlockHash common.ExecutionHash,
+		executionBlockHeight math.U64,
+	) error
+}
+
 // ExecutionPayload is the interface for the execution payload.
 type ExecutionPayload interface {
 	ExecutionPayloadHeader
@@ -136,6 +149,8 @@ type ExecutionPayloadHeader interface {
 	GetBlockHash() common.ExecutionHash
 	// GetParentHash returns the parent hash.
 	GetParentHash() common.ExecutionHash
+	// GetNumber returns the block number.
+	GetNumber() math.U64
 }
 
 // Genesis is the interface for the genesis.
@@ -190,12 +205,22 @@ type ReadOnlyBeaconState[
 		ExecutionPayloadHeaderT,
 		error,
 	)
+	// GetEth1DepositIndex returns the index of the next deposit to be
+	// processed.
+	GetEth1DepositIndex() (uint64, error)
 	// GetSlot retrieves the current slot of the beacon state.
 	GetSlot() (math.Slot, error)
 	// HashTreeRoot returns the hash tree root of the beacon state.
 	HashTreeRoot() ([32]byte, error)
 }
 
+// SlotClock maps slots to time.
+type SlotClock interface {
+	// PayloadTimestamp returns the timestamp to build the execution payload
+	// of the given slot with.
+	PayloadTimestamp(slot math.Slot, parentTimestamp uint64) uint64
+}
+
 // StateProcessor defines the interface for processing various state transitions
 // in the beacon chain.
 type StateProcessor[
@@ -241,12 +266,25 @@ type StorageBackend[
 	StateFromContext(context.Context) BeaconStateT
 }
 
+// StoreRewinder rewinds the stores kept alongside the beacon state.
+type StoreRewinder[BeaconStateT any] interface {
+	// CanRewind returns an error if any of the stores cannot be rewound to
+	// the given beacon state.
+	CanRewind(BeaconStateT) error
+	// Rewind rewinds the stores to the given beacon state.
+	Rewind(BeaconStateT) error
+}
+
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
 	// IncrementCounter increments the counter identified by
 	// the provided key.
 	IncrementCounter(key string, args ...string)
 
+	// SetGauge sets the gauge identified by the provided key to the
+	// provided value.
+	SetGauge(key string, value int64, args ...string)
+
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
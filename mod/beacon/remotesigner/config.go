@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package remotesigner
+
+import "time"
+
+const (
+	// defaultURL is the default URL of the remote signer, which is the
+	// default address of Web3Signer.
+	defaultURL = "http://localhost:9000"
+	// defaultTimeout is the default timeout of each request to the remote
+	// signer.
+	defaultTimeout = 1 * time.Second
+	// defaultMaxRetries is the default number of times a failed request is
+	// retried.
+	defaultMaxRetries = 2
+	// defaultRetryInterval is the default time waited between two attempts
+	// of a request.
+	defaultRetryInterval = 100 * time.Millisecond
+)
+
+// Config is the configuration for the remote signer.
+//
+//nolint:lll // struct tags.
+type Config struct {
+	// Enabled determines if the validator key is held by the remote signer
+	// instead of the node.
+	Enabled bool `mapstructure:"enabled"`
+	// URL is the URL of the remote signer.
+	URL string `mapstructure:"url"`
+	// PublicKey is the hex encoded public key to sign with. It may be left
+	// empty if the remote signer holds a single key.
+	PublicKey string `mapstructure:"public-key"`
+	// Timeout is the timeout of each request to the remote signer.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// MaxRetries is the number of times a failed request is retried.
+	MaxRetries uint64 `mapstructure:"max-retries"`
+	// RetryInterval is the time waited between two attempts of a request.
+	RetryInterval time.Duration `mapstructure:"retry-interval"`
+	// CACertFile is the path to the certificate of the authority the
+	// certificate of the remote signer is verified against. The system
+	// roots are used if it is empty.
+	CACertFile string `mapstructure:"ca-cert-file"`
+	// ClientCertFile and ClientKeyFile are the paths to the certificate and
+	// key the node authenticates itself with to the remote signer.
+	ClientCertFile string `mapstructure:"client-cert-file"`
+	ClientKeyFile  string `mapstructure:"client-key-file"`
+}
+
+// DefaultConfig returns the default remote signer configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:       false,
+		URL:           defaultURL,
+		Timeout:       defaultTimeout,
+		MaxRetries:    defaultMaxRetries,
+		RetryInterval: defaultRetryInterval,
+	}
+}
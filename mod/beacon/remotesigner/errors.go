@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package remotesigner
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrUntypedSigningRequest is returned when the remote signer is asked
+	// to sign a bare message, which it refuses to do without knowing what
+	// the message is.
+	ErrUntypedSigningRequest = errors.New(
+		"remote signer only signs typed requests",
+	)
+
+	// ErrUnsupportedSigningType is returned when the type of a signing
+	// request is not supported by the remote signer.
+	ErrUnsupportedSigningType = errors.New("unsupported signing type")
+
+	// ErrMissingSigningData is returned when a signing request lacks the
+	// object required by its type.
+	ErrMissingSigningData = errors.New("signing request is missing its data")
+
+	// ErrUnexpectedStatus is returned when the remote signer answers with
+	// an unexpected HTTP status.
+	ErrUnexpectedStatus = errors.New("unexpected remote signer status")
+
+	// ErrNoPublicKey is returned when the remote signer holds no key.
+	ErrNoPublicKey = errors.New("remote signer holds no public key")
+
+	// ErrAmbiguousPublicKey is returned when the remote signer holds
+	// several keys and none is configured.
+	ErrAmbiguousPublicKey = errors.New(
+		"remote signer holds several public keys, one must be configured",
+	)
+
+	// ErrPublicKeyNotFound is returned when the configured key is not held
+	// by the remote signer.
+	ErrPublicKeyNotFound = errors.New(
+		"public key not found on the remote signer",
+	)
+
+	// ErrInvalidSignature is returned when a signature does not verify.
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	// ErrInvalidCACert is returned when the CA certificate file holds no
+	// PEM encoded certificate.
+	ErrInvalidCACert = errors.New("invalid CA certificate")
+)
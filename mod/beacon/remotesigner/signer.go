@@ -0,0 +1,289 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/itsdevbear/comet-bls12-381/bls/blst"
+)
+
+// maxErrorBodySize bounds the part of an error response that is read into
+// the returned error.
+const maxErrorBodySize = 1024
+
+// RemoteSigner is a BLS signer whose key is held by a remote signing
+// service exposing the Web3Signer eth2 API, so that the key never has to
+// be stored on the node host. It only signs typed requests, which lets the
+// remote signer apply its own policies, such as slashing protection, to
+// what it signs.
+type RemoteSigner struct {
+	cfg Config
+	// url is the base URL of the remote signer.
+	url string
+	// httpClient is the HTTP client requests are sent with.
+	httpClient *http.Client
+	// pubkey is the public key signed with, cached once resolved.
+	pubkey crypto.BLSPubkey
+}
+
+// New creates a new remote signer from the given configuration. If no
+// public key is configured, the remote signer is asked for its keys and
+// must hold exactly one.
+func New(cfg Config, opts ...Option) (*RemoteSigner, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &RemoteSigner{
+		cfg: cfg,
+		url: strings.TrimSuffix(u.String(), "/"),
+	}
+	for _, opt := range opts {
+		if err = opt(s); err != nil {
+			return nil, err
+		}
+	}
+	if s.httpClient == nil {
+		if s.httpClient, err = newHTTPClient(cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.PublicKey != "" {
+		if err = s.pubkey.UnmarshalText([]byte(cfg.PublicKey)); err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+
+	pubkeys, err := s.PublicKeys(context.Background())
+	switch {
+	case err != nil:
+		return nil, err
+	case len(pubkeys) == 0:
+		return nil, ErrNoPublicKey
+	case len(pubkeys) > 1:
+		return nil, ErrAmbiguousPublicKey
+	}
+	s.pubkey = pubkeys[0]
+	return s, nil
+}
+
+// PublicKey returns the public key of the signer.
+func (s *RemoteSigner) PublicKey() crypto.BLSPubkey {
+	return s.pubkey
+}
+
+// Sign refuses to sign the bare message, see SignRequest.
+func (s *RemoteSigner) Sign([]byte) (crypto.BLSSignature, error) {
+	return crypto.BLSSignature{}, ErrUntypedSigningRequest
+}
+
+// SignRequest asks the remote signer to sign the request and verifies the
+// returned signature against the public key of the signer.
+func (s *RemoteSigner) SignRequest(
+	req *crypto.SigningRequest,
+) (crypto.BLSSignature, error) {
+	body, err := NewSignBody(req)
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+
+	resp, err := s.do(
+		context.Background(), http.MethodPost,
+		SignPath+"/"+s.pubkey.String(), body,
+	)
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+
+	sig, err := decodeSignature(resp)
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	if err = s.VerifySignature(
+		s.pubkey, req.SigningRoot[:], sig,
+	); err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	return sig, nil
+}
+
+// VerifySignature verifies a signature against a message and public key.
+func (s *RemoteSigner) VerifySignature(
+	pubKey crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	pubkey, err := blst.PublicKeyFromBytes(pubKey[:])
+	if err != nil {
+		return err
+	}
+
+	sig, err := blst.SignatureFromBytes(signature[:])
+	if err != nil {
+		return err
+	}
+
+	if !sig.Verify(pubkey, msg) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// PublicKeys returns the public keys held by the remote signer.
+func (s *RemoteSigner) PublicKeys(
+	ctx context.Context,
+) ([]crypto.BLSPubkey, error) {
+	resp, err := s.do(ctx, http.MethodGet, PublicKeysPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var pubkeys []crypto.BLSPubkey
+	if err = json.Unmarshal(resp, &pubkeys); err != nil {
+		return nil, err
+	}
+	if s.cfg.PublicKey == "" {
+		return pubkeys, nil
+	}
+	for _, pubkey := range pubkeys {
+		if pubkey == s.pubkey {
+			return pubkeys, nil
+		}
+	}
+	return nil, errors.Wrapf(ErrPublicKeyNotFound, "%s", s.pubkey)
+}
+
+// Upcheck checks that the remote signer is up.
+func (s *RemoteSigner) Upcheck(ctx context.Context) error {
+	_, err := s.do(ctx, http.MethodGet, UpcheckPath, nil)
+	return err
+}
+
+// do sends a request to the remote signer and returns the body of the
+// response, retrying up to MaxRetries times on network errors and server
+// errors. Requests the remote signer refuses, such as those violating its
+// slashing protection, are not retried.
+func (s *RemoteSigner) do(
+	ctx context.Context,
+	method string,
+	path string,
+	payload any,
+) ([]byte, error) {
+	var reqBody []byte
+	if payload != nil {
+		var err error
+		if reqBody, err = json.Marshal(payload); err != nil {
+			return nil, err
+		}
+	}
+
+	for attempt := uint64(0); ; attempt++ {
+		body, retry, err := s.attempt(ctx, method, path, reqBody)
+		if err == nil || !retry || attempt >= s.cfg.MaxRetries {
+			return body, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(s.cfg.RetryInterval):
+		}
+	}
+}
+
+// attempt sends a request to the remote signer once. It returns whether
+// the request is worth retrying if it fails.
+func (s *RemoteSigner) attempt(
+	ctx context.Context,
+	method string,
+	path string,
+	payload []byte,
+) ([]byte, bool, error) {
+	if s.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.cfg.Timeout)
+		defer cancel()
+	}
+
+	var reqBody io.Reader
+	if payload != nil {
+		reqBody = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, s.url+path, reqBody)
+	if err != nil {
+		return nil, false, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, true, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, true, err
+	}
+	if resp.StatusCode == http.StatusOK {
+		return body, false, nil
+	}
+
+	if len(body) > maxErrorBodySize {
+		body = body[:maxErrorBodySize]
+	}
+	return nil, resp.StatusCode >= http.StatusInternalServerError,
+		errors.Wrapf(
+			ErrUnexpectedStatus, "%s %s: %d %s",
+			method, path, resp.StatusCode, strings.TrimSpace(string(body)),
+		)
+}
+
+// decodeSignature decodes the response of the signing endpoint, which is
+// either a JSON object or the bare hex encoded signature.
+func decodeSignature(body []byte) (crypto.BLSSignature, error) {
+	body = bytes.TrimSpace(body)
+	if bytes.HasPrefix(body, []byte("{")) {
+		var resp SignResponse
+		err := json.Unmarshal(body, &resp)
+		return resp.Signature, err
+	}
+
+	var sig crypto.BLSSignature
+	err := sig.UnmarshalText(body)
+	return sig, err
+}
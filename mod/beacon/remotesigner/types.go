@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package remotesigner
+
+import (
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+const (
+	// SignPath is the path of the signing endpoint of the remote signer,
+	// followed by the public key to sign with.
+	SignPath = "/api/v1/eth2/sign"
+	// PublicKeysPath is the path of the endpoint listing the public keys
+	// held by the remote signer.
+	PublicKeysPath = "/api/v1/eth2/publicKeys"
+	// UpcheckPath is the path of the health endpoint of the remote signer.
+	UpcheckPath = "/upcheck"
+)
+
+// SignBody is the body of a request to the signing endpoint, in the format
+// of the Web3Signer eth2 API.
+type SignBody struct {
+	// Type is the type of the signed object.
+	Type crypto.SigningType `json:"type"`
+	// SigningRoot is the signing root of the object.
+	SigningRoot bytes.B32 `json:"signingRoot"`
+	// ForkInfo is the fork the signing domain is computed for.
+	ForkInfo *ForkInfo `json:"fork_info,omitempty"`
+	// RandaoReveal is set for requests of type RANDAO_REVEAL.
+	RandaoReveal *RandaoReveal `json:"randao_reveal,omitempty"`
+	// BeaconBlock is set for requests of type BLOCK_V2.
+	BeaconBlock *BeaconBlock `json:"beacon_block,omitempty"`
+	// Deposit is set for requests of type DEPOSIT.
+	Deposit *Deposit `json:"deposit,omitempty"`
+	// ValidatorRegistration is set for requests of type
+	// VALIDATOR_REGISTRATION.
+	ValidatorRegistration *ValidatorRegistration `json:"validator_registration,omitempty"` //nolint:lll // struct tags.
+}
+
+// ForkInfo is the fork a signing domain is computed for.
+type ForkInfo struct {
+	// Fork is the fork itself.
+	Fork Fork `json:"fork"`
+	// GenesisValidatorsRoot is the genesis validators root of the chain.
+	GenesisValidatorsRoot bytes.B32 `json:"genesis_validators_root"`
+}
+
+// Fork is a fork of the chain.
+type Fork struct {
+	// PreviousVersion is the version of the previous fork.
+	PreviousVersion bytes.B4 `json:"previous_version"`
+	// CurrentVersion is the version of the current fork.
+	CurrentVersion bytes.B4 `json:"current_version"`
+	// Epoch is the epoch of the current fork.
+	Epoch uint64 `json:"epoch,string"`
+}
+
+// RandaoReveal is the object signed by a randao reveal.
+type RandaoReveal struct {
+	// Epoch is the epoch of the reveal.
+	Epoch uint64 `json:"epoch,string"`
+}
+
+// BeaconBlock is a versioned beacon block header.
+type BeaconBlock struct {
+	// Version is the uppercase name of the fork of the block.
+	Version string `json:"version"`
+	// BlockHeader is the header of the block.
+	BlockHeader *BlockHeader `json:"block_header"`
+}
+
+// BlockHeader is the header of a beacon block.
+type BlockHeader struct {
+	// Slot is the slot of the block.
+	Slot uint64 `json:"slot,string"`
+	// ProposerIndex is the index of the proposer of the block.
+	ProposerIndex uint64 `json:"proposer_index,string"`
+	// ParentRoot is the root of the parent block.
+	ParentRoot bytes.B32 `json:"parent_root"`
+	// StateRoot is the root of the post state of the block.
+	StateRoot bytes.B32 `json:"state_root"`
+	// BodyRoot is the root of the body of the block.
+	BodyRoot bytes.B32 `json:"body_root"`
+}
+
+// Deposit is a deposit message.
+type Deposit struct {
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+	// WithdrawalCredentials are the withdrawal credentials of the
+	// validator.
+	WithdrawalCredentials bytes.B32 `json:"withdrawal_credentials"`
+	// Amount is the deposited amount, in gwei.
+	Amount uint64 `json:"amount,string"`
+	// GenesisForkVersion is the fork version the deposit domain is
+	// computed for.
+	GenesisForkVersion bytes.B4 `json:"genesis_fork_version"`
+}
+
+// ValidatorRegistration is a registration of a validator with external
+// block builders.
+type ValidatorRegistration struct {
+	// FeeRecipient is the address the validator wants to be paid at.
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+	// GasLimit is the gas limit the validator wants blocks built with.
+	GasLimit uint64 `json:"gas_limit,string"`
+	// Timestamp is the time of the registration.
+	Timestamp uint64 `json:"timestamp,string"`
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+}
+
+// SignResponse is the JSON response of the signing endpoint.
+type SignResponse struct {
+	// Signature is the signature of the signing root.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// NewSignBody returns the body of the request to the signing endpoint for
+// the given signing request.
+func NewSignBody(req *crypto.SigningRequest) (*SignBody, error) {
+	body := &SignBody{
+		Type:        req.Type,
+		SigningRoot: req.SigningRoot,
+	}
+	if req.ForkInfo != nil {
+		body.ForkInfo = &ForkInfo{
+			Fork: Fork{
+				PreviousVersion: req.ForkInfo.PreviousVersion,
+				CurrentVersion:  req.ForkInfo.CurrentVersion,
+				Epoch:           req.ForkInfo.Epoch,
+			},
+			GenesisValidatorsRoot: req.ForkInfo.GenesisValidatorsRoot,
+		}
+	}
+
+	var missing bool
+	switch req.Type {
+	case crypto.SigningTypeRandaoReveal:
+		if missing = req.RandaoReveal == nil || req.ForkInfo == nil; !missing {
+			body.RandaoReveal = &RandaoReveal{Epoch: req.RandaoReveal.Epoch}
+		}
+	case crypto.SigningTypeBlock:
+		if missing = req.Block == nil || req.ForkInfo == nil; !missing {
+			body.BeaconBlock = &BeaconBlock{
+				Version: strings.ToUpper(version.Name(
+					version.ToUint32(req.ForkInfo.CurrentVersion),
+				)),
+				BlockHeader: &BlockHeader{
+					Slot:          req.Block.Slot,
+					ProposerIndex: req.Block.ProposerIndex,
+					ParentRoot:    req.Block.ParentRoot,
+					StateRoot:     req.Block.StateRoot,
+					BodyRoot:      req.Block.BodyRoot,
+				},
+			}
+		}
+	case crypto.SigningTypeDeposit:
+		if missing = req.Deposit == nil; !missing {
+			body.Deposit = &Deposit{
+				Pubkey:                req.Deposit.Pubkey,
+				WithdrawalCredentials: req.Deposit.WithdrawalCredentials,
+				Amount:                req.Deposit.Amount,
+				GenesisForkVersion:    req.Deposit.GenesisForkVersion,
+			}
+		}
+	case crypto.SigningTypeValidatorRegistration:
+		if missing = req.ValidatorRegistration == nil; !missing {
+			body.ValidatorRegistration = &ValidatorRegistration{
+				FeeRecipient: req.ValidatorRegistration.FeeRecipient,
+				GasLimit:     req.ValidatorRegistration.GasLimit,
+				Timestamp:    req.ValidatorRegistration.Timestamp,
+				Pubkey:       req.ValidatorRegistration.Pubkey,
+			}
+		}
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedSigningType, "%q", req.Type)
+	}
+
+	if missing {
+		return nil, errors.Wrapf(ErrMissingSigningData, "%q", req.Type)
+	}
+	return body, nil
+}
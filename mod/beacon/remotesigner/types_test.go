@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package remotesigner_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/beacon/remotesigner"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSignBody(t *testing.T) {
+	body, err := remotesigner.NewSignBody(&crypto.SigningRequest{
+		Type:         crypto.SigningTypeRandaoReveal,
+		SigningRoot:  bytes.B32{0x01},
+		ForkInfo:     crypto.NewForkInfo(bytes.B4{0x04}, 5, bytes.B32{0x02}),
+		RandaoReveal: &crypto.RandaoRevealData{Epoch: 7},
+	})
+	require.NoError(t, err)
+
+	bz, err := json.Marshal(body)
+	require.NoError(t, err)
+	var raw map[string]any
+	require.NoError(t, json.Unmarshal(bz, &raw))
+	require.Equal(t, "RANDAO_REVEAL", raw["type"])
+	require.Equal(t, map[string]any{"epoch": "7"}, raw["randao_reveal"])
+	require.Equal(t, map[string]any{
+		"fork": map[string]any{
+			"previous_version": "0x04000000",
+			"current_version":  "0x04000000",
+			"epoch":            "5",
+		},
+		"genesis_validators_root": bytes.B32{0x02}.String(),
+	}, raw["fork_info"])
+	require.NotContains(t, raw, "beacon_block")
+}
+
+func TestNewSignBody_Invalid(t *testing.T) {
+	_, err := remotesigner.NewSignBody(&crypto.SigningRequest{
+		Type: crypto.SigningTypeBlock,
+	})
+	require.ErrorIs(t, err, remotesigner.ErrMissingSigningData)
+
+	_, err = remotesigner.NewSignBody(&crypto.SigningRequest{
+		Type: "AGGREGATE_AND_PROOF",
+	})
+	require.ErrorIs(t, err, remotesigner.ErrUnsupportedSigningType)
+}
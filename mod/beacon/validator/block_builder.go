@@ -30,8 +30,11 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/telemetry"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -43,15 +46,23 @@ func (s *Service[
 ]) buildBlockAndSidecars(
 	ctx context.Context,
 	requestedSlot math.Slot,
-) (BeaconBlockT, BlobSidecarsT, error) {
+) (_ BeaconBlockT, _ BlobSidecarsT, err error) {
 	var (
 		blk       BeaconBlockT
 		sidecars  BlobSidecarsT
 		startTime = time.Now()
-		g, _      = errgroup.WithContext(ctx)
 	)
 
 	defer s.metrics.measureRequestBlockForProposalTime(startTime)
+	ctx, span := s.tracer.Start(
+		ctx, "BuildBlockAndSidecars",
+		trace.WithAttributes(attribute.Int64(
+			"slot", int64(requestedSlot.Unwrap()),
+		)),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	g, _ := errgroup.WithContext(ctx)
 	s.logger.Info(
 		"Requesting beacon block assembly 🙈",
 		"slot", requestedSlot.Base10(),
@@ -66,7 +77,10 @@ func (s *Service[
 
 	// Prepare the state such that it is ready to build a block for
 	// the requested slot
-	if _, err := s.stateProcessor.ProcessSlots(st, requestedSlot); err != nil {
+	_, processSlotsSpan := s.tracer.Start(ctx, "ProcessSlots")
+	_, err = s.stateProcessor.ProcessSlots(st, requestedSlot)
+	telemetry.EndSpan(processSlotsSpan, err)
+	if err != nil {
 		return blk, sidecars, err
 	}
 
@@ -99,6 +113,9 @@ func (s *Service[
 		return blk, sidecars, err
 	}
 
+	// Swap in the payload of the remote builder if it pays more.
+	envelope = s.retrieveRemotePayload(ctx, st, blk, envelope)
+
 	// Produce blob sidecars, we produce them in parallel to computing the state
 	// root as an optimization.
 	//
@@ -106,10 +123,18 @@ func (s *Service[
 	// functions
 	// without giving up the parallelization benefits.
 	g.Go(func() error {
-		sidecars, err = s.blobFactory.BuildSidecars(
+		_, sidecarsSpan := s.tracer.Start(
+			ctx, "BuildSidecars",
+			trace.WithAttributes(attribute.Int(
+				"num_blobs", len(envelope.GetBlobsBundle().GetBlobs()),
+			)),
+		)
+		var sidecarsErr error
+		sidecars, sidecarsErr = s.blobFactory.BuildSidecars(
 			blk, envelope.GetBlobsBundle(),
 		)
-		return err
+		telemetry.EndSpan(sidecarsSpan, sidecarsErr)
+		return sidecarsErr
 	})
 
 	// Compute the state root for the block.
@@ -178,8 +203,11 @@ func (s *Service[
 	slot math.Slot,
 ) (crypto.BLSSignature, error) {
 	var (
-		forkData ForkDataT
-		epoch    = s.chainSpec.SlotToEpoch(slot)
+		forkData    ForkDataT
+		epoch       = s.chainSpec.SlotToEpoch(slot)
+		forkVersion = version.FromUint32[common.Version](
+			s.chainSpec.ActiveForkVersionForEpoch(epoch),
+		)
 	)
 
 	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
@@ -188,9 +216,7 @@ func (s *Service[
 	}
 
 	signingRoot, err := forkData.New(
-		version.FromUint32[common.Version](
-			s.chainSpec.ActiveForkVersionForEpoch(epoch),
-		), genesisValidatorsRoot,
+		forkVersion, genesisValidatorsRoot,
 	).ComputeRandaoSigningRoot(
 		s.chainSpec.DomainTypeRandao(),
 		epoch,
@@ -199,7 +225,14 @@ func (s *Service[
 	if err != nil {
 		return crypto.BLSSignature{}, err
 	}
-	return s.signer.Sign(signingRoot[:])
+	return crypto.SignRequest(s.signer, &crypto.SigningRequest{
+		Type:        crypto.SigningTypeRandaoReveal,
+		SigningRoot: signingRoot,
+		ForkInfo: crypto.NewForkInfo(
+			forkVersion, epoch.Unwrap(), genesisValidatorsRoot,
+		),
+		RandaoReveal: &crypto.RandaoRevealData{Epoch: epoch.Unwrap()},
+	})
 }
 
 // retrieveExecutionPayload retrieves the execution payload for the block.
@@ -208,10 +241,15 @@ func (s *Service[
 	ExecutionPayloadT, ExecutionPayloadHeaderT, _,
 ]) retrieveExecutionPayload(
 	ctx context.Context, st BeaconStateT, blk BeaconBlockT,
-) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
-	//
-	// TODO: Add external block builders to this flow.
-	//
+) (_ engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], err error) {
+	ctx, span := s.tracer.Start(
+		ctx, "RetrieveExecutionPayload",
+		trace.WithAttributes(attribute.Int64(
+			"slot", int64(blk.GetSlot().Unwrap()),
+		)),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	// Get the payload for the block.
 	envelope, err := s.localPayloadBuilder.
 		RetrievePayload(
@@ -220,6 +258,7 @@ func (s *Service[
 			blk.GetParentBlockRoot(),
 		)
 	if err != nil {
+		span.AddEvent("local payload not ready, requesting payload sync")
 		s.metrics.failedToRetrievePayload(
 			blk.GetSlot(),
 			err,
@@ -245,11 +284,8 @@ func (s *Service[
 			ctx,
 			st,
 			blk.GetSlot(),
-			// TODO: this is hood.
-			max(
-				//#nosec:G701
-				uint64(time.Now().Unix()+1),
-				uint64((lph.GetTimestamp()+1)),
+			s.slotClock.PayloadTimestamp(
+				blk.GetSlot(), lph.GetTimestamp().Unwrap(),
 			),
 			blk.GetParentBlockRoot(),
 			lph.GetBlockHash(),
@@ -259,10 +295,92 @@ func (s *Service[
 	return envelope, nil
 }
 
+// retrieveRemotePayload asks the remote builder for a payload worth more
+// than the local one and, if it gets one, sets it on the block. The local
+// payload is kept whenever the remote builder fails.
+func (s *Service[
+	BeaconBlockT, _, BeaconStateT, _, _, _, _,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, _,
+]) retrieveRemotePayload(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	local engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+) engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT] {
+	if s.remotePayloadBuilder == nil || !s.remotePayloadBuilder.Enabled() ||
+		local.ShouldOverrideBuilder() {
+		return local
+	}
+
+	var err error
+	ctx, span := s.tracer.Start(
+		ctx, "RetrieveRemotePayload",
+		trace.WithAttributes(attribute.Int64(
+			"slot", int64(blk.GetSlot().Unwrap()),
+		)),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	remote, err := s.fetchRemotePayload(ctx, st, blk, local)
+	if err != nil {
+		s.logger.Warn(
+			"Falling back to local payload 🏠",
+			"slot", blk.GetSlot().Base10(),
+			"error", err,
+		)
+		s.metrics.remotePayloadFallback(err)
+		return local
+	}
+
+	s.metrics.remotePayloadUsed()
+	return remote
+}
+
+// fetchRemotePayload retrieves the payload of the remote builder and sets
+// it on the block body, restoring the local payload if that fails.
+func (s *Service[
+	BeaconBlockT, _, BeaconStateT, _, _, _, _,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, _,
+]) fetchRemotePayload(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	local engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	// The latest execution payload header is the parent of the payload.
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	remote, err := s.remotePayloadBuilder.RetrievePayload(
+		ctx, blk, lph.GetBlockHash(), genesisValidatorsRoot, local.GetValue(),
+	)
+	if err != nil {
+		return nil, err
+	} else if remote == nil || remote.GetBlobsBundle() == nil {
+		return nil, ErrNilPayload
+	}
+
+	body := blk.GetBody()
+	if err = body.SetExecutionData(remote.GetExecutionPayload()); err != nil {
+		return nil, errors.Join(
+			err, body.SetExecutionData(local.GetExecutionPayload()),
+		)
+	}
+	body.SetBlobKzgCommitments(remote.GetBlobsBundle().GetCommitments())
+	return remote, nil
+}
+
 // BuildBlockBody assembles the block body with necessary components.
 func (s *Service[
 	BeaconBlockT, _, BeaconStateT, _,
-	_, _, Eth1DataT, ExecutionPayloadT, _, _,
+	DepositT, _, Eth1DataT, ExecutionPayloadT, _, _,
 ]) buildBlockBody(
 	ctx context.Context,
 	st BeaconStateT,
@@ -293,28 +411,59 @@ func (s *Service[
 		return ErrNilDepositIndexStart
 	}
 
-	// Dequeue deposits from the state.
-	deposits, err := s.bsb.DepositStore(ctx).GetDepositsByIndex(
-		depositIndex,
-		s.chainSpec.MaxDepositsPerBlock(),
-	)
+	latestHeader, err := st.GetLatestExecutionPayloadHeader()
 	if err != nil {
 		return err
 	}
 
-	// Set the deposits on the block body.
-	body.SetDeposits(deposits)
-
+	// Before the deposit proof fork, blocks carry the deposits alone and
+	// vote for no deposit tree.
 	var eth1Data Eth1DataT
-	// TODO: assemble real eth1data.
-	body.SetEth1Data(eth1Data.New(
-		common.Bytes32{},
-		0,
-		common.ZeroHash,
-	))
+	if s.chainSpec.SlotToEpoch(blk.GetSlot()) <
+		s.chainSpec.DepositProofForkEpoch() {
+		var deposits []DepositT
+		deposits, err = s.bsb.DepositStore(ctx).GetDepositsByIndex(
+			depositIndex,
+			s.chainSpec.MaxDepositsPerBlock(),
+		)
+		if err != nil {
+			return err
+		}
+		body.SetDeposits(deposits)
+		body.SetEth1Data(eth1Data.New(
+			common.Bytes32{},
+			0,
+			common.ZeroHash,
+		))
+	} else {
+		// Dequeue deposits from the store, along with the deposit tree they
+		// are proven against, and vote for that tree.
+		var (
+			deposits     []DepositT
+			depositRoot  common.Root
+			depositCount uint64
+		)
+		deposits, depositRoot, depositCount, err = s.bsb.DepositStore(ctx).
+			GetDepositsWithProofs(
+				depositIndex,
+				s.chainSpec.MaxDepositsPerBlock(),
+			)
+		if err != nil {
+			return err
+		}
+		body.SetDeposits(deposits)
+		body.SetDepositProofs(true)
+		body.SetEth1Data(eth1Data.New(
+			depositRoot,
+			math.U64(depositCount),
+			latestHeader.GetBlockHash(),
+		))
+	}
 
 	// Set the graffiti on the block body.
-	body.SetGraffiti(bytes.ToBytes32([]byte(s.cfg.Graffiti)))
+	body.SetGraffiti(bytes.ToBytes32(
+		[]byte(s.proposerConfig.Graffiti(s.signer.PublicKey())),
+	))
 
 	return body.SetExecutionData(envelope.GetExecutionPayload())
 }
@@ -329,15 +478,21 @@ func (s *Service[
 	ctx context.Context,
 	st BeaconStateT,
 	blk BeaconBlockT,
-) error {
+) (err error) {
 	slot := blk.GetSlot()
+	ctx, span := s.tracer.Start(
+		ctx, "ComputeAndSetStateRoot",
+		trace.WithAttributes(attribute.Int64("slot", int64(slot.Unwrap()))),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	s.logger.Info(
 		"Computing state root for block 🌲",
 		"slot", slot.Base10(),
 	)
 
 	var stateRoot common.Root
-	stateRoot, err := s.computeStateRoot(ctx, st, blk)
+	stateRoot, err = s.computeStateRoot(ctx, st, blk)
 	if err != nil {
 		s.logger.Error(
 			"failed to compute state root while building block ❗️ ",
@@ -20,6 +20,11 @@
 
 package validator
 
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+)
+
 const (
 	// defaultGraffiti is the default graffiti string.
 	defaultGraffiti = ""
@@ -34,7 +39,8 @@ const (
 //nolint:lll // struct tags.
 type Config struct {
 	// Graffiti is the string that will be included in the
-	// graffiti field of the beacon block.
+	// graffiti field of the beacon block, unless the proposer config
+	// sets one.
 	Graffiti string `mapstructure:"graffiti"`
 
 	// EnableOptimisticPayloadBuilds is the optimistic block builder.
@@ -48,3 +54,11 @@ func DefaultConfig() Config {
 		EnableOptimisticPayloadBuilds: defaultEnableOptimisticPayloadBuilds,
 	}
 }
+
+// Validate checks that the graffiti fits in the graffiti field of a block.
+func (c Config) Validate() error {
+	if len(c.Graffiti) > constants.RootLength {
+		return errors.Wrapf(ErrGraffitiTooLong, "graffiti %q", c.Graffiti)
+	}
+	return nil
+}
@@ -49,4 +49,8 @@ var (
 	// ErrNilDepositIndexStart is an error for when the deposit index start is
 	// nil.
 	ErrNilDepositIndexStart = errors.New("nil deposit index start")
+
+	// ErrGraffitiTooLong is an error for when the configured graffiti does
+	// not fit in the graffiti field of a block.
+	ErrGraffitiTooLong = errors.New("graffiti must be at most 32 bytes")
 )
@@ -74,3 +74,19 @@ func (cm *validatorMetrics) failedToRetrievePayload(
 		err.Error(),
 	)
 }
+
+// remotePayloadUsed increments the counter for the number of times the
+// payload of the remote builder was preferred over the local one.
+func (cm *validatorMetrics) remotePayloadUsed() {
+	cm.sink.IncrementCounter("beacon_kit.validator.remote_payload_used")
+}
+
+// remotePayloadFallback increments the counter for the number of times the
+// validator fell back to the local payload.
+func (cm *validatorMetrics) remotePayloadFallback(err error) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.remote_payload_fallback",
+		"error",
+		err.Error(),
+	)
+}
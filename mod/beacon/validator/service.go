@@ -31,6 +31,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Service is responsible for building beacon blocks.
@@ -58,6 +59,8 @@ type Service[
 	chainSpec common.ChainSpec
 	// signer is used to retrieve the public key of this node.
 	signer crypto.BLSSigner
+	// proposerConfig provides the graffiti of the blocks of this node.
+	proposerConfig ProposerConfig
 	// blobFactory is used to create blob sidecars for blocks.
 	blobFactory BlobFactory[
 		BeaconBlockT, BeaconBlockBodyT, BlobSidecarsT,
@@ -79,11 +82,17 @@ type Service[
 	// Building blocks are done by submitting forkchoice updates through.
 	// The local Builder.
 	localPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT]
-	// remotePayloadBuilders represents a list of remote block builders, these
-	// builders are connected to other execution clients via the EngineAPI.
-	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT]
+	// remotePayloadBuilder represents the remote block builder, whose
+	// payloads compete by value with the ones of the local builder.
+	remotePayloadBuilder RemotePayloadBuilder[
+		BeaconBlockT, ExecutionPayloadT,
+	]
+	// slotClock maps slots to the timestamps of their payloads.
+	slotClock SlotClock
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
+	// tracer is the tracer for the block building flow.
+	tracer trace.Tracer
 	// blkFeed is a feed for blocks.
 	blkFeed *event.FeedOf[
 		asynctypes.EventID,
@@ -130,13 +139,18 @@ func NewService[
 		ExecutionPayloadHeaderT,
 	],
 	signer crypto.BLSSigner,
+	proposerConfig ProposerConfig,
 	blobFactory BlobFactory[
 		BeaconBlockT, BeaconBlockBodyT, BlobSidecarsT,
 		DepositT, Eth1DataT, ExecutionPayloadT,
 	],
 	localPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT],
-	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT],
+	remotePayloadBuilder RemotePayloadBuilder[
+		BeaconBlockT, ExecutionPayloadT,
+	],
+	slotClock SlotClock,
 	ts TelemetrySink,
+	tracer trace.Tracer,
 	blkFeed *event.FeedOf[
 		asynctypes.EventID, *asynctypes.Event[BeaconBlockT]],
 	sidecarsFeed *event.FeedOf[
@@ -153,19 +167,22 @@ func NewService[
 		DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadT,
 		ExecutionPayloadHeaderT, ForkDataT,
 	]{
-		cfg:                   cfg,
-		logger:                logger,
-		bsb:                   bsb,
-		chainSpec:             chainSpec,
-		signer:                signer,
-		stateProcessor:        stateProcessor,
-		blobFactory:           blobFactory,
-		localPayloadBuilder:   localPayloadBuilder,
-		remotePayloadBuilders: remotePayloadBuilders,
-		metrics:               newValidatorMetrics(ts),
-		blkFeed:               blkFeed,
-		sidecarsFeed:          sidecarsFeed,
-		slotFeed:              slotFeed,
+		cfg:                  cfg,
+		logger:               logger,
+		bsb:                  bsb,
+		chainSpec:            chainSpec,
+		signer:               signer,
+		proposerConfig:       proposerConfig,
+		stateProcessor:       stateProcessor,
+		blobFactory:          blobFactory,
+		localPayloadBuilder:  localPayloadBuilder,
+		remotePayloadBuilder: remotePayloadBuilder,
+		slotClock:            slotClock,
+		metrics:              newValidatorMetrics(ts),
+		tracer:               tracer,
+		blkFeed:              blkFeed,
+		sidecarsFeed:         sidecarsFeed,
+		slotFeed:             slotFeed,
 	}
 }
 
@@ -76,6 +76,9 @@ type BeaconBlockBody[
 	SetEth1Data(Eth1DataT)
 	// SetDeposits sets the deposits of the beacon block body.
 	SetDeposits([]DepositT)
+	// SetDepositProofs sets whether the beacon block body carries the
+	// inclusion proofs of its deposits.
+	SetDepositProofs(bool)
 	// SetExecutionData sets the execution data of the beacon block body.
 	SetExecutionData(ExecutionPayloadT) error
 	// SetGraffiti sets the graffiti of the beacon block body.
@@ -134,6 +137,13 @@ type DepositStore[DepositT any] interface {
 		startIndex uint64,
 		numView uint64,
 	) ([]DepositT, error)
+	// GetDepositsWithProofs returns up to `numView` deposits starting from
+	// the given index, along with the deposit root and count their
+	// inclusion proofs are built against.
+	GetDepositsWithProofs(
+		startIndex uint64,
+		numView uint64,
+	) ([]DepositT, common.Root, uint64, error)
 }
 
 // Eth1Data represents the eth1 data interface.
@@ -170,6 +180,13 @@ type ForkData[T any] interface {
 	) (common.Root, error)
 }
 
+// ProposerConfig provides the settings of the proposers of this node.
+type ProposerConfig interface {
+	// Graffiti returns the graffiti of the proposer with the given public
+	// key.
+	Graffiti(pubkey crypto.BLSPubkey) string
+}
+
 // PayloadBuilder represents a service that is responsible for
 // building eth1 blocks.
 type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
@@ -192,6 +209,29 @@ type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
 	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
 }
 
+// RemotePayloadBuilder represents a builder that builds payloads outside
+// of this node, such as a relay of the builder API.
+type RemotePayloadBuilder[BeaconBlockT, ExecutionPayloadT any] interface {
+	// Enabled returns true if the remote builder is enabled.
+	Enabled() bool
+	// RetrievePayload retrieves a payload for the given block, which
+	// carries the local payload, if one is worth more than minValue.
+	RetrievePayload(
+		ctx context.Context,
+		blk BeaconBlockT,
+		parentBlockHash common.ExecutionHash,
+		genesisValidatorsRoot common.Root,
+		minValue math.Wei,
+	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
+}
+
+// SlotClock maps slots to time.
+type SlotClock interface {
+	// PayloadTimestamp returns the timestamp to build the execution payload
+	// of the given slot with.
+	PayloadTimestamp(slot math.Slot, parentTimestamp uint64) uint64
+}
+
 // StateProcessor defines the interface for processing the state.
 type StateProcessor[
 	BeaconBlockT any,
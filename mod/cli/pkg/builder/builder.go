@@ -28,6 +28,7 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	cmdlib "github.com/berachain/beacon-kit/mod/cli/pkg/commands"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -151,6 +152,10 @@ func (cb *CLIBuilder[T]) InterceptConfigsPreRunHandler(
 		return err
 	}
 
+	// apply the BEACON_KIT_* environment overrides before anything reads
+	// the configuration
+	config.ApplyEnvOverrides(serverCtx.Viper)
+
 	// overwrite default server logger
 	serverCtx.Logger, err = CreatePhusluLogger(
 		serverCtx, cmd.OutOrStdout(),
@@ -164,10 +169,15 @@ func (cb *CLIBuilder[T]) InterceptConfigsPreRunHandler(
 }
 
 // CreatePhusluLogger creates a a phuslu logger with the given output.
-// It reads the log level and format from the server context.
+// It reads the log level from the server context and the logger
+// configuration from the app config.
 func CreatePhusluLogger(
 	ctx *server.Context, out io.Writer,
 ) (log.Logger, error) {
 	logLvlStr := ctx.Viper.GetString(flags.FlagLogLevel)
-	return phuslu.NewLogger[log.Logger](logLvlStr, out), nil
+	cfg, err := config.ReadConfigFromAppOpts(ctx.Viper)
+	if err != nil {
+		return nil, err
+	}
+	return phuslu.NewLogger[log.Logger](logLvlStr, out, &cfg.Logger), nil
 }
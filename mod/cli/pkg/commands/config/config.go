@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	confixcmd "cosmossdk.io/tools/confix/cmd"
+	beaconconfig "github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+)
+
+// Commands creates a new command for managing the application configuration,
+// extending the confix commands with a validation command.
+func Commands() *cobra.Command {
+	cmd := confixcmd.ConfigCommand()
+	cmd.AddCommand(
+		NewValidateCommand(),
+	)
+	return cmd
+}
+
+// NewValidateCommand creates a new command for validating the configuration
+// of the node.
+func NewValidateCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate",
+		Short: "Validates the beacon-kit configuration of the node",
+		Long: `Validates the beacon-kit configuration of the node, including the
+BEACON_KIT_* environment overrides, and its consistency with the CometBFT
+configuration. Every problem found is printed; the command fails if any of
+them would prevent the node from starting.`,
+		Args: cobra.NoArgs,
+		// Problems are reported above, the usage would only bury them.
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			cfg, err := beaconconfig.ReadConfigFromAppOpts(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			fatal, warnings := beaconconfig.Problems(
+				cfg.Validate(serverCtx.Config),
+			)
+			for _, warning := range warnings {
+				cmd.Printf("warning: %s\n", warning)
+			}
+			for _, problem := range fatal {
+				cmd.Printf("error: %s\n", problem)
+			}
+			if len(fatal) > 0 {
+				return errors.Wrapf(
+					ErrInvalidConfig, "%d problem(s) found", len(fatal),
+				)
+			}
+
+			cmd.Println("Configuration is valid")
+			return nil
+		},
+	}
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/hex"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/spf13/cobra"
+)
+
+// blobSlotEntry is a slot holding blob sidecars.
+type blobSlotEntry struct {
+	Slot     math.Slot `json:"slot"`
+	Sidecars int       `json:"sidecars"`
+}
+
+// blobEntry is a stored blob sidecar, bar its blob.
+type blobEntry struct {
+	Slot          math.Slot            `json:"slot"`
+	Index         uint64               `json:"index"`
+	KzgCommitment string               `json:"kzgCommitment"`
+	VersionedHash common.ExecutionHash `json:"versionedHash"`
+	KzgProof      eip4844.KZGProof     `json:"kzgProof"`
+	BlockRoot     common.Root          `json:"blockRoot"`
+}
+
+// NewBlobsCmd creates a new command for listing the stored blob sidecars.
+func NewBlobsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "blobs [slot]",
+		Short: "Lists the stored blob sidecars",
+		Long: `Lists the blob sidecars stored for the given slot, without their
+blobs. Without a slot, lists the slots holding sidecars instead.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db := openBlobStore(cmd)
+			if len(args) == 0 {
+				return listBlobSlots(cmd, db)
+			}
+			slot, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			return listBlobs(cmd, db, slot)
+		},
+	}
+}
+
+// listBlobSlots prints the slots holding sidecars in the given store.
+func listBlobSlots(cmd *cobra.Command, db *filedb.RangeDB) error {
+	slots, err := db.Indexes()
+	if err != nil {
+		return err
+	}
+
+	entries := make([]blobSlotEntry, 0, len(slots))
+	t := newTable("SLOT", "SIDECARS")
+	for _, slot := range slots {
+		keys, err := db.Keys(slot)
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		entries = append(entries, blobSlotEntry{
+			Slot:     math.Slot(slot),
+			Sidecars: len(keys),
+		})
+		t.add(slot, len(keys))
+	}
+	return render(cmd, entries, t)
+}
+
+// listBlobs prints the sidecars stored for the given slot.
+func listBlobs(cmd *cobra.Command, db *filedb.RangeDB, slot uint64) error {
+	keys, err := db.Keys(slot)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]blobEntry, 0, len(keys))
+	for _, key := range keys {
+		bz, err := db.Get(slot, key)
+		if err != nil {
+			return err
+		}
+		sidecar := new(datypes.BlobSidecar)
+		if err = sidecar.UnmarshalSSZ(bz); err != nil {
+			return err
+		}
+		blockRoot, err := sidecar.BeaconBlockHeader.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		entries = append(entries, blobEntry{
+			Slot:          math.Slot(slot),
+			Index:         sidecar.Index,
+			KzgCommitment: hex.FromBytes(sidecar.KzgCommitment[:]).Unwrap(),
+			VersionedHash: sidecar.KzgCommitment.ToVersionedHash(),
+			KzgProof:      sidecar.KzgProof,
+			BlockRoot:     blockRoot,
+		})
+	}
+	slices.SortFunc(entries, func(a, b blobEntry) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+
+	t := newTable("INDEX", "KZG COMMITMENT", "VERSIONED HASH", "BLOCK ROOT")
+	for _, entry := range entries {
+		t.add(
+			entry.Index, entry.KzgCommitment,
+			entry.VersionedHash.Hex(), entry.BlockRoot,
+		)
+	}
+	return render(cmd, entries, t)
+}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/db"
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+// execute runs the db command with the given args against the node home
+// directory and returns its output.
+func execute(t *testing.T, home string, args ...string) (string, error) {
+	t.Helper()
+	return executeCmd(t, db.Commands(nil), home, args...)
+}
+
+// executeCmd runs the command with the given args against the node home
+// directory and returns its output.
+func executeCmd(
+	t *testing.T,
+	cmd *cobra.Command,
+	home string,
+	args ...string,
+) (string, error) {
+	t.Helper()
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config.SetRoot(home)
+	serverCtx.Logger = log.NewNopLogger()
+
+	var out bytes.Buffer
+	cmd.SetArgs(args)
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	err := cmd.ExecuteContext(context.WithValue(
+		context.Background(), server.ServerContextKey, serverCtx,
+	))
+	return out.String(), err
+}
+
+func TestBlobs(t *testing.T) {
+	home := t.TempDir()
+	blobs := filedb.NewRangeDB(filedb.NewDB(
+		filedb.WithRootDirectory(filepath.Join(home, "data", "blobs")),
+		filedb.WithFileExtension("ssz"),
+		filedb.WithDirectoryPermissions(0700),
+		filedb.WithLogger(log.NewNopLogger()),
+	))
+	for i, commitment := range []eip4844.KZGCommitment{{0x02}, {0x01}} {
+		sidecar := &datypes.BlobSidecar{
+			Index:         uint64(i),
+			KzgCommitment: commitment,
+			BeaconBlockHeader: ctypes.NewBeaconBlockHeader(
+				3, 0, common.Root{}, common.Root{}, common.Root{},
+			),
+			InclusionProof: make([][32]byte, 8),
+		}
+		bz, err := sidecar.MarshalSSZ()
+		require.NoError(t, err)
+		require.NoError(t, blobs.Set(3, commitment[:], bz))
+	}
+
+	out, err := execute(t, home, "blobs")
+	require.NoError(t, err)
+	require.Equal(t, "SLOT  SIDECARS\n3     2\n", out)
+
+	out, err = execute(t, home, "blobs", "3", "--output", "json")
+	require.NoError(t, err)
+	var entries []struct {
+		Slot          string `json:"slot"`
+		Index         uint64 `json:"index"`
+		KzgCommitment string `json:"kzgCommitment"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &entries))
+	require.Len(t, entries, 2)
+	require.Equal(t, uint64(0), entries[0].Index)
+	require.Equal(t, "0x3", entries[0].Slot)
+	require.Contains(t, entries[0].KzgCommitment, "0x02")
+
+	_, err = execute(t, home, "blobs", "--output", "yaml")
+	require.ErrorIs(t, err, db.ErrUnknownOutput)
+
+	// Nothing is written to the store.
+	dirs, err := os.ReadDir(filepath.Join(home, "data", "blobs"))
+	require.NoError(t, err)
+	require.Len(t, dirs, 1)
+}
+
+func TestReplay_InvalidFlags(t *testing.T) {
+	home := t.TempDir()
+	for _, tc := range []struct {
+		args []string
+		err  error
+	}{
+		{[]string{"--from-height", "0"}, db.ErrInvalidHeightRange},
+		{
+			[]string{"--from-height", "3", "--to-height", "2"},
+			db.ErrInvalidHeightRange,
+		},
+		{[]string{"--engine", "remote"}, db.ErrUnknownEngine},
+		// The block store of the node holds no block.
+		{[]string{"--to-height", "2"}, db.ErrHeightUnavailable},
+	} {
+		_, err := executeCmd(t, db.NewReplayCmd(nil), home, tc.args...)
+		require.ErrorIs(t, err, tc.err, tc.args)
+	}
+}
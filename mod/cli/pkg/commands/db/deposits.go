@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/hex"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagLimit is the flag for the maximum number of deposits listed.
+	flagLimit = "limit"
+	// defaultLimit is the default maximum number of deposits listed.
+	defaultLimit = 100
+)
+
+// depositEntry is a stored deposit, bar its proof.
+type depositEntry struct {
+	Index       uint64              `json:"index"`
+	Pubkey      crypto.BLSPubkey    `json:"pubkey"`
+	Credentials string              `json:"credentials"`
+	Amount      math.Gwei           `json:"amount"`
+	Signature   crypto.BLSSignature `json:"signature"`
+}
+
+// NewDepositsCmd creates a new command for listing the stored deposits.
+func NewDepositsCmd(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposits [index]",
+		Short: "Lists the stored deposits from an index",
+		Long: `Lists the stored deposits from the given index. Without an index,
+lists the deposits queued for inclusion, from the deposit index of the
+latest beacon state.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			limit, err := cmd.Flags().GetUint64(flagLimit)
+			if err != nil {
+				return err
+			}
+			var index uint64
+			if len(args) == 0 {
+				index, err = queueHead(cmd, chainSpec)
+			} else {
+				index, err = strconv.ParseUint(args[0], 10, 64)
+			}
+			if err != nil {
+				return err
+			}
+
+			store, closeFn, err := openDepositStore(cmd)
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+
+			deposits, err := store.GetDepositsByIndex(index, limit)
+			if err != nil {
+				return err
+			}
+			entries := make([]depositEntry, len(deposits))
+			t := newTable("INDEX", "PUBKEY", "CREDENTIALS", "AMOUNT")
+			for i, deposit := range deposits {
+				entries[i] = depositEntry{
+					Index:       deposit.Index,
+					Pubkey:      deposit.Pubkey,
+					Credentials: hex.FromBytes(deposit.Credentials[:]).Unwrap(),
+					Amount:      deposit.Amount,
+					Signature:   deposit.Signature,
+				}
+				t.add(
+					entries[i].Index, entries[i].Pubkey,
+					entries[i].Credentials, entries[i].Amount,
+				)
+			}
+			return render(cmd, entries, t)
+		},
+	}
+	cmd.Flags().Uint64(
+		flagLimit, defaultLimit, "maximum number of deposits listed",
+	)
+	return cmd
+}
+
+// queueHead returns the index of the first deposit not yet included by the
+// latest beacon state.
+func queueHead(cmd *cobra.Command, chainSpec common.ChainSpec) (uint64, error) {
+	s, err := openAppStore(cmd, chainSpec)
+	if err != nil {
+		return 0, err
+	}
+	defer s.Close()
+
+	st, err := s.StateAt(0)
+	if err != nil {
+		return 0, err
+	}
+	return st.GetEth1DepositIndex()
+}
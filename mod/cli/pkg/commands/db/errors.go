@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrReadOnly indicates that a write was attempted on a database opened
+	// read-only.
+	ErrReadOnly = errors.New("database opened read-only")
+	// ErrUnknownOutput indicates that the requested output format is not
+	// supported.
+	ErrUnknownOutput = errors.New("unknown output format")
+	// ErrHeightUnavailable indicates that the state at the requested height
+	// is not held by the database.
+	ErrHeightUnavailable = errors.New("height not available")
+	// ErrStateRootMismatch indicates that the recomputed state root differs
+	// from the one recorded by the following state.
+	ErrStateRootMismatch = errors.New("state root mismatch")
+	// ErrInvalidHeightRange indicates that the range of heights to replay is
+	// empty or starts below the first height.
+	ErrInvalidHeightRange = errors.New("invalid height range")
+	// ErrUnknownEngine indicates that the requested execution engine is not
+	// supported.
+	ErrUnknownEngine = errors.New("unknown engine")
+	// ErrDivergence indicates that a replayed block does not match the
+	// chain.
+	ErrDivergence = errors.New("replayed block diverges from the chain")
+)
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagOutput is the flag for the output format.
+	flagOutput = "output"
+	// outputJSON prints results as indented JSON.
+	outputJSON = "json"
+	// outputTable prints results as a table.
+	outputTable = "table"
+	// columnPadding is the number of spaces between table columns.
+	columnPadding = 2
+)
+
+// table is the tabular rendering of a result.
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+// newTable returns an empty table with the given column names.
+func newTable(header ...string) *table {
+	return &table{header: header}
+}
+
+// add appends a row of the given values, formatted with %v.
+func (t *table) add(values ...any) {
+	row := make([]string, len(values))
+	for i, value := range values {
+		row[i] = fmt.Sprint(value)
+	}
+	t.rows = append(t.rows, row)
+}
+
+// render writes the result to the output of the command in the format of the
+// output flag, v as JSON or t as a table.
+func render(cmd *cobra.Command, v any, t *table) error {
+	format, err := cmd.Flags().GetString(flagOutput)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case outputJSON:
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case outputTable:
+		w := tabwriter.NewWriter(
+			cmd.OutOrStdout(), 0, 0, columnPadding, ' ', 0,
+		)
+		fmt.Fprintln(w, strings.Join(t.header, "\t"))
+		for _, row := range t.rows {
+			fmt.Fprintln(w, strings.Join(row, "\t"))
+		}
+		return w.Flush()
+	default:
+		return errors.Wrapf(
+			ErrUnknownOutput, "%q, expected %s or %s",
+			format, outputJSON, outputTable,
+		)
+	}
+}
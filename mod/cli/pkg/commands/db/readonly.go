@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	corestore "cosmossdk.io/core/store"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// readOnlyDB is an application database rejecting writes.
+type readOnlyDB struct {
+	dbm.DB
+}
+
+// Set implements dbm.DB.
+func (readOnlyDB) Set([]byte, []byte) error { return ErrReadOnly }
+
+// SetSync implements dbm.DB.
+func (readOnlyDB) SetSync([]byte, []byte) error { return ErrReadOnly }
+
+// Delete implements dbm.DB.
+func (readOnlyDB) Delete([]byte) error { return ErrReadOnly }
+
+// DeleteSync implements dbm.DB.
+func (readOnlyDB) DeleteSync([]byte) error { return ErrReadOnly }
+
+// NewBatch implements dbm.DB.
+func (readOnlyDB) NewBatch() dbm.Batch { return readOnlyBatch{} }
+
+// NewBatchWithSize implements dbm.DB.
+func (readOnlyDB) NewBatchWithSize(int) dbm.Batch { return readOnlyBatch{} }
+
+// readOnlyKVStore is a key-value store rejecting writes.
+type readOnlyKVStore struct {
+	corestore.KVStoreWithBatch
+}
+
+// Set implements corestore.KVStore.
+func (readOnlyKVStore) Set([]byte, []byte) error { return ErrReadOnly }
+
+// Delete implements corestore.KVStore.
+func (readOnlyKVStore) Delete([]byte) error { return ErrReadOnly }
+
+// NewBatch implements corestore.BatchCreator.
+func (readOnlyKVStore) NewBatch() corestore.Batch { return readOnlyBatch{} }
+
+// NewBatchWithSize implements corestore.BatchCreator.
+func (readOnlyKVStore) NewBatchWithSize(int) corestore.Batch {
+	return readOnlyBatch{}
+}
+
+// readOnlyBatch is a batch rejecting writes.
+type readOnlyBatch struct{}
+
+// Set implements the batch interfaces.
+func (readOnlyBatch) Set([]byte, []byte) error { return ErrReadOnly }
+
+// Delete implements the batch interfaces.
+func (readOnlyBatch) Delete([]byte) error { return ErrReadOnly }
+
+// Write implements the batch interfaces.
+func (readOnlyBatch) Write() error { return ErrReadOnly }
+
+// WriteSync implements the batch interfaces.
+func (readOnlyBatch) WriteSync() error { return ErrReadOnly }
+
+// Close implements the batch interfaces.
+func (readOnlyBatch) Close() error { return nil }
+
+// GetByteSize implements the batch interfaces.
+func (readOnlyBatch) GetByteSize() (int, error) { return 0, nil }
@@ -0,0 +1,566 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/config"
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	nodemetrics "github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	beacon "github.com/berachain/beacon-kit/mod/node-core/pkg/components/module"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/clock"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/telemetry"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/tracing"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagFromHeight is the flag for the first height to replay.
+	flagFromHeight = "from-height"
+	// flagToHeight is the flag for the last height to replay.
+	flagToHeight = "to-height"
+	// flagEngine is the flag for the execution engine to replay against.
+	flagEngine = "engine"
+	// engineMock accepts every execution payload.
+	engineMock = "mock"
+	// engineReal sends the execution payloads to the execution client.
+	engineReal = "real"
+)
+
+const (
+	// sourceTransition is a block the state transition failed on.
+	sourceTransition = "transition"
+	// sourceBlock is a state root differing from the one of the block.
+	sourceBlock = "block"
+	// sourceStore is a state root differing from the one of the state held
+	// by the application database.
+	sourceStore = "store"
+)
+
+// executionEngine is the execution engine the blocks are replayed against.
+type executionEngine = core.ExecutionEngine[
+	*components.ExecutionPayload,
+	*components.ExecutionPayloadHeader,
+	*components.Withdrawal,
+]
+
+// replayReport is the outcome of replaying a range of blocks.
+type replayReport struct {
+	FromHeight int64       `json:"fromHeight"`
+	ToHeight   int64       `json:"toHeight"`
+	Replayed   int         `json:"replayed"`
+	Skipped    int         `json:"skipped"`
+	Divergence *divergence `json:"divergence"`
+}
+
+// divergence is the first replayed block not matching the chain.
+type divergence struct {
+	Height   int64        `json:"height"`
+	Slot     math.Slot    `json:"slot"`
+	Source   string       `json:"source"`
+	Expected *common.Root `json:"expected,omitempty"`
+	Got      *common.Root `json:"got,omitempty"`
+	Error    string       `json:"error,omitempty"`
+}
+
+// NewReplayCmd creates a new command for replaying the blocks held by the
+// CometBFT block store through the state transition.
+func NewReplayCmd(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Replays stored blocks through the state transition",
+		Long: `Replays the blocks held by the CometBFT block store of a stopped
+node, from --from-height to --to-height, the latest block by default, through
+the state transition. The replay starts from the genesis state at height 1,
+otherwise from the state held by the application database at the height
+before. The root of the state after each block is checked against the one of
+the block and the one of the state held at its height, if any. The command
+fails at the first divergence.
+
+The execution payloads are accepted as is with --engine mock, or sent to the
+execution client configured for the node with --engine real, a payload it
+rejects failing the replay as a divergence. The deposit roots voted for by
+the blocks are not checked against the deposit store of the node, which
+forgets them once finalized, but are covered by the state roots checked.
+Replayed states are held in memory and no database is written to, bar the
+version of the key layout of the block store if missing.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			report, err := replay(cmd, chainSpec)
+			if err != nil {
+				return err
+			}
+
+			t := newTable("FIELD", "VALUE")
+			t.add("from_height", report.FromHeight)
+			t.add("to_height", report.ToHeight)
+			t.add("replayed", report.Replayed)
+			t.add("skipped", report.Skipped)
+			d := report.Divergence
+			if d == nil {
+				t.add("divergence", "-")
+				return render(cmd, report, t)
+			}
+			t.add("divergence.height", d.Height)
+			t.add("divergence.slot", d.Slot)
+			t.add("divergence.source", d.Source)
+			if d.Expected != nil {
+				t.add("divergence.expected", *d.Expected)
+				t.add("divergence.got", *d.Got)
+			}
+			if d.Error != "" {
+				t.add("divergence.error", d.Error)
+			}
+			if err = render(cmd, report, t); err != nil {
+				return err
+			}
+			return errors.Wrapf(
+				ErrDivergence, "height %d, source %s", d.Height, d.Source,
+			)
+		},
+	}
+	cmd.Flags().Int64(flagFromHeight, 1, "first height to replay")
+	cmd.Flags().Int64(
+		flagToHeight, 0, "last height to replay, latest if unset",
+	)
+	cmd.Flags().String(
+		flagEngine, engineMock, "execution engine to replay with (mock|real)",
+	)
+	cmd.Flags().String(flagOutput, outputTable, "output format (table|json)")
+	return cmd
+}
+
+// replayer re-runs the state transition over the blocks of the block store.
+type replayer struct {
+	cs        common.ChainSpec
+	sp        components.StateProcessor
+	slotClock *clock.SlotClock
+	blocks    *store.BlockStore
+	app       *appStore
+	// optimistic is whether the payloads are accepted without an execution
+	// client, in which case their verification is optimistic.
+	optimistic bool
+}
+
+// replay replays the range of blocks given by the flags of the command.
+func replay(
+	cmd *cobra.Command,
+	chainSpec common.ChainSpec,
+) (*replayReport, error) {
+	from, err := cmd.Flags().GetInt64(flagFromHeight)
+	if err != nil {
+		return nil, err
+	}
+	to, err := cmd.Flags().GetInt64(flagToHeight)
+	if err != nil {
+		return nil, err
+	}
+	if from < 1 || (to != 0 && to < from) {
+		return nil, errors.Wrapf(
+			ErrInvalidHeightRange, "from %d to %d", from, to,
+		)
+	}
+	engine, err := newEngine(cmd, chainSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, err := openBlockStore(cmd)
+	if err != nil {
+		return nil, err
+	}
+	defer blocks.Close()
+	if to == 0 {
+		to = blocks.Height()
+	}
+	if from < blocks.Base() || to > blocks.Height() {
+		return nil, errors.Wrapf(
+			ErrHeightUnavailable, "blocks %d to %d, held %d to %d",
+			from, to, blocks.Base(), blocks.Height(),
+		)
+	}
+
+	app, err := openAppStore(cmd, chainSpec)
+	if err != nil {
+		return nil, err
+	}
+	defer app.Close()
+
+	slotClock, err := clock.NewSlotClock(
+		//#nosec:G701 // chain spec durations will never overflow an int64.
+		time.Duration(chainSpec.TargetSecondsPerEth1Block())*time.Second,
+		time.Duration(chainSpec.MaxPayloadTimestampDrift())*time.Second,
+	)
+	if err != nil {
+		return nil, err
+	}
+	sp, err := newStateProcessor(
+		chainSpec, engine, unknownDepositRoots{}, slotClock,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &replayer{
+		cs:        chainSpec,
+		sp:        sp,
+		slotClock: slotClock,
+		blocks:    blocks,
+		app:       app,
+	}
+	_, r.optimistic = engine.(acceptingEngine)
+
+	var st *beaconState
+	if from > 1 {
+		st, err = app.StateAt(from - 1)
+	} else {
+		st, err = r.genesisState(cmd)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.replay(cmd.Context(), st, from, to)
+}
+
+// newStateProcessor returns the state processor the blocks are replayed
+// with, checking the eth1 data voted for against the given deposit tree.
+func newStateProcessor(
+	chainSpec common.ChainSpec,
+	engine executionEngine,
+	depositTree core.DepositTree,
+	slotClock *clock.SlotClock,
+) (components.StateProcessor, error) {
+	tracerProvider, err := tracing.NewProvider(tracing.Config{})
+	if err != nil {
+		return nil, err
+	}
+	return core.NewStateProcessor[
+		*components.BeaconBlock,
+		*components.BeaconBlockBody,
+		*components.BeaconBlockHeader,
+		components.BeaconState,
+		*components.BlobSidecars,
+		*transition.Context,
+		*components.Deposit,
+		*ctypes.Eth1Data,
+		*components.ExecutionPayload,
+		*components.ExecutionPayloadHeader,
+		*ctypes.Fork,
+		*ctypes.ForkData,
+		*ctypes.Validator,
+		*components.Withdrawal,
+		ctypes.WithdrawalCredentials,
+	](
+		chainSpec,
+		engine,
+		// Blocks are only verified, which requires no key.
+		signer.BLSSigner{},
+		depositTree,
+		slotClock,
+		tracerProvider.Tracer("beacon-kit/state-transition"),
+	), nil
+}
+
+// unknownDepositRoots is a deposit tree knowing no deposit root. The deposit
+// store of the node only knows the roots from its last finalization on, so
+// the eth1 data voted for by replayed blocks is not checked against it. It
+// was checked when the blocks were finalized, and the state roots checked
+// after each block cover it.
+type unknownDepositRoots struct{}
+
+// DepositRoot reports the root of the given count of deposits as unknown.
+func (unknownDepositRoots) DepositRoot(uint64) (common.Root, bool) {
+	return common.Root{}, false
+}
+
+// replay runs the blocks of the given range of heights over the state and
+// reports the first divergence.
+func (r *replayer) replay(
+	ctx context.Context,
+	st *beaconState,
+	from, to int64,
+) (*replayReport, error) {
+	report := &replayReport{FromHeight: from, ToHeight: to}
+	for height := from; height <= to; height++ {
+		block, _ := r.blocks.LoadBlock(height)
+		if block == nil {
+			return nil, errors.Wrapf(ErrHeightUnavailable, "block %d", height)
+		}
+		blk, err := r.decodeBlock(block)
+		if err != nil {
+			// As when finalizing, proposals without a beacon block leave
+			// the state untouched.
+			report.Skipped++
+			continue
+		}
+
+		r.slotClock.OnBlock(math.Slot(height), block.Time)
+		report.Replayed++
+		if report.Divergence, err = r.apply(ctx, st, blk, height); err != nil {
+			return nil, err
+		} else if report.Divergence != nil {
+			break
+		}
+	}
+	return report, nil
+}
+
+// decodeBlock returns the beacon block proposed by the given block.
+func (r *replayer) decodeBlock(
+	block *cmttypes.Block,
+) (*components.BeaconBlock, error) {
+	return encoding.UnmarshalBeaconBlockFromABCIRequest[*ctypes.BeaconBlock](
+		&cmtabci.FinalizeBlockRequest{
+			Height: block.Height,
+			Time:   block.Time,
+			Txs:    block.Txs.ToSliceOfBytes(),
+		},
+		r.cs.ActiveForkVersionForSlot(math.Slot(block.Height)),
+	)
+}
+
+// apply runs the state transition of the block at the given height and
+// checks the root of the resulting state. Divergences are returned as such,
+// and failures to check them as errors.
+func (r *replayer) apply(
+	ctx context.Context,
+	st *beaconState,
+	blk *components.BeaconBlock,
+	height int64,
+) (*divergence, error) {
+	d := &divergence{Height: height, Slot: blk.GetSlot()}
+	if _, err := r.sp.Transition(
+		&transition.Context{
+			Context: ctx,
+			// Payloads rejected by a real execution client fail the
+			// transition instead of being accepted optimistically.
+			OptimisticEngine: r.optimistic,
+			// The state root is checked below to report the divergence.
+			SkipValidateResult: true,
+		},
+		st.BeaconState,
+		blk,
+	); err != nil {
+		d.Source, d.Error = sourceTransition, err.Error()
+		return d, nil
+	}
+
+	var got, expected common.Root
+	got, err := st.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	if expected = blk.GetStateRoot(); got != expected {
+		d.Source, d.Expected, d.Got = sourceBlock, &expected, &got
+		return d, nil
+	}
+
+	stored, err := r.app.StateAt(height)
+	if errors.Is(err, ErrHeightUnavailable) {
+		//nolint:nilnil // pruned states are not compared.
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if expected, err = stored.HashTreeRoot(); err != nil {
+		return nil, err
+	}
+	if got != expected {
+		d.Source, d.Expected, d.Got = sourceStore, &expected, &got
+		return d, nil
+	}
+	//nolint:nilnil // the block matches the chain.
+	return nil, nil
+}
+
+// genesisState returns the beacon state initialized from the genesis file
+// of the node, held in memory.
+func (r *replayer) genesisState(cmd *cobra.Command) (*beaconState, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	appGenesis, err := genutiltypes.AppGenesisFromFile(
+		serverCtx.Config.GenesisFile(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var appState map[string]json.RawMessage
+	if err = json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+		return nil, err
+	}
+	genesis := new(components.Genesis)
+	if err = json.Unmarshal(appState[beacon.ModuleName], genesis); err != nil {
+		return nil, err
+	}
+
+	st, err := newMemoryState(r.cs, serverCtx.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	r.slotClock.SetGenesisTime(appGenesis.GenesisTime)
+	if _, err = r.sp.InitializePreminedBeaconStateFromEth1(
+		st.BeaconState,
+		genesis.GetDeposits(),
+		genesis.GetExecutionPayloadHeader(),
+		genesis.GetForkVersion(),
+	); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// newMemoryState returns an empty beacon state held in memory.
+func newMemoryState(
+	cs common.ChainSpec,
+	logger log.Logger,
+) (*beaconState, error) {
+	key := storetypes.NewKVStoreKey(beacon.ModuleName)
+	cms := rootmulti.NewStore(dbm.NewMemDB(), logger, metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	if err := cms.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+	return newBeaconState(
+		newKVStore(key).WithContext(
+			sdk.NewContext(cms.CacheMultiStore(), false, logger),
+		),
+		cs,
+		0,
+	), nil
+}
+
+// newEngine returns the execution engine given by the engine flag.
+func newEngine(
+	cmd *cobra.Command,
+	chainSpec common.ChainSpec,
+) (executionEngine, error) {
+	name, err := cmd.Flags().GetString(flagEngine)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	case engineMock:
+		return acceptingEngine{}, nil
+	case engineReal:
+		return newExecutionEngine(cmd, chainSpec)
+	default:
+		return nil, errors.Wrapf(
+			ErrUnknownEngine, "%q, expected %s or %s",
+			name, engineMock, engineReal,
+		)
+	}
+}
+
+// newExecutionEngine returns an engine sending the execution payloads to
+// the execution client configured for the node, once connected to it.
+func newExecutionEngine(
+	cmd *cobra.Command,
+	chainSpec common.ChainSpec,
+) (executionEngine, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	cfg, err := config.ReadConfigFromAppOpts(serverCtx.Viper)
+	if err != nil {
+		return nil, err
+	}
+	jwtSecret, err := components.LoadJWTFromFile(cfg.Engine.JWTSecretPath)
+	if err != nil {
+		return nil, err
+	}
+	registry, err := telemetry.NewRegistry(
+		nil, telemetry.DefaultDescriptors()...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	tracerProvider, err := tracing.NewProvider(tracing.Config{})
+	if err != nil {
+		return nil, err
+	}
+	sink := nodemetrics.NewTelemetrySink(registry)
+
+	engineClient := components.ProvideEngineClient[
+		*components.ExecutionPayload,
+		*engineprimitives.PayloadAttributes[*components.Withdrawal],
+	](components.EngineClientInputs{
+		ChainSpec:      chainSpec,
+		Config:         cfg,
+		JWTSecret:      jwtSecret,
+		Logger:         serverCtx.Logger,
+		TelemetrySink:  sink,
+		TracerProvider: tracerProvider,
+	})
+	if err = engineClient.Start(cmd.Context()); err != nil {
+		return nil, err
+	}
+	return components.ProvideExecutionEngine[
+		*components.ExecutionPayload,
+		*engineprimitives.PayloadAttributes[*components.Withdrawal],
+		engineprimitives.PayloadID,
+		*components.Withdrawal,
+	](components.ExecutionEngineInputs[
+		*components.ExecutionPayload,
+		*engineprimitives.PayloadAttributes[*components.Withdrawal],
+		*components.Withdrawal,
+	]{
+		EngineClient:  engineClient,
+		Logger:        serverCtx.Logger,
+		StatusFeed:    &components.StatusFeed{},
+		TelemetrySink: sink,
+	}), nil
+}
+
+// acceptingEngine is an execution engine accepting every payload, to replay
+// blocks without an execution client.
+type acceptingEngine struct{}
+
+// VerifyAndNotifyNewPayload accepts the payload.
+func (acceptingEngine) VerifyAndNotifyNewPayload(
+	context.Context,
+	*engineprimitives.NewPayloadRequest[
+		*components.ExecutionPayload, *components.Withdrawal,
+	],
+) error {
+	return nil
+}
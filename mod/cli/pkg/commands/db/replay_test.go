@@ -0,0 +1,308 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/genesis"
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	beacon "github.com/berachain/beacon-kit/mod/node-core/pkg/components/module"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/clock"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	cmtdb "github.com/cometbft/cometbft-db"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/stretchr/testify/require"
+)
+
+// chainBuilder builds a chain of blocks the way a node finalizes them,
+// checking the eth1 data voted for against its live deposit store.
+type chainBuilder struct {
+	t        *testing.T
+	cs       common.ChainSpec
+	sp       components.StateProcessor
+	st       components.BeaconState
+	deposits *components.DepositStore
+	blocks   *store.BlockStore
+	keys     []*signer.LegacySigner
+	// gvr is the genesis validators root of the chain.
+	gvr common.Root
+}
+
+// newChainBuilder returns a builder whose chain starts at the genesis of
+// the first of the given keys.
+func newChainBuilder(
+	t *testing.T,
+	cs common.ChainSpec,
+	keys int,
+) *chainBuilder {
+	t.Helper()
+	deposits, err := depositstore.NewStore[*components.Deposit](
+		&depositstore.KVStoreProvider{KVStoreWithBatch: storev2.NewMemDB()},
+	)
+	require.NoError(t, err)
+	slotClock, err := clock.NewSlotClock(time.Second, 0)
+	require.NoError(t, err)
+	sp, err := newStateProcessor(cs, acceptingEngine{}, deposits, slotClock)
+	require.NoError(t, err)
+	st, err := newMemoryState(cs, log.NewNopLogger())
+	require.NoError(t, err)
+
+	b := &chainBuilder{
+		t:        t,
+		cs:       cs,
+		sp:       sp,
+		st:       st.BeaconState,
+		deposits: deposits,
+		blocks:   store.NewBlockStore(cmtdb.NewMemDB()),
+	}
+	for i := range keys {
+		var key signer.LegacyKey
+		key[len(key)-1] = byte(i + 1)
+		s, keyErr := signer.NewLegacySigner(key)
+		require.NoError(t, keyErr)
+		b.keys = append(b.keys, s)
+	}
+	return b
+}
+
+// genesis initializes the state from the deposit of the first key and
+// returns the genesis of the chain.
+func (b *chainBuilder) genesis() *components.Genesis {
+	gen := genesis.DefaultGenesisDeneb()
+	gen.Deposits = []*components.Deposit{b.deposit(0)}
+	_, err := b.sp.InitializePreminedBeaconStateFromEth1(
+		b.st, gen.Deposits, gen.ExecutionPayloadHeader, gen.ForkVersion,
+	)
+	require.NoError(b.t, err)
+	b.gvr, err = b.st.GetGenesisValidatorsRoot()
+	require.NoError(b.t, err)
+	return gen
+}
+
+// deposit signs a deposit of the given key and enqueues it in the deposit
+// store.
+func (b *chainBuilder) deposit(key int) *components.Deposit {
+	credentials := ctypes.NewCredentialsFromExecutionAddress(
+		common.ExecutionAddress{byte(key)},
+	)
+	amount := math.Gwei(b.cs.MaxEffectiveBalance())
+	_, signature, err := ctypes.CreateAndSignDepositMessage(
+		ctypes.NewForkData(version.FromUint32[common.Version](
+			b.cs.ActiveForkVersionForSlot(0),
+		), b.gvr),
+		b.cs.DomainTypeDeposit(),
+		b.keys[key],
+		credentials,
+		amount,
+	)
+	require.NoError(b.t, err)
+	deposit := ctypes.NewDeposit(
+		b.keys[key].PublicKey(), credentials, amount, signature, uint64(key),
+	)
+	require.NoError(b.t, b.deposits.EnqueueDeposit(deposit))
+	return deposit
+}
+
+// propose applies the next block, proposed by the first key and including
+// the pending deposits, and saves it to the block store.
+func (b *chainBuilder) propose() {
+	t := b.t
+	slot, err := b.st.GetSlot()
+	require.NoError(t, err)
+	slot++
+	epoch := b.cs.SlotToEpoch(slot)
+
+	view := b.st.Copy()
+	_, err = b.sp.ProcessSlots(view, slot)
+	require.NoError(t, err)
+	header, err := view.GetLatestBlockHeader()
+	require.NoError(t, err)
+	parentRoot, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	lph, err := view.GetLatestExecutionPayloadHeader()
+	require.NoError(t, err)
+	mix, err := view.GetRandaoMixAtIndex(
+		uint64(epoch) % b.cs.EpochsPerHistoricalVector(),
+	)
+	require.NoError(t, err)
+	withdrawals, err := view.ExpectedWithdrawals()
+	require.NoError(t, err)
+	index, err := view.GetEth1DepositIndex()
+	require.NoError(t, err)
+	deposits, root, count, err := b.deposits.GetDepositsWithProofs(
+		index, b.cs.MaxDepositsPerBlock(),
+	)
+	require.NoError(t, err)
+	signingRoot, err := ctypes.NewForkData(
+		version.FromUint32[common.Version](
+			b.cs.ActiveForkVersionForEpoch(epoch),
+		), b.gvr,
+	).ComputeRandaoSigningRoot(b.cs.DomainTypeRandao(), epoch)
+	require.NoError(t, err)
+	reveal, err := b.keys[0].Sign(signingRoot[:])
+	require.NoError(t, err)
+
+	blk, err := (&components.BeaconBlock{}).NewWithVersion(
+		slot, 0, parentRoot, b.cs.ActiveForkVersionForSlot(slot),
+	)
+	require.NoError(t, err)
+	body := blk.GetBody()
+	body.SetRandaoReveal(reveal)
+	body.SetEth1Data((&ctypes.Eth1Data{}).New(
+		root, math.U64(count), lph.GetBlockHash(),
+	))
+	body.SetDeposits(deposits)
+	body.SetDepositProofs(true)
+	require.NoError(t, body.SetExecutionData(&components.ExecutionPayload{
+		InnerExecutionPayload: &ctypes.ExecutableDataDeneb{
+			ParentHash:   lph.GetBlockHash(),
+			LogsBloom:    make([]byte, ctypes.LogsBloomSize),
+			Random:       mix,
+			Number:       lph.GetNumber() + 1,
+			Timestamp:    lph.GetTimestamp() + 1,
+			ExtraData:    []byte{},
+			BlockHash:    common.ExecutionHash{byte(slot)},
+			Transactions: [][]byte{},
+			Withdrawals:  withdrawals,
+		},
+	}))
+
+	post := b.st.Copy()
+	_, err = b.sp.Transition(
+		&transition.Context{
+			Context:            context.Background(),
+			SkipValidateResult: true,
+		},
+		post, blk,
+	)
+	require.NoError(t, err)
+	stateRoot, err := post.HashTreeRoot()
+	require.NoError(t, err)
+	blk.SetStateRoot(stateRoot)
+	b.st = post
+
+	bz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	block := cmttypes.MakeBlock(
+		int64(slot), []cmttypes.Tx{bz}, &cmttypes.Commit{}, nil,
+	)
+	block.ProposerAddress = make(cmttypes.Address, cmtcrypto.AddressSize)
+	parts, err := block.MakePartSet(cmttypes.BlockPartSizeBytes)
+	require.NoError(t, err)
+	b.blocks.SaveBlock(block, parts, &cmttypes.Commit{Height: block.Height})
+}
+
+func TestReplay_DepositFinalization(t *testing.T) {
+	data := spec.BaseSpec()
+	data.DepositProofForkEpoch = 0
+	cs := chain.NewChainSpec(data)
+
+	b := newChainBuilder(t, cs, 4)
+	gen := b.genesis()
+	b.deposit(1)
+	b.deposit(2)
+	b.propose()
+	b.deposit(3)
+	b.propose()
+	b.propose()
+	// The deposit store forgets the roots the first blocks voted for once
+	// finalized past them.
+	require.NoError(t, b.deposits.FinalizeDeposits(
+		4, common.ExecutionHash{2}, 2,
+	))
+	_, known := b.deposits.DepositRoot(3)
+	require.False(t, known)
+
+	slotClock, err := clock.NewSlotClock(time.Second, 0)
+	require.NoError(t, err)
+	sp, err := newStateProcessor(
+		cs, acceptingEngine{}, unknownDepositRoots{}, slotClock,
+	)
+	require.NoError(t, err)
+	key := storetypes.NewKVStoreKey(beacon.ModuleName)
+	cms := rootmulti.NewStore(
+		dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics(),
+	)
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	r := &replayer{
+		cs:        cs,
+		sp:        sp,
+		slotClock: slotClock,
+		blocks:    b.blocks,
+		// No state is held, so only the state roots of the blocks are
+		// compared.
+		app: &appStore{
+			cms: cms,
+			kv:  newKVStore(key),
+			cs:  cs,
+			ctx: server.NewDefaultContext(),
+		},
+	}
+
+	st, err := newMemoryState(cs, log.NewNopLogger())
+	require.NoError(t, err)
+	_, err = sp.InitializePreminedBeaconStateFromEth1(
+		st.BeaconState,
+		gen.Deposits,
+		gen.ExecutionPayloadHeader,
+		gen.ForkVersion,
+	)
+	require.NoError(t, err)
+
+	// Payloads are verified as with a real execution client, under which
+	// the eth1 data voted for is checked.
+	report, err := r.replay(context.Background(), st, 1, 3)
+	require.NoError(t, err)
+	require.Nil(t, report.Divergence)
+	require.Equal(t, 3, report.Replayed)
+
+	// Rebuilt from the replayed blocks, the state matches the one of the
+	// chain.
+	got, err := st.HashTreeRoot()
+	require.NoError(t, err)
+	want, err := b.st.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+	index, err := st.GetEth1DepositIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), index)
+}
@@ -0,0 +1,362 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/hex"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/spf13/cobra"
+)
+
+// stateSummary holds the fields of a beacon state, bar its validators and
+// historical vectors.
+type stateSummary struct {
+	Height                       int64                    `json:"height"`
+	Slot                         math.Slot                `json:"slot"`
+	Fork                         *types.Fork              `json:"fork"`
+	GenesisValidatorsRoot        common.Root              `json:"genesisValidatorsRoot"`
+	LatestBlockHeader            *types.BeaconBlockHeader `json:"latestBlockHeader"`
+	LatestExecutionBlockHash     common.ExecutionHash     `json:"latestExecutionBlockHash"`
+	LatestExecutionBlockNumber   math.U64                 `json:"latestExecutionBlockNumber"`
+	Eth1Data                     *types.Eth1Data          `json:"eth1Data"`
+	Eth1DepositIndex             uint64                   `json:"eth1DepositIndex"`
+	NextWithdrawalIndex          uint64                   `json:"nextWithdrawalIndex"`
+	NextWithdrawalValidatorIndex math.ValidatorIndex      `json:"nextWithdrawalValidatorIndex"`
+	TotalSlashing                math.Gwei                `json:"totalSlashing"`
+	TotalValidators              uint64                   `json:"totalValidators"`
+}
+
+// validatorEntry is a validator along with its index and balance.
+type validatorEntry struct {
+	Index   math.ValidatorIndex `json:"index"`
+	Balance math.Gwei           `json:"balance"`
+	*types.Validator
+}
+
+// stateRootCheck is the recomputed root of a beacon state, along with the
+// one recorded by the following state.
+type stateRootCheck struct {
+	Height   int64        `json:"height"`
+	Slot     math.Slot    `json:"slot"`
+	Root     common.Root  `json:"root"`
+	Recorded *common.Root `json:"recorded"`
+	Match    *bool        `json:"match"`
+}
+
+// NewStateCmd creates a new command for printing the fields of the beacon
+// state.
+func NewStateCmd(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "Prints the fields of the beacon state",
+		Long: `Prints the fields of the beacon state at the latest height, or
+the one given by --height, bar its validators and historical vectors.`,
+		Args: cobra.NoArgs,
+		RunE: withState(chainSpec, func(
+			cmd *cobra.Command, st *beaconState,
+		) error {
+			summary, err := summarize(st)
+			if err != nil {
+				return err
+			}
+
+			t := newTable("FIELD", "VALUE")
+			t.add("height", summary.Height)
+			t.add("slot", summary.Slot)
+			t.add("fork.previous_version", summary.Fork.PreviousVersion)
+			t.add("fork.current_version", summary.Fork.CurrentVersion)
+			t.add("fork.epoch", summary.Fork.Epoch)
+			t.add("genesis_validators_root", summary.GenesisValidatorsRoot)
+			t.add("latest_block_header.slot", summary.LatestBlockHeader.Slot)
+			t.add(
+				"latest_block_header.proposer_index",
+				summary.LatestBlockHeader.ProposerIndex,
+			)
+			t.add(
+				"latest_block_header.parent_root",
+				summary.LatestBlockHeader.ParentBlockRoot,
+			)
+			t.add(
+				"latest_block_header.state_root",
+				summary.LatestBlockHeader.StateRoot,
+			)
+			t.add(
+				"latest_block_header.body_root",
+				summary.LatestBlockHeader.BodyRoot,
+			)
+			t.add(
+				"latest_execution_block_hash",
+				summary.LatestExecutionBlockHash,
+			)
+			t.add(
+				"latest_execution_block_number",
+				summary.LatestExecutionBlockNumber,
+			)
+			t.add("eth1_data.deposit_root", summary.Eth1Data.DepositRoot)
+			t.add("eth1_data.deposit_count", summary.Eth1Data.DepositCount)
+			t.add("eth1_data.block_hash", summary.Eth1Data.BlockHash)
+			t.add("eth1_deposit_index", summary.Eth1DepositIndex)
+			t.add("next_withdrawal_index", summary.NextWithdrawalIndex)
+			t.add(
+				"next_withdrawal_validator_index",
+				summary.NextWithdrawalValidatorIndex,
+			)
+			t.add("total_slashing", summary.TotalSlashing)
+			t.add("total_validators", summary.TotalValidators)
+			return render(cmd, summary, t)
+		}),
+	}
+	addHeightFlag(cmd)
+	return cmd
+}
+
+// NewValidatorsCmd creates a new command for listing the validators of the
+// beacon state.
+func NewValidatorsCmd(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validators",
+		Short: "Lists the validators of the beacon state",
+		Long: `Lists the validators of the beacon state at the latest height, or
+the one given by --height, along with their balances.`,
+		Args: cobra.NoArgs,
+		RunE: withState(chainSpec, func(
+			cmd *cobra.Command, st *beaconState,
+		) error {
+			validators, err := st.kv.GetValidators()
+			if err != nil {
+				return err
+			}
+			balances, err := st.kv.GetBalances()
+			if err != nil {
+				return err
+			}
+
+			entries := make([]validatorEntry, len(validators))
+			t := newTable(
+				"INDEX", "PUBKEY", "EFFECTIVE BALANCE", "BALANCE", "SLASHED",
+				"ACTIVATION EPOCH", "EXIT EPOCH", "WITHDRAWAL CREDENTIALS",
+			)
+			for i, validator := range validators {
+				entries[i] = validatorEntry{
+					Index:     math.ValidatorIndex(i),
+					Validator: validator,
+				}
+				if i < len(balances) {
+					entries[i].Balance = math.Gwei(balances[i])
+				}
+				t.add(
+					i,
+					validator.Pubkey,
+					validator.EffectiveBalance,
+					entries[i].Balance,
+					validator.Slashed,
+					validator.ActivationEpoch,
+					validator.ExitEpoch,
+					hex.FromBytes(validator.WithdrawalCredentials[:]).Unwrap(),
+				)
+			}
+			return render(cmd, entries, t)
+		}),
+	}
+	addHeightFlag(cmd)
+	return cmd
+}
+
+// NewStateRootCmd creates a new command for recomputing the hash tree root
+// of the beacon state.
+func NewStateRootCmd(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-root",
+		Short: "Recomputes and checks the hash tree root of the beacon state",
+		Long: `Recomputes the hash tree root of the beacon state at the height
+given by --height, the one below the latest height by default, and compares
+it with the root recorded by the state of the following height. The command
+fails if they differ. The root of the latest state is printed alone, as no
+state records it yet.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			height, err := cmd.Flags().GetInt64(flagHeight)
+			if err != nil {
+				return err
+			}
+			s, err := openAppStore(cmd, chainSpec)
+			if err != nil {
+				return err
+			}
+			defer s.Close()
+
+			if height == 0 {
+				height = max(s.LatestHeight()-1, 1)
+			}
+			check, err := checkStateRoot(s, height, chainSpec)
+			if err != nil {
+				return err
+			}
+
+			t := newTable("HEIGHT", "SLOT", "ROOT", "RECORDED", "MATCH")
+			if check.Recorded != nil {
+				t.add(
+					check.Height, check.Slot, check.Root,
+					*check.Recorded, *check.Match,
+				)
+			} else {
+				t.add(check.Height, check.Slot, check.Root, "-", "-")
+			}
+			if err = render(cmd, check, t); err != nil {
+				return err
+			}
+			if check.Match != nil && !*check.Match {
+				return errors.Wrapf(
+					ErrStateRootMismatch, "height %d", check.Height,
+				)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Int64(
+		flagHeight, 0, "height of the state, below the latest if unset",
+	)
+	return cmd
+}
+
+// withState returns a command handler running fn with the beacon state at
+// the height given by the height flag.
+func withState(
+	chainSpec common.ChainSpec,
+	fn func(*cobra.Command, *beaconState) error,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		height, err := cmd.Flags().GetInt64(flagHeight)
+		if err != nil {
+			return err
+		}
+		s, err := openAppStore(cmd, chainSpec)
+		if err != nil {
+			return err
+		}
+		defer s.Close()
+
+		st, err := s.StateAt(height)
+		if err != nil {
+			return err
+		}
+		return fn(cmd, st)
+	}
+}
+
+// addHeightFlag adds the height flag to the given command.
+func addHeightFlag(cmd *cobra.Command) {
+	cmd.Flags().Int64(flagHeight, 0, "height of the state, latest if unset")
+}
+
+// summarize returns the fields of the given beacon state.
+//
+//nolint:funlen // one getter per field.
+func summarize(st *beaconState) (*stateSummary, error) {
+	var (
+		summary = &stateSummary{Height: st.height}
+		err     error
+	)
+	if summary.Slot, err = st.kv.GetSlot(); err != nil {
+		return nil, err
+	}
+	if summary.Fork, err = st.kv.GetFork(); err != nil {
+		return nil, err
+	}
+	if summary.GenesisValidatorsRoot, err =
+		st.kv.GetGenesisValidatorsRoot(); err != nil {
+		return nil, err
+	}
+	if summary.LatestBlockHeader, err =
+		st.kv.GetLatestBlockHeader(); err != nil {
+		return nil, err
+	}
+	lph, err := st.kv.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+	summary.LatestExecutionBlockHash = lph.GetBlockHash()
+	summary.LatestExecutionBlockNumber = lph.GetNumber()
+	if summary.Eth1Data, err = st.kv.GetEth1Data(); err != nil {
+		return nil, err
+	}
+	if summary.Eth1DepositIndex, err =
+		st.kv.GetEth1DepositIndex(); err != nil {
+		return nil, err
+	}
+	if summary.NextWithdrawalIndex, err =
+		st.kv.GetNextWithdrawalIndex(); err != nil {
+		return nil, err
+	}
+	if summary.NextWithdrawalValidatorIndex, err =
+		st.kv.GetNextWithdrawalValidatorIndex(); err != nil {
+		return nil, err
+	}
+	if summary.TotalSlashing, err = st.kv.GetTotalSlashing(); err != nil {
+		return nil, err
+	}
+	if summary.TotalValidators, err =
+		st.kv.GetTotalValidators(); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// checkStateRoot recomputes the root of the beacon state at the given
+// height and compares it with the one recorded by the state of the
+// following height, if held.
+func checkStateRoot(
+	s *appStore,
+	height int64,
+	chainSpec common.ChainSpec,
+) (*stateRootCheck, error) {
+	st, err := s.StateAt(height)
+	if err != nil {
+		return nil, err
+	}
+	check := &stateRootCheck{Height: height}
+	if check.Slot, err = st.GetSlot(); err != nil {
+		return nil, err
+	}
+	if check.Root, err = st.HashTreeRoot(); err != nil {
+		return nil, err
+	}
+	if height >= s.LatestHeight() {
+		return check, nil
+	}
+
+	next, err := s.StateAt(height + 1)
+	if err != nil {
+		return nil, err
+	}
+	recorded, err := next.StateRootAtIndex(
+		check.Slot.Unwrap() % chainSpec.SlotsPerHistoricalRoot(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	match := recorded == check.Root
+	check.Recorded, check.Match = &recorded, &match
+	return check, nil
+}
@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	storev2 "cosmossdk.io/store/v2/db"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	beacon "github.com/berachain/beacon-kit/mod/node-core/pkg/components/module"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
+	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/store"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+// appStore is a read-only view of the beacon states held by the application
+// database of a stopped node.
+type appStore struct {
+	db  dbm.DB
+	cms *rootmulti.Store
+	kv  *components.KVStore
+	cs  common.ChainSpec
+	ctx *server.Context
+}
+
+// beaconState is the beacon state at a height, along with the store it is
+// read from.
+type beaconState struct {
+	components.BeaconState
+	kv     *components.KVStore
+	height int64
+}
+
+// openAppStore opens the application database of the node read-only.
+func openAppStore(
+	cmd *cobra.Command,
+	cs common.ChainSpec,
+) (*appStore, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	db, err := server.OpenDB(
+		serverCtx.Config.RootDir, server.GetAppDBBackend(serverCtx.Viper),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	key := storetypes.NewKVStoreKey(beacon.ModuleName)
+	cms := rootmulti.NewStore(
+		readOnlyDB{db}, serverCtx.Logger, metrics.NewNoOpMetrics(),
+	)
+	// Upgrading the trees to fast nodes would write to the database.
+	cms.SetIAVLDisableFastNode(true)
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	if err = cms.LoadLatestVersion(); err != nil {
+		return nil, errors.Join(err, db.Close())
+	}
+
+	return &appStore{
+		db:  db,
+		cms: cms,
+		kv:  newKVStore(key),
+		cs:  cs,
+		ctx: serverCtx,
+	}, nil
+}
+
+// newKVStore returns the beacon state store backed by the given key.
+func newKVStore(key *storetypes.KVStoreKey) *components.KVStore {
+	return components.ProvideKVStore(components.KVStoreInput{
+		Environment: appmodule.Environment{
+			KVStoreService: runtime.NewKVStoreService(key),
+		},
+	})
+}
+
+// newBeaconState returns the beacon state read from the given store.
+func newBeaconState(
+	kv *components.KVStore,
+	cs common.ChainSpec,
+	height int64,
+) *beaconState {
+	return &beaconState{
+		BeaconState: state.NewBeaconStateFromDB[
+			components.BeaconState, *components.BeaconStateMarshallable,
+		](kv, cs),
+		kv:     kv,
+		height: height,
+	}
+}
+
+// LatestHeight returns the height of the latest state held.
+func (s *appStore) LatestHeight() int64 {
+	return s.cms.LastCommitID().Version
+}
+
+// StateAt returns the beacon state at the given height, the latest one if
+// zero.
+func (s *appStore) StateAt(height int64) (*beaconState, error) {
+	if height == 0 {
+		height = s.LatestHeight()
+	}
+	ms, err := s.cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, errors.Wrapf(
+			ErrHeightUnavailable, "height %d: %v", height, err,
+		)
+	}
+
+	return newBeaconState(
+		s.kv.WithContext(sdk.NewContext(ms, false, s.ctx.Logger)),
+		s.cs,
+		height,
+	), nil
+}
+
+// Close closes the application database.
+func (s *appStore) Close() error {
+	return s.db.Close()
+}
+
+// openBlockStore opens the CometBFT block store of the node. Opening it
+// records the version of its key layout if missing, as CometBFT does.
+func openBlockStore(cmd *cobra.Command) (*store.BlockStore, error) {
+	cfg := server.GetServerContextFromCmd(cmd).Config
+	db, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{
+		ID:     "blockstore",
+		Config: cfg,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return store.NewBlockStore(
+		db, store.WithDBKeyLayout(cfg.Storage.ExperimentalKeyLayout),
+	), nil
+}
+
+// openBlobStore opens the blob sidecar store of the node read-only.
+func openBlobStore(cmd *cobra.Command) *filedb.RangeDB {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	return filedb.NewRangeDB(
+		filedb.NewDB(
+			filedb.WithRootDirectory(
+				filepath.Join(serverCtx.Config.RootDir, "data", "blobs"),
+			),
+			filedb.WithFileExtension("ssz"),
+			filedb.WithLogger(serverCtx.Logger),
+			filedb.WithLegacyValidator(dastore.ValidateSidecar),
+			filedb.WithReadOnly(),
+		),
+	)
+}
+
+// openDepositStore opens the deposit store of the node read-only. The
+// returned function closes it.
+func openDepositStore(
+	cmd *cobra.Command,
+) (*components.DepositStore, func() error, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	kvp, err := storev2.NewDB(
+		storev2.DBTypePebbleDB,
+		"deposits",
+		filepath.Join(serverCtx.Config.RootDir, "data"),
+		nil,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	store, err := depositstore.NewStore[*components.Deposit](
+		&depositstore.KVStoreProvider{
+			KVStoreWithBatch: readOnlyKVStore{kvp},
+		},
+	)
+	if err != nil {
+		return nil, nil, errors.Join(err, kvp.Close())
+	}
+	return store, kvp.Close, nil
+}
@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/spf13/cobra"
+)
+
+// broadcastDepositTx sends the deposit of the given message and signature to
+// the deposit contract, paid for by the key of the private key flag, and
+// waits for it to be included. It then checks that the contract emitted the
+// deposit.
+func broadcastDepositTx(
+	cmd *cobra.Command,
+	logger log.Logger,
+	chainSpec common.ChainSpec,
+	depositMsg *types.DepositMessage,
+	signature crypto.BLSSignature,
+) error {
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	privKeyHex, err := cmd.Flags().GetString(privateKey)
+	if err != nil {
+		return err
+	}
+	if privKeyHex == "" {
+		return ErrPrivateKeyRequired
+	}
+	privKey, err := gethcrypto.HexToECDSA(strings.TrimPrefix(privKeyHex, "0x"))
+	if err != nil {
+		return errors.Wrap(ErrInvalidPrivateKey, err.Error())
+	}
+
+	client, err := dialEngineRPC(ctx, cmd)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(privKey, chainID)
+	if err != nil {
+		return err
+	}
+	opts.Context = ctx
+	opts.Value = depositMsg.Amount.ToWei()
+	// The transaction is only built and signed here, so that it can be
+	// reported before it is sent. Its gas limit is estimated by the binding.
+	opts.NoSend = true
+
+	contract, err := deposit.NewBeaconDepositContract(
+		chainSpec.DepositContractAddress(), client,
+	)
+	if err != nil {
+		return err
+	}
+	tx, err := contract.Deposit(
+		opts,
+		depositMsg.Pubkey[:],
+		depositMsg.Credentials[:],
+		depositMsg.Amount.Unwrap(),
+		signature[:],
+	)
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"Sending deposit transaction",
+		"hash", tx.Hash().Hex(),
+		"from", opts.From.Hex(),
+		"gas", tx.Gas(),
+	)
+	if err = client.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+
+	receipt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil {
+		return err
+	}
+	if receipt.Status != gethtypes.ReceiptStatusSuccessful {
+		return errors.Wrapf(
+			ErrDepositTransactionFailed, "%s", tx.Hash().Hex(),
+		)
+	}
+
+	index, err := verifyDepositLog(
+		contract, chainSpec.DepositContractAddress(), receipt, depositMsg,
+	)
+	if err != nil {
+		return err
+	}
+	logger.Info(
+		"Deposit included 🫡",
+		"hash", tx.Hash().Hex(),
+		"block", receipt.BlockNumber,
+		"index", index,
+	)
+	return nil
+}
+
+// dialEngineRPC dials the execution client at the engine RPC URL flag,
+// authenticated with the JWT secret at the JWT secret flag.
+func dialEngineRPC(
+	ctx context.Context,
+	cmd *cobra.Command,
+) (*ethclient.Client, error) {
+	url, err := cmd.Flags().GetString(engineRPCURL)
+	if err != nil {
+		return nil, err
+	}
+	jwtPath, err := cmd.Flags().GetString(jwtSecretPath)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := components.LoadJWTFromFile(jwtPath)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcClient, err := rpc.DialOptions(
+		ctx, url, rpc.WithHTTPAuth(node.NewJWTAuth(*secret)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ethclient.NewClient(rpcClient), nil
+}
+
+// verifyDepositLog checks that the given receipt holds the Deposit event of
+// the given deposit message, emitted by the contract at the given address,
+// and returns the index of the deposit.
+func verifyDepositLog(
+	contract *deposit.BeaconDepositContract,
+	address common.ExecutionAddress,
+	receipt *gethtypes.Receipt,
+	depositMsg *types.DepositMessage,
+) (uint64, error) {
+	for _, l := range receipt.Logs {
+		if l.Address != address {
+			continue
+		}
+		event, err := contract.ParseDeposit(*l)
+		if err != nil {
+			// Not a Deposit event.
+			continue
+		}
+		if !bytes.Equal(event.Pubkey, depositMsg.Pubkey[:]) ||
+			event.Amount != depositMsg.Amount.Unwrap() {
+			return 0, errors.Wrapf(
+				ErrDepositLogMismatch,
+				"got pubkey 0x%x and amount %d",
+				event.Pubkey, event.Amount,
+			)
+		}
+		return event.Index, nil
+	}
+	return 0, errors.Wrapf(
+		ErrDepositLogNotFound, "%s", receipt.TxHash.Hex(),
+	)
+}
@@ -67,12 +67,8 @@ func NewCreateValidator(chainSpec common.ChainSpec) *cobra.Command {
 	return cmd
 }
 
-// createValidatorCmd returns a command that builds a create validator request.
-//
-// TODO: Implement broadcast functionality. Currently, the implementation works
-// for the geth client but something about the Deposit binding is not handling
-// other execution layers correctly. Peep the commit history for what we had.
-// 🤷‍♂️.
+// createValidatorCmd returns a command that builds a create validator request
+// and, if the broadcast flag is set, sends it to the deposit contract.
 func createValidatorCmd(
 	chainSpec common.ChainSpec,
 ) func(*cobra.Command, []string) error {
@@ -129,8 +125,7 @@ func createValidatorCmd(
 			return err
 		}
 
-		// If the broadcast flag is not set, output the deposit message and
-		// signature and return early.
+		// Output the deposit message and signature.
 		logger.Info(
 			"Deposit Message CallData",
 			"pubkey", depositMsg.Pubkey.String(),
@@ -139,10 +134,18 @@ func createValidatorCmd(
 			"signature", signature.String(),
 		)
 
-		// TODO: once broadcast is fixed, remove this.
-		logger.Info("Send the above calldata to the deposit contract 🫡")
+		broadcast, err := cmd.Flags().GetBool(broadcastDeposit)
+		if err != nil {
+			return err
+		}
+		if !broadcast {
+			logger.Info("Send the above calldata to the deposit contract 🫡")
+			return nil
+		}
 
-		return nil
+		return broadcastDepositTx(
+			cmd, logger, chainSpec, depositMsg, signature,
+		)
 	}
 }
 
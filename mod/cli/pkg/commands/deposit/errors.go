@@ -28,4 +28,28 @@ var (
 	ErrValidatorPrivateKeyRequired = errors.New(
 		"validator private key required",
 	)
+
+	// ErrPrivateKeyRequired is returned when the deposit is broadcast but no
+	// private key is provided to pay for it.
+	ErrPrivateKeyRequired = errors.New(
+		"private key required to broadcast the deposit",
+	)
+
+	// ErrInvalidPrivateKey is returned when the private key to pay for the
+	// deposit cannot be parsed.
+	ErrInvalidPrivateKey = errors.New("invalid private key")
+
+	// ErrDepositTransactionFailed is returned when the deposit transaction
+	// is included but reverted.
+	ErrDepositTransactionFailed = errors.New("deposit transaction failed")
+
+	// ErrDepositLogNotFound is returned when the deposit transaction did not
+	// emit a Deposit event.
+	ErrDepositLogNotFound = errors.New("deposit event not found")
+
+	// ErrDepositLogMismatch is returned when the Deposit event emitted by the
+	// deposit transaction does not match the deposit message.
+	ErrDepositLogMismatch = errors.New(
+		"deposit event does not match the deposit message",
+	)
 )
@@ -51,6 +51,7 @@ func Commands() *cobra.Command {
 	cmd.AddCommand(
 		NewGenerateJWTCommand(),
 		NewValidateJWTCommand(),
+		NewRotateJWTCommand(),
 	)
 
 	return cmd
@@ -104,6 +105,31 @@ file name "jwt.hex" in the current directory.`,
 	return cmd
 }
 
+// NewRotateJWTCommand creates a new command for rotating a JWT secret.
+func NewRotateJWTCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate",
+		Short: "Replaces a JWT secret with a newly generated one",
+		Long: `This command replaces an existing JWT secret with a newly generated
+one. The file is replaced atomically, so that a running node reloads the new
+secret without a restart, while still authenticating with the previous one
+until the execution client is restarted with the new secret. If no file path is
+specified, it uses the default file name "jwt.hex" in the current directory.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// Get the file path from the command flags.
+			outputPath, err := getFilePath(cmd, FlagOutputPath)
+			if err != nil {
+				return err
+			}
+
+			return rotateAuthSecretInFile(cmd, outputPath)
+		},
+	}
+	cmd.Flags().StringP(
+		FlagOutputPath, "o", "", "Optional file path of the JWT secret")
+	return cmd
+}
+
 // getFilePath retrieves the file path for the JWT secret from the command flag.
 // If no path is specified, it returns the default secret file name.
 func getFilePath(cmd *cobra.Command, path string) (string, error) {
@@ -166,6 +192,54 @@ func generateAuthSecretInFile(cmd *cobra.Command, fileName string) error {
 	return nil
 }
 
+// rotateAuthSecretInFile atomically replaces the valid JWT secret held by the
+// specified file with a newly generated one.
+func rotateAuthSecretInFile(cmd *cobra.Command, fileName string) error {
+	// The secret being replaced must be valid, for the node to have loaded
+	// it in the first place.
+	if _, err := components.LoadJWTFromFile(fileName); err != nil {
+		return err
+	}
+	info, err := os.Stat(fileName)
+	if err != nil {
+		return err
+	}
+
+	secret, err := jwt.NewRandom()
+	if err != nil {
+		return err
+	}
+
+	// Write the new secret next to the file and rename it over the file, so
+	// that the file never holds a partially written secret.
+	tmp, err := os.CreateTemp(filepath.Dir(fileName), ".jwt-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.WriteString(secret.Hex()); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Chmod(info.Mode().Perm()); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp.Name(), fileName); err != nil {
+		return err
+	}
+
+	cmd.Printf(
+		"Successfully rotated JSON-RPC authentication secret in: %s\n"+
+			"Restart the execution client for it to use the new secret.",
+		fileName,
+	)
+	return nil
+}
+
 func validateJWTSecret(cmd *cobra.Command, filePath string) error {
 	_, err := components.LoadJWTFromFile(filePath)
 	if err != nil {
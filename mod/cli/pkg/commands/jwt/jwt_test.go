@@ -118,3 +118,31 @@ func checkAuthFileIntegrity(tb testing.TB, fPath string) {
 	require.NoError(tb, err)
 	require.Len(tb, decoded, 32)
 }
+
+func Test_NewRotateJWTCommand(t *testing.T) {
+	path := filepath.Join(t.TempDir(), jwt.DefaultSecretFileName)
+
+	// Only a valid secret can be rotated.
+	cmd := jwt.NewRotateJWTCommand()
+	cmd.SetArgs([]string{"--output-path", path})
+	require.Error(t, cmd.Execute())
+
+	cmd = jwt.NewGenerateJWTCommand()
+	cmd.SetArgs([]string{"--output-path", path})
+	require.NoError(t, cmd.Execute())
+	previous, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	cmd = jwt.NewRotateJWTCommand()
+	cmd.SetArgs([]string{"--output-path", path})
+	require.NoError(t, cmd.Execute())
+	checkAuthFileIntegrity(t, path)
+	rotated, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotEqual(t, previous, rotated)
+
+	// No temporary file is left behind.
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rollback
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagHard is the flag to remove the blocks rolled back as well.
+	flagHard = "hard"
+	// flagHeight is the flag for the height to roll back to.
+	flagHeight = "height"
+)
+
+// NewRollbackCmd creates a command to roll back the CometBFT state, the
+// beacon state and the stores kept alongside it to an earlier height.
+func NewRollbackCmd[T types.Node](
+	appCreator servertypes.AppCreator[T],
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rolls back the node state to an earlier height",
+		Long: `Rolls back the CometBFT state, the beacon state and the stores
+kept alongside it, the blob sidecars and deposits, to an earlier height, one
+below the current height unless --height is set. The head of the execution
+client is moved back to the execution payload of that height, so the
+execution client must be reachable and still hold it.
+
+Nothing is rolled back if the state or store data needed at that height has
+already been pruned. Rolling back more than one height removes the blocks
+rolled back, which requires --hard. In-memory caches, such as the payload
+cache, start empty on the next start of the node.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			hard, err := cmd.Flags().GetBool(flagHard)
+			if err != nil {
+				return err
+			}
+			height, err := cmd.Flags().GetInt64(flagHeight)
+			if err != nil {
+				return err
+			}
+			return rollback(cmd, appCreator, height, hard)
+		},
+	}
+
+	cmd.Flags().Bool(flagHard, false, "remove the blocks as well as state")
+	cmd.Flags().Int64(
+		flagHeight, 0, "height to roll back to, the previous one if unset",
+	)
+	return cmd
+}
+
+// rollback rolls the node back to the given height, the previous one if
+// zero, after checking that every store can be rolled back to it.
+func rollback[T types.Node](
+	cmd *cobra.Command,
+	appCreator servertypes.AppCreator[T],
+	height int64,
+	hard bool,
+) error {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	db, err := server.OpenDB(
+		serverCtx.Config.RootDir, server.GetAppDBBackend(serverCtx.Viper),
+	)
+	if err != nil {
+		return err
+	}
+	app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+
+	var (
+		chain *components.ChainService
+		dbm   *components.DBManager
+	)
+	if err = app.FetchService(&chain); err != nil {
+		return err
+	}
+	if err = app.FetchService(&dbm); err != nil {
+		return err
+	}
+
+	cms := app.CommitMultiStore()
+	current := cms.LastCommitID().Version
+	if height == 0 {
+		height = current - 1
+	}
+	switch {
+	case height <= 0 || height >= current:
+		return errors.Wrapf(
+			ErrInvalidTarget, "height %d, current height %d", height, current,
+		)
+	case height < current-1 && !hard:
+		return ErrHardRequired
+	}
+
+	// Check everything can be rolled back before changing anything.
+	target, err := cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return errors.Wrapf(ErrStatePruned, "height %d: %v", height, err)
+	}
+	if err = chain.CanRollback(
+		sdk.NewContext(target, false, serverCtx.Logger), dbm,
+	); err != nil {
+		return err
+	}
+
+	rolledBack, hash, err := cmtcmd.RollbackState(serverCtx.Config, hard)
+	for err == nil && hard && rolledBack > height {
+		rolledBack, hash, err = cmtcmd.RollbackState(serverCtx.Config, hard)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to rollback CometBFT state")
+	}
+	if rolledBack != height {
+		return errors.Wrapf(
+			ErrInvalidTarget,
+			"CometBFT state rolled back to height %d instead of %d",
+			rolledBack, height,
+		)
+	}
+
+	if err = cms.RollbackToVersion(height); err != nil {
+		return errors.Wrap(err, "failed to rollback to version")
+	}
+	if err = chain.Rollback(
+		sdk.NewContext(target, false, serverCtx.Logger), dbm,
+	); err != nil {
+		return err
+	}
+
+	cmd.Printf("Rolled back state to height %d and hash %X\n", height, hash)
+	return nil
+}
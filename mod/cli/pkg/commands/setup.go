@@ -21,12 +21,15 @@
 package commands
 
 import (
-	confixcmd "cosmossdk.io/tools/confix/cmd"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/client"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/cometbft"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/config"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/db"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposit"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/genesis"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/jwt"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/rollback"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/signer"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -59,11 +62,13 @@ func DefaultRootCommandSetup[T types.Node](
 		// `client`
 		client.Commands(),
 		// `config`
-		confixcmd.ConfigCommand(),
+		config.Commands(),
 		// `init`
 		genutilcli.InitCmd(mm),
 		// `genesis`
 		genesis.Commands(chainSpec),
+		// `db`
+		db.Commands(chainSpec),
 		// `deposit`
 		deposit.Commands(chainSpec),
 		// `jwt`
@@ -72,8 +77,12 @@ func DefaultRootCommandSetup[T types.Node](
 		keys.Commands(),
 		// `prune`
 		pruning.Cmd(appCreator),
+		// `replay`
+		db.NewReplayCmd(chainSpec),
 		// `rollback`
-		server.NewRollbackCmd(appCreator),
+		rollback.NewRollbackCmd(appCreator),
+		// `signer`
+		signer.Commands(),
 		// `snapshots`
 		snapshot.Cmd(appCreator),
 		// `start`
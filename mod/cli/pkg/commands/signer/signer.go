@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/slashingprotection"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// Commands creates a new command for signer related actions.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "signer",
+		Short:                      "signer subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewSlashingProtectionCommand(),
+	)
+
+	return cmd
+}
+
+// NewSlashingProtectionCommand creates a new command for managing the
+// slashing protection history of the node.
+func NewSlashingProtectionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "slashing-protection",
+		Short:                      "slashing protection subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewImportCommand(),
+		NewExportCommand(),
+	)
+
+	return cmd
+}
+
+// NewImportCommand creates a new command for importing a slashing
+// protection history.
+func NewImportCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import [file]",
+		Short: "Imports an EIP-3076 slashing protection history",
+		Long: `Imports a slashing protection history in the EIP-3076
+interchange format into the slashing protection database of the node, which
+must not be running. The history is merged with the one of the node.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			interchange := new(slashingprotection.Interchange)
+			if err = json.Unmarshal(bz, interchange); err != nil {
+				return err
+			}
+
+			store, err := openStore(cmd)
+			if err != nil {
+				return err
+			}
+			defer store.Close()
+
+			if err = store.Import(interchange); err != nil {
+				return err
+			}
+			cmd.Printf(
+				"Successfully imported the slashing protection history of "+
+					"%d validators\n", len(interchange.Data),
+			)
+			return nil
+		},
+	}
+}
+
+// NewExportCommand creates a new command for exporting the slashing
+// protection history.
+func NewExportCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export [file]",
+		Short: "Exports the slashing protection history as EIP-3076",
+		Long: `Exports the slashing protection database of the node, which
+must not be running, in the EIP-3076 interchange format. The history is
+written to the given file, or to the standard output if none is given.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := openStore(cmd)
+			if err != nil {
+				return err
+			}
+			defer store.Close()
+
+			interchange, err := store.Export()
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(interchange, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 0 {
+				cmd.Printf("%s\n", bz)
+				return nil
+			}
+			//#nosec:G306 // the history is not secret.
+			return os.WriteFile(args[0], append(bz, '\n'), 0o644)
+		},
+	}
+}
+
+// openStore opens the slashing protection store of the node.
+func openStore(cmd *cobra.Command) (*slashingprotection.Store, error) {
+	clientCtx, ok := cmd.Context().
+		Value(client.ClientContextKey).(*client.Context)
+	if !ok {
+		return nil, ErrNoClientCtx
+	}
+	return components.OpenSlashingProtection(clientCtx.HomeDir)
+}
@@ -25,6 +25,7 @@ import (
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
 	flags "github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -38,10 +39,15 @@ type LoggerInput struct {
 }
 
 // ProvideLogger creates a the default phuslu logger.
-// It reads the log level and format from the server context.
+// It reads the log level from the server context and the logger
+// configuration from the app config.
 func ProvideLogger(
 	in LoggerInput,
 ) (log.Logger, error) {
 	logLvlStr := cast.ToString(in.AppOpts.Get(flags.FlagLogLevel))
-	return phuslu.NewLogger[log.Logger](logLvlStr, in.Out), nil
+	cfg, err := config.ReadConfigFromAppOpts(in.AppOpts)
+	if err != nil {
+		return nil, err
+	}
+	return phuslu.NewLogger[log.Logger](logLvlStr, in.Out, &cfg.Logger), nil
 }
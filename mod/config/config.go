@@ -21,13 +21,22 @@
 package config
 
 import (
+	"github.com/berachain/beacon-kit/mod/beacon/remotesigner"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/config/pkg/template"
 	viperlib "github.com/berachain/beacon-kit/mod/config/pkg/viper"
+	"github.com/berachain/beacon-kit/mod/da/pkg/archive"
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
 	"github.com/berachain/beacon-kit/mod/errors"
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/p2p"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/telemetry"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/tracing"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
@@ -40,21 +49,48 @@ type AppOptions interface {
 // DefaultConfig returns the default configuration for a BeaconKit chain.
 func DefaultConfig() *Config {
 	return &Config{
+		BlobArchive:    archive.DefaultConfig(),
+		BlobGossip:     p2p.DefaultConfig(),
+		BlobStore:      filedb.DefaultConfig(),
 		Engine:         engineclient.DefaultConfig(),
 		KZG:            kzg.DefaultConfig(),
+		Logger:         *phuslu.DefaultConfig(),
+		Metrics:        telemetry.DefaultConfig(),
+		Tracing:        tracing.DefaultConfig(),
 		PayloadBuilder: builder.DefaultConfig(),
+		Relay:          relay.DefaultConfig(),
+		RemoteSigner:   remotesigner.DefaultConfig(),
 		Validator:      validator.DefaultConfig(),
 	}
 }
 
 // Config is the main configuration struct for the BeaconKit chain.
 type Config struct {
+	// BlobArchive is the configuration for the archive of expired blob
+	// sidecars.
+	BlobArchive archive.Config `mapstructure:"blob-archive"`
+	// BlobGossip is the configuration for gossiping blob sidecars outside
+	// of the proposals.
+	BlobGossip p2p.Config `mapstructure:"blob-gossip"`
+	// BlobStore is the configuration for the files blob sidecars are stored
+	// in.
+	BlobStore filedb.Config `mapstructure:"blob-store"`
 	// Engine is the configuration for the execution client.
 	Engine engineclient.Config `mapstructure:"engine"`
 	// KZG is the configuration for the KZG blob verifier.
 	KZG kzg.Config `mapstructure:"kzg"`
+	// Logger is the configuration for the logger.
+	Logger phuslu.Config `mapstructure:"logger"`
+	// Metrics is the configuration for the Prometheus metrics server.
+	Metrics telemetry.Config `mapstructure:"metrics"`
+	// Tracing is the configuration for OpenTelemetry tracing.
+	Tracing tracing.Config `mapstructure:"tracing"`
 	// PayloadBuilder is the configuration for the local build payload timeout.
 	PayloadBuilder builder.Config `mapstructure:"payload-builder"`
+	// Relay is the configuration for the external block builder.
+	Relay relay.Config `mapstructure:"relay"`
+	// RemoteSigner is the configuration for the remote signer.
+	RemoteSigner remotesigner.Config `mapstructure:"remote-signer"`
 	// Validator is the configuration for the validator client.
 	Validator validator.Config `mapstructure:"validator"`
 }
@@ -80,13 +116,15 @@ func MustReadConfigFromAppOpts(opts AppOptions) *Config {
 }
 
 // ReadConfigFromAppOpts reads the configuration options from the given
-// application options.
+// application options, overridden by the BEACON_KIT_* environment variables.
 func ReadConfigFromAppOpts(opts AppOptions) (*Config, error) {
 	v, ok := opts.(*viper.Viper)
 	if !ok {
 		return nil, errors.Newf("invalid application options type: %T", opts)
 	}
 
+	ApplyEnvOverrides(v)
+
 	type cfgUnmarshaller struct {
 		BeaconKit Config `mapstructure:"beacon-kit"`
 	}
@@ -107,3 +145,22 @@ func ReadConfigFromAppOpts(opts AppOptions) (*Config, error) {
 
 	return &cfg.BeaconKit, nil
 }
+
+// ReadCometBFTConfigFromAppOpts reads the CometBFT configuration from the
+// given application options, falling back to the CometBFT defaults for the
+// keys that are not set.
+func ReadCometBFTConfigFromAppOpts(opts AppOptions) (*cmtcfg.Config, error) {
+	v, ok := opts.(*viper.Viper)
+	if !ok {
+		return nil, errors.Newf("invalid application options type: %T", opts)
+	}
+
+	cfg := cmtcfg.DefaultConfig()
+	if err := v.Unmarshal(cfg); err != nil {
+		return nil, errors.Newf(
+			"failed to decode cometbft configuration: %w",
+			err,
+		)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	"github.com/berachain/beacon-kit/mod/errors"
+	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+// validConfig returns a configuration whose files exist.
+func validConfig(t *testing.T) *config.Config {
+	t.Helper()
+	dir := t.TempDir()
+
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	jwtPath := filepath.Join(dir, "jwt.hex")
+	require.NoError(t, os.WriteFile(jwtPath, []byte(secret.Hex()), 0o600))
+
+	setupPath := filepath.Join(dir, "trusted-setup.json")
+	require.NoError(t, os.WriteFile(setupPath, []byte("{}"), 0o600))
+
+	cfg := config.DefaultConfig()
+	cfg.Engine.JWTSecretPath = jwtPath
+	cfg.KZG.TrustedSetupPath = setupPath
+	cfg.PayloadBuilder.SuggestedFeeRecipient = common.ExecutionAddress{1}
+	return cfg
+}
+
+func TestValidate(t *testing.T) {
+	cfg := validConfig(t)
+	require.NoError(t, cfg.Validate(cmtcfg.DefaultConfig()))
+
+	cfg.Engine.JWTSecretPath = filepath.Join(t.TempDir(), "missing")
+	cfg.Engine.RPCTimeout = 0
+	cfg.KZG.Implementation = "unknown"
+	cfg.Validator.Graffiti = "a graffiti that is longer than 32 bytes"
+
+	err := cfg.Validate(nil)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	require.ErrorIs(t, err, engineclient.ErrNonPositiveDuration)
+	require.ErrorIs(t, err, kzg.ErrUnsupportedKzgImplementation)
+
+	fatal, warnings := config.Problems(err)
+	require.Len(t, fatal, 4)
+	require.Empty(t, warnings)
+	require.Contains(t, fatal[0].Error(), "beacon-kit.engine: rpc-timeout")
+}
+
+func TestValidateZeroFeeRecipient(t *testing.T) {
+	cfg := validConfig(t)
+	cfg.PayloadBuilder.SuggestedFeeRecipient = common.ZeroAddress
+
+	fatal, warnings := config.Problems(cfg.Validate(nil))
+	require.Empty(t, fatal)
+	require.Len(t, warnings, 1)
+	require.ErrorContains(
+		t, warnings[0], builder.ErrZeroFeeRecipient.Error(),
+	)
+
+	// A default fee recipient in the proposer config silences the warning.
+	path := filepath.Join(t.TempDir(), "proposer-config.json")
+	require.NoError(t, os.WriteFile(path, []byte(
+		`{"default_config":{"fee_recipient":`+
+			`"0x0000000000000000000000000000000000000001"}}`,
+	), 0o600))
+	cfg.PayloadBuilder.ProposerConfig = path
+	require.NoError(t, cfg.Validate(nil))
+}
+
+func TestValidateCometBFTTimeouts(t *testing.T) {
+	cmtCfg := cmtcfg.DefaultConfig()
+	cmtCfg.Consensus.TimeoutPropose = time.Second
+
+	cfg := validConfig(t)
+	cfg.PayloadBuilder.PayloadTimeout = 2 * time.Second
+	cfg.Relay.Enabled = true
+	cfg.Relay.Timeout = time.Second
+
+	err := cfg.Validate(cmtCfg)
+	require.ErrorIs(t, err, config.ErrPayloadTimeoutTooLong)
+	require.ErrorIs(t, err, config.ErrRelayTimeoutTooLong)
+	require.True(t, errors.IsFatal(err))
+
+	// Without a CometBFT configuration the timeouts are not cross-checked.
+	require.NoError(t, cfg.Validate(nil))
+}
+
+func TestApplyEnvOverrides(t *testing.T) {
+	require.Equal(
+		t,
+		"BEACON_KIT_ENGINE_JWT_SECRET_PATH",
+		config.EnvName("beacon-kit.engine.jwt-secret-path"),
+	)
+
+	t.Setenv("BEACON_KIT_ENGINE_JWT_SECRET_PATH", "/secrets/jwt.hex")
+	t.Setenv("BEACON_KIT_ENGINE_RPC_DIAL_URL", "http://geth:8551")
+	t.Setenv("BEACON_KIT_PAYLOAD_BUILDER_PAYLOAD_TIMEOUT", "500ms")
+	t.Setenv("BEACON_KIT_LOGGER_FILE_ENABLED", "true")
+	t.Setenv(
+		"BEACON_KIT_PAYLOAD_BUILDER_SUGGESTED_FEE_RECIPIENT",
+		"0x0000000000000000000000000000000000000001",
+	)
+
+	v := viper.New()
+	v.Set("beacon-kit.engine.jwt-secret-path", "./jwt.hex")
+	v.Set("beacon-kit.engine.rpc-timeout", "3s")
+
+	cfg, err := config.ReadConfigFromAppOpts(v)
+	require.NoError(t, err)
+	require.Equal(t, "/secrets/jwt.hex", cfg.Engine.JWTSecretPath)
+	require.Equal(t, "http://geth:8551", cfg.Engine.RPCDialURL.String())
+	require.Equal(t, 3*time.Second, cfg.Engine.RPCTimeout)
+	require.Equal(t, 500*time.Millisecond, cfg.PayloadBuilder.PayloadTimeout)
+	require.True(t, cfg.Logger.File.Enabled)
+	require.Equal(
+		t,
+		common.ExecutionAddress{19: 1},
+		cfg.PayloadBuilder.SuggestedFeeRecipient,
+	)
+}
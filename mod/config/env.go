@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// configKey is the key of the configuration in the app config.
+const configKey = "beacon-kit"
+
+// ApplyEnvOverrides sets every configuration key for which a BEACON_KIT_*
+// environment variable is defined to the value of that variable. The name of
+// the variable is the key upper cased, with dashes and dots replaced by
+// underscores, e.g. the key
+// beacon-kit.engine.jwt-secret-path is overridden by
+// BEACON_KIT_ENGINE_JWT_SECRET_PATH.
+func ApplyEnvOverrides(v *viper.Viper) {
+	for _, key := range keys(configKey, reflect.TypeOf(Config{})) {
+		if value, ok := os.LookupEnv(EnvName(key)); ok {
+			v.Set(key, value)
+		}
+	}
+}
+
+// EnvName returns the name of the environment variable overriding the given
+// configuration key.
+func EnvName(key string) string {
+	return strings.NewReplacer("-", "_", ".", "_").Replace(
+		strings.ToUpper(key),
+	)
+}
+
+// keys returns the keys of the fields of t that can be set from a string,
+// prefixed with the given key.
+func keys(prefix string, t reflect.Type) []string {
+	var out []string
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag := field.Tag.Get("mapstructure")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		key := prefix + "." + tag
+		switch {
+		case field.Type.Kind() == reflect.Map:
+			// Maps cannot be expressed as a single variable.
+		case isSection(field.Type):
+			out = append(out, keys(key, field.Type)...)
+		default:
+			out = append(out, key)
+		}
+	}
+	return out
+}
+
+// isSection returns whether t is a struct made of configuration keys rather
+// than a single value decoded from a string.
+func isSection(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := range t.NumField() {
+		if t.Field(i).Tag.Get("mapstructure") != "" {
+			return true
+		}
+	}
+	return false
+}
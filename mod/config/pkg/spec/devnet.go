@@ -36,5 +36,12 @@ func DevnetChainSpec() chain.Spec[
 ] {
 	testnetSpec := BaseSpec()
 	testnetSpec.DepositEth1ChainID = 80087
+	// Devnets start from a new genesis, so proposals are enveloped, deposits
+	// are proven, payload timestamps are checked and the staking fixes apply
+	// from the start.
+	testnetSpec.TxEnvelopeForkEpoch = 0
+	testnetSpec.DepositProofForkEpoch = 0
+	testnetSpec.PayloadTimestampForkEpoch = 0
+	testnetSpec.StakingFixForkEpoch = 0
 	return chain.NewChainSpec(testnetSpec)
 }
@@ -100,8 +100,14 @@ func BaseSpec() chain.SpecData[
 		DepositEth1ChainID:        uint64(80084),
 		Eth1FollowDistance:        1,
 		TargetSecondsPerEth1Block: 3,
+		MaxPayloadTimestampDrift:  12,
 		// Fork-related values.
-		ElectraForkEpoch: 9999999999999999,
+		ElectraForkEpoch:          9999999999999999,
+		TxEnvelopeForkEpoch:       9999999999999999,
+		BlobSidecarsRootForkEpoch: 9999999999999999,
+		DepositProofForkEpoch:     9999999999999999,
+		PayloadTimestampForkEpoch: 9999999999999999,
+		StakingFixForkEpoch:       9999999999999999,
 		// State list length constants.
 		EpochsPerHistoricalVector: 8,
 		EpochsPerSlashingsVector:  8,
@@ -26,6 +26,11 @@ const TomlTemplate = `
 ###                                BeaconKit                                ###
 ###############################################################################
 
+# Every key below can be overridden by an environment variable named after it,
+# upper cased with dashes and dots replaced by underscores, e.g.
+# BEACON_KIT_ENGINE_JWT_SECRET_PATH for beacon-kit.engine.jwt-secret-path.
+# Run "beacond config validate" to check the resulting configuration.
+
 [beacon-kit.engine]
 # HTTP url of the execution client JSON-RPC endpoint.
 rpc-dial-url = "{{ .BeaconKit.Engine.RPCDialURL }}"
@@ -39,7 +44,7 @@ rpc-timeout = "{{ .BeaconKit.Engine.RPCTimeout }}"
 # Interval for the startup check.
 rpc-startup-check-interval = "{{ .BeaconKit.Engine.RPCStartupCheckInterval }}"
 
-# Interval for the JWT refresh.
+# Interval at which the JWT secret is reloaded from disk.
 rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 
 # Path to the execution client JWT-secret
@@ -53,6 +58,76 @@ trusted-setup-path = "{{.BeaconKit.KZG.TrustedSetupPath}}"
 # Options are "crate-crypto/go-kzg-4844" or "ethereum/c-kzg-4844".
 implementation = "{{.BeaconKit.KZG.Implementation}}"
 
+[beacon-kit.logger]
+# Format of the time in log lines.
+time-format = "{{ .BeaconKit.Logger.TimeFormat }}"
+
+# Output format of the logger. Options are "console", "json" or "logfmt".
+format = "{{ .BeaconKit.Logger.Format }}"
+
+[beacon-kit.logger.module-levels]
+# Per-module log level overrides, keyed by the logger's "service" name, e.g.
+# blockchain = "debug"
+{{- range $module, $level := .BeaconKit.Logger.ModuleLevels }}
+{{ $module }} = "{{ $level }}"
+{{- end }}
+
+[beacon-kit.logger.file]
+# Enabled determines if log lines are also written to a rotating log file.
+enabled = {{ .BeaconKit.Logger.File.Enabled }}
+
+# Path to the log file.
+path = "{{ .BeaconKit.Logger.File.Path }}"
+
+# Maximum size in bytes of the log file before it gets rotated.
+max-size = {{ .BeaconKit.Logger.File.MaxSize }}
+
+# Maximum number of rotated log files to retain.
+max-backups = {{ .BeaconKit.Logger.File.MaxBackups }}
+
+[beacon-kit.logger.sampling]
+# Enabled determines if debug log lines are sampled.
+enabled = {{ .BeaconKit.Logger.Sampling.Enabled }}
+
+# Number of lines per message logged every tick before sampling kicks in.
+initial = {{ .BeaconKit.Logger.Sampling.Initial }}
+
+# Once initial is exceeded, only every n-th line per message is logged.
+thereafter = {{ .BeaconKit.Logger.Sampling.Thereafter }}
+
+# Interval after which the sampling counters are reset.
+tick = "{{ .BeaconKit.Logger.Sampling.Tick }}"
+
+[beacon-kit.metrics]
+# Enabled determines if the Prometheus metrics server is started.
+enabled = {{ .BeaconKit.Metrics.Enabled }}
+
+# Address the metrics server listens on. Metrics are served on /metrics.
+listen-address = "{{ .BeaconKit.Metrics.ListenAddress }}"
+
+[beacon-kit.metrics.global-labels]
+# Constant labels attached to every series, e.g.
+# chain_id = "80084"
+{{- range $name, $value := .BeaconKit.Metrics.GlobalLabels }}
+{{ $name }} = "{{ $value }}"
+{{- end }}
+
+[beacon-kit.tracing]
+# Exporter is the span exporter to use, one of "noop" or "otlp".
+exporter = "{{ .BeaconKit.Tracing.Exporter }}"
+
+# Endpoint is the host:port of the OTLP/HTTP collector.
+endpoint = "{{ .BeaconKit.Tracing.Endpoint }}"
+
+# Insecure determines if the exporter connects over plain HTTP.
+insecure = {{ .BeaconKit.Tracing.Insecure }}
+
+# SampleRatio is the fraction of traces that are sampled, between 0 and 1.
+sample-ratio = {{ .BeaconKit.Tracing.SampleRatio }}
+
+# ServiceName is the service name reported with every span.
+service-name = "{{ .BeaconKit.Tracing.ServiceName }}"
+
 [beacon-kit.payload-builder]
 # Enabled determines if the local payload builder is enabled.
 enabled = {{ .BeaconKit.PayloadBuilder.Enabled }}
@@ -61,11 +136,123 @@ enabled = {{ .BeaconKit.PayloadBuilder.Enabled }}
 # from this node.
 suggested-fee-recipient = "{{.BeaconKit.PayloadBuilder.SuggestedFeeRecipient}}"
 
+# Path of the proposer config file, a JSON file setting the fee recipient, gas
+# limit and graffiti of each validator key, along with a default entry. The
+# settings it leaves out fall back to the ones in this file. It is reloaded
+# whenever it changes.
+proposer-config = "{{.BeaconKit.PayloadBuilder.ProposerConfig}}"
+
 # The timeout for local build payload. This should match, or be slightly less
 # than the configured timeout on your execution client. It also must be less than
 # timeout_proposal in the CometBFT configuration.
 payload-timeout = "{{ .BeaconKit.PayloadBuilder.PayloadTimeout }}"
 
+[beacon-kit.relay]
+# Enabled determines if payloads are also requested from an external block
+# builder through the builder API. The local payload is used whenever the
+# relay fails or does not pay more.
+enabled = {{ .BeaconKit.Relay.Enabled }}
+
+# URL of the builder API of the relay, e.g. mev-boost.
+url = "{{ .BeaconKit.Relay.URL }}"
+
+# Timeout of requests to the relay. It must leave enough time to fall back to
+# the local payload within timeout_propose in the CometBFT configuration.
+timeout = "{{ .BeaconKit.Relay.Timeout }}"
+
+# Gas limit the relay is asked to build blocks with.
+gas-limit = {{ .BeaconKit.Relay.GasLimit }}
+
+# Number of consecutive faults after which the relay is cut off.
+max-consecutive-faults = {{ .BeaconKit.Relay.MaxConsecutiveFaults }}
+
+# Number of slots local payloads are used for once the relay is cut off.
+fallback-slots = {{ .BeaconKit.Relay.FallbackSlots }}
+
+[beacon-kit.remote-signer]
+# Enabled determines if the validator key is held by a remote signer exposing
+# the Web3Signer eth2 API instead of being stored on the node.
+enabled = {{ .BeaconKit.RemoteSigner.Enabled }}
+
+# URL of the remote signer.
+url = "{{ .BeaconKit.RemoteSigner.URL }}"
+
+# Hex encoded public key to sign with. It may be left empty if the remote
+# signer holds a single key.
+public-key = "{{ .BeaconKit.RemoteSigner.PublicKey }}"
+
+# Timeout of each request to the remote signer.
+timeout = "{{ .BeaconKit.RemoteSigner.Timeout }}"
+
+# Number of times a failed request is retried.
+max-retries = {{ .BeaconKit.RemoteSigner.MaxRetries }}
+
+# Time waited between two attempts of a request.
+retry-interval = "{{ .BeaconKit.RemoteSigner.RetryInterval }}"
+
+# Path to the certificate of the authority the certificate of the remote
+# signer is verified against. The system roots are used if it is empty.
+ca-cert-file = "{{ .BeaconKit.RemoteSigner.CACertFile }}"
+
+# Paths to the certificate and key the node authenticates itself with to the
+# remote signer, for mutual TLS.
+client-cert-file = "{{ .BeaconKit.RemoteSigner.ClientCertFile }}"
+client-key-file = "{{ .BeaconKit.RemoteSigner.ClientKeyFile }}"
+
+[beacon-kit.blob-archive]
+# Enabled determines if blob sidecars are exported to the archive before they
+# are pruned, and looked up there once they are.
+enabled = {{ .BeaconKit.BlobArchive.Enabled }}
+
+# Backend of the archive, either "filesystem" or "s3".
+backend = "{{ .BeaconKit.BlobArchive.Backend }}"
+
+# Directory of the filesystem backend, relative to the home directory unless
+# absolute.
+directory = "{{ .BeaconKit.BlobArchive.Directory }}"
+
+[beacon-kit.blob-archive.s3]
+# URL of the S3-compatible object store.
+endpoint = "{{ .BeaconKit.BlobArchive.S3.Endpoint }}"
+
+# Bucket sidecars are archived to, and prefix of the key of every object.
+bucket = "{{ .BeaconKit.BlobArchive.S3.Bucket }}"
+prefix = "{{ .BeaconKit.BlobArchive.S3.Prefix }}"
+
+# Region of the bucket.
+region = "{{ .BeaconKit.BlobArchive.S3.Region }}"
+
+# Credentials requests to the object store are signed with.
+access-key-id = "{{ .BeaconKit.BlobArchive.S3.AccessKeyID }}"
+secret-access-key = "{{ .BeaconKit.BlobArchive.S3.SecretAccessKey }}"
+
+# Timeout of each request to the object store.
+timeout = "{{ .BeaconKit.BlobArchive.S3.Timeout }}"
+
+[beacon-kit.blob-gossip]
+# Blob sidecars are gossiped over a side channel, leaving only their root in
+# proposals, from the blob-sidecars-root-fork-epoch of the chain spec on.
+
+# Address sidecars are received and served on.
+listen-address = "{{ .BeaconKit.BlobGossip.ListenAddress }}"
+
+# Base URLs of the peers sidecars are pushed to and pulled from,
+# e.g. ["http://10.0.0.2:26670"].
+peers = [{{ range $i, $peer := .BeaconKit.BlobGossip.Peers }}{{ if $i }}, {{ end }}"{{ $peer }}"{{ end }}]
+
+# Time a proposal waits for its sidecars before pulling them from the peers,
+# and time they are pulled for.
+timeout = "{{ .BeaconKit.BlobGossip.Timeout }}"
+
+# Time received sidecars are kept and served for.
+retention = "{{ .BeaconKit.BlobGossip.Retention }}"
+
+[beacon-kit.blob-store]
+# When blob sidecar files are flushed to disk: "none" leaves it to the
+# operating system, "file" syncs each file before it is renamed into place and
+# "full" also syncs its directory.
+sync-policy = "{{ .BeaconKit.BlobStore.SyncPolicy }}"
+
 [beacon-kit.validator]
 # Graffiti string that will be included in the graffiti field of the beacon block.
 graffiti = "{{.BeaconKit.Validator.Graffiti}}"
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	cmtcfg "github.com/cometbft/cometbft/config"
+)
+
+var (
+	// ErrPayloadTimeoutTooLong is returned when the local payload builder
+	// is given more time than CometBFT waits for a proposal.
+	ErrPayloadTimeoutTooLong = errors.New(
+		"payload timeout must be less than the CometBFT propose timeout",
+	)
+
+	// ErrRelayTimeoutTooLong is returned when requests to the relay may
+	// outlive the time CometBFT waits for a proposal.
+	ErrRelayTimeoutTooLong = errors.New(
+		"relay timeout must be less than the CometBFT propose timeout",
+	)
+)
+
+// Validate checks every section of the configuration and, when cmtCfg is
+// not nil, its consistency with the CometBFT configuration. Each problem is
+// prefixed with the key of its section and the problems are returned joined.
+// Problems that do not prevent the node from running are non-fatal errors,
+// use Problems to tell them apart.
+func (c *Config) Validate(cmtCfg *cmtcfg.Config) error {
+	var errs []error
+	for _, section := range []struct {
+		key      string
+		validate func() error
+	}{
+		{"blob-gossip", c.BlobGossip.Validate},
+		{"blob-store", c.BlobStore.Validate},
+		{"engine", c.Engine.Validate},
+		{"kzg", c.KZG.Validate},
+		{"payload-builder", c.PayloadBuilder.Validate},
+		{"validator", c.Validator.Validate},
+	} {
+		errs = append(errs, withPrefix(section.key, section.validate())...)
+	}
+
+	if cmtCfg != nil {
+		errs = append(errs, withPrefix(
+			"payload-builder", c.validatePayloadTimeout(cmtCfg),
+		)...)
+		errs = append(errs, withPrefix(
+			"relay", c.validateRelayTimeout(cmtCfg),
+		)...)
+	}
+	return errors.Join(errs...)
+}
+
+// Problems splits the error returned by Validate into the fatal problems and
+// the warnings.
+func Problems(err error) ([]error, []error) {
+	var fatal, warnings []error
+	for _, problem := range flatten(err) {
+		if errors.IsFatal(problem) {
+			fatal = append(fatal, problem)
+		} else {
+			warnings = append(warnings, problem)
+		}
+	}
+	return fatal, warnings
+}
+
+// validatePayloadTimeout checks that a payload can be built locally before
+// CometBFT gives up on the proposal.
+func (c *Config) validatePayloadTimeout(cmtCfg *cmtcfg.Config) error {
+	if !c.PayloadBuilder.Enabled ||
+		c.PayloadBuilder.PayloadTimeout < cmtCfg.Consensus.TimeoutPropose {
+		return nil
+	}
+	return errors.Wrapf(
+		ErrPayloadTimeoutTooLong, "payload-timeout %s, timeout_propose %s",
+		c.PayloadBuilder.PayloadTimeout, cmtCfg.Consensus.TimeoutPropose,
+	)
+}
+
+// validateRelayTimeout checks that the relay answers, or is given up on,
+// before CometBFT gives up on the proposal.
+func (c *Config) validateRelayTimeout(cmtCfg *cmtcfg.Config) error {
+	if !c.Relay.Enabled ||
+		c.Relay.Timeout < cmtCfg.Consensus.TimeoutPropose {
+		return nil
+	}
+	return errors.Wrapf(
+		ErrRelayTimeoutTooLong, "timeout %s, timeout_propose %s",
+		c.Relay.Timeout, cmtCfg.Consensus.TimeoutPropose,
+	)
+}
+
+// withPrefix flattens err and prefixes each of its problems with the full
+// key of the given section.
+func withPrefix(section string, err error) []error {
+	problems := flatten(err)
+	for i, problem := range problems {
+		problems[i] = errors.Wrapf(problem, "beacon-kit.%s", section)
+	}
+	return problems
+}
+
+// flatten returns the leaves of a tree of joined errors. The wrappers of a
+// joined error, such as the stack attached by errors.Join, are dropped.
+func flatten(err error) []error {
+	if err == nil {
+		return nil
+	}
+	for e := err; e != nil; {
+		switch wrapper := e.(type) {
+		case interface{ Unwrap() []error }:
+			var leaves []error
+			for _, child := range wrapper.Unwrap() {
+				leaves = append(leaves, flatten(child)...)
+			}
+			return leaves
+		case interface{ Unwrap() error }:
+			e = wrapper.Unwrap()
+		default:
+			e = nil
+		}
+	}
+	return []error{err}
+}
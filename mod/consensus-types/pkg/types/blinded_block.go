@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// BlindedBeaconBlockDeneb is a beacon block of the Deneb fork whose
+// execution payload has been replaced by its header, as exchanged with
+// external block builders.
+//
+//go:generate go run github.com/ferranbt/fastssz/sszgen --path blinded_block.go -objs BlindedBeaconBlockDeneb,SignedBlindedBeaconBlockDeneb -include ../../../primitives/pkg/common,../../../primitives/pkg/crypto,../../../primitives/pkg/math,..,./header.go,./withdrawal_credentials.go,./deposit.go,./payload_header.go,../../../primitives/pkg/eip4844,../../../primitives/pkg/bytes,./eth1data.go,./body.go,./blinded_body.go,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output blinded_block.ssz.go
+//nolint:lll
+type BlindedBeaconBlockDeneb struct {
+	// BeaconBlockHeaderBase is the base of the BlindedBeaconBlockDeneb.
+	BeaconBlockHeaderBase
+	// Body is the blinded body of the BlindedBeaconBlockDeneb.
+	Body *BlindedBeaconBlockBodyDeneb `json:"body"`
+}
+
+// NewBlindedBeaconBlockDeneb blinds the given block, replacing its execution
+// payload and blob commitments with the given header and commitments.
+func NewBlindedBeaconBlockDeneb(
+	blk *BeaconBlockDeneb,
+	header *ExecutionPayloadHeaderDeneb,
+	commitments []eip4844.KZGCommitment,
+) *BlindedBeaconBlockDeneb {
+	return &BlindedBeaconBlockDeneb{
+		BeaconBlockHeaderBase: blk.BeaconBlockHeaderBase,
+		Body: &BlindedBeaconBlockBodyDeneb{
+			BeaconBlockBodyBase:    blk.Body.BeaconBlockBodyBase,
+			ExecutionPayloadHeader: header,
+			BlobKzgCommitments:     commitments,
+		},
+	}
+}
+
+// Blind returns the blinded form of the BeaconBlockDeneb.
+func (b *BeaconBlockDeneb) Blind() (*BlindedBeaconBlockDeneb, error) {
+	header, err := b.GetBody().GetExecutionPayload().ToHeader()
+	if err != nil {
+		return nil, err
+	}
+	denebHeader, ok := header.
+		InnerExecutionPayloadHeader.(*ExecutionPayloadHeaderDeneb)
+	if !ok {
+		return nil, ErrForkVersionNotSupported
+	}
+	return NewBlindedBeaconBlockDeneb(
+		b, denebHeader, b.Body.BlobKzgCommitments,
+	), nil
+}
+
+// Version identifies the version of the BlindedBeaconBlockDeneb.
+func (b *BlindedBeaconBlockDeneb) Version() uint32 {
+	return version.Deneb
+}
+
+// IsNil checks if the BlindedBeaconBlockDeneb instance is nil.
+func (b *BlindedBeaconBlockDeneb) IsNil() bool {
+	return b == nil
+}
+
+// Unblind rebuilds the full block from the given execution payload, which
+// must match the header the block was blinded with.
+func (b *BlindedBeaconBlockDeneb) Unblind(
+	payload *ExecutableDataDeneb,
+) (*BeaconBlockDeneb, error) {
+	if b.Body.ExecutionPayloadHeader == nil {
+		return nil, ErrNilPayloadHeader
+	}
+
+	payloadRoot, err := payload.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	headerRoot, err := b.Body.ExecutionPayloadHeader.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	if payloadRoot != headerRoot {
+		return nil, ErrPayloadHeaderMismatch
+	}
+
+	return &BeaconBlockDeneb{
+		BeaconBlockHeaderBase: b.BeaconBlockHeaderBase,
+		Body: &BeaconBlockBodyDeneb{
+			BeaconBlockBodyBase: b.Body.BeaconBlockBodyBase,
+			ExecutionPayload:    payload,
+			BlobKzgCommitments:  b.Body.BlobKzgCommitments,
+		},
+	}, nil
+}
+
+// SignedBlindedBeaconBlockDeneb is a blinded beacon block signed by its
+// proposer.
+type SignedBlindedBeaconBlockDeneb struct {
+	// Message is the signed blinded block.
+	Message *BlindedBeaconBlockDeneb `json:"message"`
+	// Signature is the signature of the proposer over the block.
+	Signature crypto.BLSSignature `json:"signature" ssz-size:"96"`
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconBlockDeneb_Blind(t *testing.T) {
+	block := generateValidBeaconBlockDeneb()
+	block.Body.ExecutionPayload.Transactions = [][]byte{{0x01, 0x02}}
+	block.Body.BlobKzgCommitments = []eip4844.KZGCommitment{{0x03}}
+
+	blinded, err := block.Blind()
+	require.NoError(t, err)
+	require.Equal(t, block.BeaconBlockHeaderBase, blinded.BeaconBlockHeaderBase)
+	require.Equal(
+		t, block.Body.BlobKzgCommitments, blinded.Body.BlobKzgCommitments,
+	)
+
+	// The blinded block commits to the same root as the full block.
+	blockRoot, err := block.HashTreeRoot()
+	require.NoError(t, err)
+	blindedRoot, err := blinded.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, blockRoot, blindedRoot)
+}
+
+func TestBlindedBeaconBlockDeneb_Unblind(t *testing.T) {
+	block := generateValidBeaconBlockDeneb()
+	block.Body.Deposits = []*types.Deposit{}
+	blinded, err := block.Blind()
+	require.NoError(t, err)
+
+	unblinded, err := blinded.Unblind(block.Body.ExecutionPayload)
+	require.NoError(t, err)
+	require.Equal(t, block, unblinded)
+}
+
+func TestBlindedBeaconBlockDeneb_UnblindMismatch(t *testing.T) {
+	block := generateValidBeaconBlockDeneb()
+	blinded, err := block.Blind()
+	require.NoError(t, err)
+
+	payload := *block.Body.ExecutionPayload
+	payload.BlockHash = common.ExecutionHash{0xff}
+	_, err = blinded.Unblind(&payload)
+	require.ErrorIs(t, err, types.ErrPayloadHeaderMismatch)
+
+	blinded.Body.ExecutionPayloadHeader = nil
+	_, err = blinded.Unblind(&payload)
+	require.ErrorIs(t, err, types.ErrNilPayloadHeader)
+}
+
+func TestSignedBlindedBeaconBlockDeneb_MarshalUnmarshalSSZ(t *testing.T) {
+	block := generateValidBeaconBlockDeneb()
+	block.Body.Deposits = []*types.Deposit{}
+	blinded, err := block.Blind()
+	require.NoError(t, err)
+	signed := &types.SignedBlindedBeaconBlockDeneb{
+		Message:   blinded,
+		Signature: [96]byte{0x01},
+	}
+
+	bz, err := signed.MarshalSSZ()
+	require.NoError(t, err)
+
+	unmarshalled := new(types.SignedBlindedBeaconBlockDeneb)
+	require.NoError(t, unmarshalled.UnmarshalSSZ(bz))
+	require.Equal(t, signed, unmarshalled)
+}
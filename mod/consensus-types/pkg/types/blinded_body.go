@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+)
+
+// BlindedBeaconBlockBodyDeneb is the body of a blinded beacon block in the
+// Deneb chain. It carries the header of the execution payload in place of
+// the payload itself, and shares its hash tree root with the full body.
+//
+//go:generate go run github.com/ferranbt/fastssz/sszgen --path ./blinded_body.go -objs BlindedBeaconBlockBodyDeneb -include ../../../primitives/pkg/crypto,./body.go,./payload_header.go,../../../primitives/pkg/eip4844,../../../primitives/pkg/bytes,./eth1data.go,../../../primitives/pkg/math,../../../primitives/pkg/common,./deposit.go,./withdrawal_credentials.go,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output blinded_body.ssz.go
+//nolint:lll
+type BlindedBeaconBlockBodyDeneb struct {
+	BeaconBlockBodyBase
+	// ExecutionPayloadHeader is the header of the execution payload.
+	ExecutionPayloadHeader *ExecutionPayloadHeaderDeneb `json:"executionPayloadHeader"`
+	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
+	BlobKzgCommitments []eip4844.KZGCommitment `json:"blobKzgCommitments" ssz-size:"?,48" ssz-max:"16"`
+}
+
+// IsNil checks if the BlindedBeaconBlockBodyDeneb is nil.
+func (b *BlindedBeaconBlockBodyDeneb) IsNil() bool {
+	return b == nil
+}
+
+// GetExecutionPayloadHeader returns the ExecutionPayloadHeader of the Body.
+func (
+	b *BlindedBeaconBlockBodyDeneb,
+) GetExecutionPayloadHeader() *ExecutionPayloadHeaderDeneb {
+	return b.ExecutionPayloadHeader
+}
+
+// GetBlobKzgCommitments returns the BlobKzgCommitments of the Body.
+func (
+	b *BlindedBeaconBlockBodyDeneb,
+) GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash] {
+	return b.BlobKzgCommitments
+}
@@ -28,11 +28,13 @@ package types
 import (
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	fastssz "github.com/ferranbt/fastssz"
 )
 
 const (
@@ -93,13 +95,13 @@ func BlockBodyKZGOffset(
 // shared between all forks.
 type BeaconBlockBodyBase struct {
 	// RandaoReveal is the reveal of the RANDAO.
-	RandaoReveal crypto.BLSSignature `ssz-size:"96"`
+	RandaoReveal crypto.BLSSignature `json:"randaoReveal" ssz-size:"96"`
 	// Eth1Data is the data from the Eth1 chain.
-	Eth1Data *Eth1Data
+	Eth1Data *Eth1Data `json:"eth1Data"`
 	// Graffiti is for a fun message or meme.
-	Graffiti [32]byte `ssz-size:"32"`
+	Graffiti common.Bytes32 `json:"graffiti"     ssz-size:"32"`
 	// Deposits is the list of deposits included in the body.
-	Deposits []*Deposit `              ssz-max:"16"`
+	Deposits []*Deposit `json:"deposits"     ssz-max:"16"`
 }
 
 // GetRandaoReveal returns the RandaoReveal of the Body.
@@ -153,6 +155,10 @@ type BeaconBlockBodyDeneb struct {
 	ExecutionPayload *ExecutableDataDeneb
 	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
 	BlobKzgCommitments []eip4844.KZGCommitment `ssz-size:"?,48" ssz-max:"16"`
+	// depositProofs is whether the body carries the inclusion proofs of its
+	// deposits, which it does from the deposit proof fork on. They are
+	// encoded after the other fields, in the order of the deposits.
+	depositProofs bool
 }
 
 // IsNil checks if the BeaconBlockBodyDeneb is nil.
@@ -195,9 +201,51 @@ func (b *BeaconBlockBodyDeneb) SetBlobKzgCommitments(
 	b.BlobKzgCommitments = commitments
 }
 
+// HasDepositProofs returns whether the body carries the inclusion proofs of
+// its deposits.
+func (b *BeaconBlockBodyDeneb) HasDepositProofs() bool {
+	return b.depositProofs
+}
+
+// SetDepositProofs sets whether the body carries the inclusion proofs of its
+// deposits.
+func (b *BeaconBlockBodyDeneb) SetDepositProofs(depositProofs bool) {
+	b.depositProofs = depositProofs
+}
+
+// fixedSizeSSZ returns the size of the fixed part of the encoding.
+func (b *BeaconBlockBodyDeneb) fixedSizeSSZ() int {
+	if b.depositProofs {
+		return 216
+	}
+	return 212
+}
+
+// hashDepositProofsWith hashes the inclusion proofs of the given deposits
+// as a list of vectors of roots.
+func hashDepositProofsWith(
+	hh fastssz.HashWalker,
+	deposits []*Deposit,
+) error {
+	num := uint64(len(deposits))
+	if num > constants.MaxDepositsPerBlock {
+		return fastssz.ErrIncorrectListSize
+	}
+	subIndx := hh.Index()
+	for _, deposit := range deposits {
+		proofIndx := hh.Index()
+		for _, root := range deposit.Proof {
+			hh.Append(root[:])
+		}
+		hh.Merkleize(proofIndx)
+	}
+	hh.MerkleizeWithMixin(subIndx, num, constants.MaxDepositsPerBlock)
+	return nil
+}
+
 // GetTopLevelRoots returns the top-level roots of the BeaconBlockBodyDeneb.
 func (b *BeaconBlockBodyDeneb) GetTopLevelRoots() ([][32]byte, error) {
-	layer := make([][32]byte, BodyLengthDeneb)
+	layer := make([][32]byte, b.Length())
 	var err error
 	randao := b.GetRandaoReveal()
 	layer[0], err = ssz.MerkleizeByteSlice[math.U64, [32]byte](randao[:])
@@ -223,10 +271,25 @@ func (b *BeaconBlockBodyDeneb) GetTopLevelRoots() ([][32]byte, error) {
 	}
 
 	// KZG commitments is not needed
+
+	if b.depositProofs {
+		hh := fastssz.DefaultHasherPool.Get()
+		defer fastssz.DefaultHasherPool.Put(hh)
+		if err = hashDepositProofsWith(hh, b.GetDeposits()); err != nil {
+			return nil, err
+		}
+		if layer[BodyLengthDeneb], err = hh.HashRoot(); err != nil {
+			return nil, err
+		}
+	}
 	return layer, nil
 }
 
-// Length returns the number of fields in the BeaconBlockBodyDeneb struct.
+// Length returns the number of fields in the BeaconBlockBodyDeneb struct,
+// counting the deposit proofs if carried.
 func (b *BeaconBlockBodyDeneb) Length() uint64 {
+	if b.depositProofs {
+		return BodyLengthDeneb + 1
+	}
 	return BodyLengthDeneb
 }
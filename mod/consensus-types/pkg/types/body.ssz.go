@@ -16,7 +16,7 @@ func (b *BeaconBlockBodyDeneb) MarshalSSZ() ([]byte, error) {
 // MarshalSSZTo ssz marshals the BeaconBlockBodyDeneb object to a target array
 func (b *BeaconBlockBodyDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(212)
+	offset := b.fixedSizeSSZ()
 
 	// Field (0) 'RandaoReveal'
 	dst = append(dst, b.RandaoReveal[:]...)
@@ -45,6 +45,12 @@ func (b *BeaconBlockBodyDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error)
 
 	// Offset (5) 'BlobKzgCommitments'
 	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlobKzgCommitments) * 48
+
+	// Offset (6) 'DepositProofs'
+	if b.depositProofs {
+		dst = ssz.WriteOffset(dst, offset)
+	}
 
 	// Field (3) 'Deposits'
 	if size := len(b.Deposits); size > 16 {
@@ -71,6 +77,15 @@ func (b *BeaconBlockBodyDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error)
 		dst = append(dst, b.BlobKzgCommitments[ii][:]...)
 	}
 
+	// Field (6) 'DepositProofs'
+	if b.depositProofs {
+		for ii := 0; ii < len(b.Deposits); ii++ {
+			for jj := 0; jj < 33; jj++ {
+				dst = append(dst, b.Deposits[ii].Proof[jj][:]...)
+			}
+		}
+	}
+
 	return
 }
 
@@ -83,7 +98,7 @@ func (b *BeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 	}
 
 	tail := buf
-	var o3, o4, o5 uint64
+	var o3, o4, o5, o6 uint64
 
 	// Field (0) 'RandaoReveal'
 	copy(b.RandaoReveal[:], buf[0:96])
@@ -104,7 +119,14 @@ func (b *BeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o3 < 212 {
+	// The first offset tells the size of the fixed part, which is larger
+	// when the deposit proofs are carried.
+	switch o3 {
+	case 212:
+		b.depositProofs = false
+	case 216:
+		b.depositProofs = true
+	default:
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -118,6 +140,14 @@ func (b *BeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
+	// Offset (6) 'DepositProofs'
+	o6 = size
+	if b.depositProofs {
+		if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+			return ssz.ErrOffset
+		}
+	}
+
 	// Field (3) 'Deposits'
 	{
 		buf = tail[o3:o4]
@@ -149,7 +179,7 @@ func (b *BeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 
 	// Field (5) 'BlobKzgCommitments'
 	{
-		buf = tail[o5:]
+		buf = tail[o5:o6]
 		num, err := ssz.DivideInt2(len(buf), 48, 16)
 		if err != nil {
 			return err
@@ -159,12 +189,28 @@ func (b *BeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 			copy(b.BlobKzgCommitments[ii][:], buf[ii*48:(ii+1)*48])
 		}
 	}
+
+	// Field (6) 'DepositProofs'
+	if b.depositProofs {
+		buf = tail[o6:]
+		if len(buf) != len(b.Deposits)*1056 {
+			return ssz.ErrSize
+		}
+		for ii := 0; ii < len(b.Deposits); ii++ {
+			for jj := 0; jj < 33; jj++ {
+				copy(
+					b.Deposits[ii].Proof[jj][:],
+					buf[ii*1056+jj*32:ii*1056+(jj+1)*32],
+				)
+			}
+		}
+	}
 	return err
 }
 
 // SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyDeneb object
 func (b *BeaconBlockBodyDeneb) SizeSSZ() (size int) {
-	size = 212
+	size = b.fixedSizeSSZ()
 
 	// Field (3) 'Deposits'
 	size += len(b.Deposits) * 192
@@ -178,6 +224,11 @@ func (b *BeaconBlockBodyDeneb) SizeSSZ() (size int) {
 	// Field (5) 'BlobKzgCommitments'
 	size += len(b.BlobKzgCommitments) * 48
 
+	// Field (6) 'DepositProofs'
+	if b.depositProofs {
+		size += len(b.Deposits) * 1056
+	}
+
 	return
 }
 
@@ -239,6 +290,13 @@ func (b *BeaconBlockBodyDeneb) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 		hh.MerkleizeWithMixin(subIndx, numItems, 16)
 	}
 
+	// Field (6) 'DepositProofs'
+	if b.depositProofs {
+		if err = hashDepositProofsWith(hh, b.Deposits); err != nil {
+			return
+		}
+	}
+
 	hh.Merkleize(indx)
 	return
 }
@@ -21,6 +21,8 @@
 package types_test
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
@@ -29,6 +31,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	ssz "github.com/ferranbt/fastssz"
 	"github.com/stretchr/testify/require"
 )
 
@@ -163,3 +166,58 @@ func TestBeaconBlockBody_Empty(t *testing.T) {
 	_, ok := body.RawBeaconBlockBody.(*types.BeaconBlockBodyDeneb)
 	require.True(t, ok)
 }
+
+func TestBeaconBlockBodyDeneb_DepositProofs(t *testing.T) {
+	body := generateBeaconBlockBodyDeneb()
+	body.Deposits = []*types.Deposit{{Index: 1}, {Index: 2}}
+	body.Deposits[0].Proof[0] = common.Root{1}
+	body.Deposits[1].Proof[32] = common.Root{2}
+
+	// Before the fork, the proofs are not encoded.
+	legacy, err := body.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, uint32(212), binary.LittleEndian.Uint32(legacy[200:]))
+	decoded := new(types.BeaconBlockBodyDeneb)
+	require.NoError(t, decoded.UnmarshalSSZ(legacy))
+	require.False(t, decoded.HasDepositProofs())
+	require.Equal(t, [33]common.Root{}, decoded.Deposits[0].Proof)
+	legacyRoot, err := body.HashTreeRoot()
+	require.NoError(t, err)
+
+	body.SetDepositProofs(true)
+	bz, err := body.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, bz, len(legacy)+4+2*33*32)
+	require.Equal(t, uint32(216), binary.LittleEndian.Uint32(bz[200:]))
+	decoded = new(types.BeaconBlockBodyDeneb)
+	require.NoError(t, decoded.UnmarshalSSZ(bz))
+	require.True(t, decoded.HasDepositProofs())
+	require.Equal(t, body.Deposits, decoded.Deposits)
+
+	root, err := body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NotEqual(t, legacyRoot, root)
+
+	// The top-level roots, with the one of the commitments filled in, are
+	// the leaves of the body root.
+	roots, err := body.GetTopLevelRoots()
+	require.NoError(t, err)
+	require.Len(t, roots, int(types.BodyLengthDeneb)+1)
+	hh := ssz.NewHasher()
+	hh.MerkleizeWithMixin(hh.Index(), 0, 16)
+	roots[types.KZGPositionDeneb], err = hh.HashRoot()
+	require.NoError(t, err)
+	for len(roots) < 8 {
+		roots = append(roots, [32]byte{})
+	}
+	for len(roots) > 1 {
+		var next [][32]byte
+		for i := 0; i < len(roots); i += 2 {
+			next = append(next, sha256.Sum256(
+				append(roots[i][:], roots[i+1][:]...),
+			))
+		}
+		roots = next
+	}
+	require.Equal(t, root, roots[0])
+}
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+)
+
+// BuilderBid is the offer of an external block builder to supply the
+// execution payload of a block, as defined in the builder API.
+// https://github.com/ethereum/builder-specs/blob/main/specs/deneb/builder.md#builderbid
+//
+//go:generate go run github.com/ferranbt/fastssz/sszgen --path builder.go -objs BuilderBid,SignedBuilderBid,ValidatorRegistration,SignedValidatorRegistration -include ../../../primitives/pkg/common,../../../primitives/pkg/crypto,../../../primitives/pkg/math,../../../primitives/pkg/eip4844,../../../primitives/pkg/bytes,./payload_header.go,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output builder.ssz.go
+//nolint:lll
+type BuilderBid struct {
+	// Header is the header of the execution payload offered by the builder.
+	Header *ExecutionPayloadHeaderDeneb `json:"header"`
+	// BlobKzgCommitments is the list of KZG commitments for the blobs of
+	// the payload.
+	BlobKzgCommitments []eip4844.KZGCommitment `json:"blobKzgCommitments" ssz-size:"?,48" ssz-max:"16"`
+	// Value is the payment to the proposer, in Wei.
+	Value math.Wei `json:"value"              ssz-size:"32"`
+	// Pubkey is the public key of the builder.
+	Pubkey crypto.BLSPubkey `json:"pubkey"             ssz-size:"48"`
+}
+
+// SignedBuilderBid is a BuilderBid signed by its builder.
+type SignedBuilderBid struct {
+	// Message is the signed bid.
+	Message *BuilderBid `json:"message"`
+	// Signature is the signature of the builder over the bid.
+	Signature crypto.BLSSignature `json:"signature" ssz-size:"96"`
+}
+
+// VerifySignature verifies the signature of the builder over the bid.
+func (b *SignedBuilderBid) VerifySignature(
+	forkData *ForkData,
+	domainType common.DomainType,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	if b.Message == nil || b.Message.Header == nil {
+		return ErrNilPayloadHeader
+	}
+
+	domain, err := forkData.ComputeDomain(domainType)
+	if err != nil {
+		return err
+	}
+
+	signingRoot, err := ssz.ComputeSigningRoot(b.Message, domain)
+	if err != nil {
+		return err
+	}
+
+	if err = signatureVerificationFn(
+		b.Message.Pubkey, signingRoot[:], b.Signature,
+	); err != nil {
+		return errors.Join(err, ErrInvalidBuilderBid)
+	}
+	return nil
+}
+
+// ValidatorRegistration is the registration of a validator with an external
+// block builder, as defined in the builder API.
+// https://github.com/ethereum/builder-specs/blob/main/specs/bellatrix/builder.md#validatorregistrationv1
+type ValidatorRegistration struct {
+	// FeeRecipient is the address the builder should pay the proposer to.
+	FeeRecipient common.ExecutionAddress `json:"feeRecipient" ssz-size:"20"`
+	// GasLimit is the gas limit the validator wants its blocks built with.
+	GasLimit math.U64 `json:"gasLimit"`
+	// Timestamp is the unix time of the registration, later registrations
+	// of the same validator supersede earlier ones.
+	Timestamp math.U64 `json:"timestamp"`
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey `json:"pubkey"       ssz-size:"48"`
+}
+
+// SignedValidatorRegistration is a ValidatorRegistration signed by its
+// validator.
+type SignedValidatorRegistration struct {
+	// Message is the signed registration.
+	Message *ValidatorRegistration `json:"message"`
+	// Signature is the signature of the validator over the registration.
+	Signature crypto.BLSSignature `json:"signature" ssz-size:"96"`
+}
+
+// CreateAndSignValidatorRegistration constructs and signs a validator
+// registration.
+func CreateAndSignValidatorRegistration(
+	forkData *ForkData,
+	domainType common.DomainType,
+	signer crypto.BLSSigner,
+	feeRecipient common.ExecutionAddress,
+	gasLimit math.U64,
+	timestamp math.U64,
+) (*SignedValidatorRegistration, error) {
+	domain, err := forkData.ComputeDomain(domainType)
+	if err != nil {
+		return nil, err
+	}
+
+	registration := &ValidatorRegistration{
+		FeeRecipient: feeRecipient,
+		GasLimit:     gasLimit,
+		Timestamp:    timestamp,
+		Pubkey:       signer.PublicKey(),
+	}
+
+	signingRoot, err := ssz.ComputeSigningRoot(registration, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := crypto.SignRequest(signer, &crypto.SigningRequest{
+		Type:        crypto.SigningTypeValidatorRegistration,
+		SigningRoot: signingRoot,
+		ValidatorRegistration: &crypto.ValidatorRegistrationData{
+			FeeRecipient: feeRecipient,
+			GasLimit:     gasLimit.Unwrap(),
+			Timestamp:    timestamp.Unwrap(),
+			Pubkey:       registration.Pubkey,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignedValidatorRegistration{
+		Message:   registration,
+		Signature: signature,
+	}, nil
+}
+
+// ExecutionPayloadAndBlobsBundle is the payload revealed by an external
+// block builder in exchange for a signed blinded block.
+type ExecutionPayloadAndBlobsBundle struct {
+	// ExecutionPayload is the execution payload of the block.
+	ExecutionPayload *ExecutableDataDeneb `json:"executionPayload"`
+	// BlobsBundle holds the blobs of the payload along with their
+	// commitments and proofs.
+	BlobsBundle *engineprimitives.BlobsBundleV1[
+		eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+	] `json:"blobsBundle"`
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAndSignValidatorRegistration(t *testing.T) {
+	var (
+		forkData   = types.NewForkData(common.Version{}, common.Root{})
+		domainType = common.DomainType{0x00, 0x00, 0x00, 0x01}
+		pubkey     = crypto.BLSPubkey{0x01}
+		signer     = &mocks.BLSSigner{}
+	)
+	signer.EXPECT().PublicKey().Return(pubkey)
+	signer.EXPECT().Sign(mock.Anything).Return(crypto.BLSSignature{0x02}, nil)
+
+	signed, err := types.CreateAndSignValidatorRegistration(
+		forkData, domainType, signer,
+		common.ExecutionAddress{0x03}, math.U64(30_000_000), math.U64(1),
+	)
+	require.NoError(t, err)
+	require.Equal(t, pubkey, signed.Message.Pubkey)
+	require.Equal(t, math.U64(30_000_000), signed.Message.GasLimit)
+	require.Equal(t, crypto.BLSSignature{0x02}, signed.Signature)
+
+	// The registration is signed over its signing root.
+	domain, err := forkData.ComputeDomain(domainType)
+	require.NoError(t, err)
+	signingRoot, err := ssz.ComputeSigningRoot(signed.Message, domain)
+	require.NoError(t, err)
+	signer.AssertCalled(t, "Sign", signingRoot[:])
+}
+
+func TestSignedBuilderBid_VerifySignature(t *testing.T) {
+	var (
+		forkData   = types.NewForkData(common.Version{}, common.Root{})
+		domainType = common.DomainType{0x00, 0x00, 0x00, 0x01}
+		bid        = &types.SignedBuilderBid{
+			Message: &types.BuilderBid{
+				Header: &types.ExecutionPayloadHeaderDeneb{
+					LogsBloom: make([]byte, 256),
+				},
+				Value:  math.Wei{0x01},
+				Pubkey: crypto.BLSPubkey{0x01},
+			},
+			Signature: crypto.BLSSignature{0x02},
+		}
+		errBadSig = errors.New("bad signature")
+	)
+
+	require.NoError(t, bid.VerifySignature(
+		forkData, domainType,
+		func(crypto.BLSPubkey, []byte, crypto.BLSSignature) error {
+			return nil
+		},
+	))
+
+	err := bid.VerifySignature(
+		forkData, domainType,
+		func(crypto.BLSPubkey, []byte, crypto.BLSSignature) error {
+			return errBadSig
+		},
+	)
+	require.ErrorIs(t, err, errBadSig)
+	require.ErrorIs(t, err, types.ErrInvalidBuilderBid)
+
+	bid.Message.Header = nil
+	require.ErrorIs(t, bid.VerifySignature(
+		forkData, domainType, nil,
+	), types.ErrNilPayloadHeader)
+}
+
+func TestSignedBuilderBid_MarshalUnmarshalSSZ(t *testing.T) {
+	bid := &types.SignedBuilderBid{
+		Message: &types.BuilderBid{
+			Header: &types.ExecutionPayloadHeaderDeneb{
+				LogsBloom: make([]byte, 256),
+				ExtraData: []byte{},
+			},
+			BlobKzgCommitments: nil,
+			Value:              math.Wei{0x01},
+			Pubkey:             crypto.BLSPubkey{0x01},
+		},
+		Signature: crypto.BLSSignature{0x02},
+	}
+
+	bz, err := bid.MarshalSSZ()
+	require.NoError(t, err)
+
+	unmarshalled := new(types.SignedBuilderBid)
+	require.NoError(t, unmarshalled.UnmarshalSSZ(bz))
+	require.Equal(t, bid.Message.Value, unmarshalled.Message.Value)
+	require.Equal(t, bid.Message.Header, unmarshalled.Message.Header)
+	require.Equal(t, bid.Signature, unmarshalled.Signature)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	fastssz "github.com/ferranbt/fastssz"
 )
 
 // Deposit into the consensus layer from the deposit contract in the execution
@@ -45,6 +46,11 @@ type Deposit struct {
 	Signature crypto.BLSSignature `json:"signature"   ssz-max:"96"`
 	// Index of the deposit in the deposit contract.
 	Index uint64 `json:"index"`
+	// Proof is the inclusion proof of the deposit data in the deposit tree,
+	// with the deposit count mixed in as its last element. It is not part
+	// of the deposit encoding, block bodies carry it from the deposit proof
+	// fork on.
+	Proof [constants.DepositProofLength]common.Root `json:"proof" ssz:"-"`
 }
 
 // NewDeposit creates a new Deposit instance.
@@ -130,3 +136,28 @@ func (d *Deposit) GetSignature() crypto.BLSSignature {
 func (d *Deposit) GetWithdrawalCredentials() WithdrawalCredentials {
 	return d.Credentials
 }
+
+// GetProof returns the inclusion proof of the deposit in the deposit tree.
+func (d *Deposit) GetProof() [constants.DepositProofLength]common.Root {
+	return d.Proof
+}
+
+// SetProof sets the inclusion proof of the deposit in the deposit tree.
+func (d *Deposit) SetProof(proof [constants.DepositProofLength]common.Root) {
+	d.Proof = proof
+}
+
+// DataRoot returns the hash tree root of the deposit data, i.e. the leaf
+// the deposit contract inserts into the deposit tree.
+func (d *Deposit) DataRoot() (common.Root, error) {
+	hh := fastssz.DefaultHasherPool.Get()
+	defer fastssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+	hh.PutBytes(d.Pubkey[:])
+	hh.PutBytes(d.Credentials[:])
+	hh.PutUint64(uint64(d.Amount))
+	hh.PutBytes(d.Signature[:])
+	hh.Merkleize(indx)
+	return hh.HashRoot()
+}
@@ -68,7 +68,16 @@ func CreateAndSignDepositMessage(
 		return nil, crypto.BLSSignature{}, err
 	}
 
-	signature, err := signer.Sign(signingRoot[:])
+	signature, err := crypto.SignRequest(signer, &crypto.SigningRequest{
+		Type:        crypto.SigningTypeDeposit,
+		SigningRoot: signingRoot,
+		Deposit: &crypto.DepositData{
+			Pubkey:                depositMessage.Pubkey,
+			WithdrawalCredentials: common.Bytes32(credentials),
+			Amount:                amount.Unwrap(),
+			GenesisForkVersion:    forkData.CurrentVersion,
+		},
+	})
 	if err != nil {
 		return nil, crypto.BLSSignature{}, err
 	}
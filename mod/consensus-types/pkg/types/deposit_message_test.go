@@ -60,6 +60,34 @@ func TestCreateAndSignDepositMessage(t *testing.T) {
 	require.NotNil(t, signature)
 }
 
+func TestCreateAndSignDepositMessage_TypedSigner(t *testing.T) {
+	forkData := &types.ForkData{
+		CurrentVersion:        common.Version{0x00, 0x00, 0x00, 0x04},
+		GenesisValidatorsRoot: common.Root{0x00, 0x00, 0x00, 0x00},
+	}
+	pubkey := crypto.BLSPubkey{0x01}
+	credentials := types.WithdrawalCredentials{0x02}
+	amount := math.Gwei(32)
+
+	mocksSigner := &mocks.TypedBLSSigner{}
+	mocksSigner.On("PublicKey").Return(pubkey)
+	mocksSigner.On("SignRequest", mock.MatchedBy(
+		func(req *crypto.SigningRequest) bool {
+			return req.Type == crypto.SigningTypeDeposit &&
+				req.Deposit.Pubkey == pubkey &&
+				req.Deposit.Amount == amount.Unwrap() &&
+				req.Deposit.GenesisForkVersion == forkData.CurrentVersion
+		},
+	)).Return(crypto.BLSSignature{0x03}, nil)
+
+	_, signature, err := types.CreateAndSignDepositMessage(
+		forkData, common.DomainType{0x03}, mocksSigner, credentials, amount,
+	)
+	require.NoError(t, err)
+	require.Equal(t, crypto.BLSSignature{0x03}, signature)
+	mocksSigner.AssertNotCalled(t, "Sign", mock.Anything)
+}
+
 func TestNewDepositMessage(t *testing.T) {
 	pubKey := crypto.BLSPubkey{}
 	credentials := types.WithdrawalCredentials{}
@@ -21,6 +21,8 @@
 package types_test
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
@@ -150,3 +152,43 @@ func TestDeposit_Getters(t *testing.T) {
 	require.Equal(t, deposit.Signature, deposit.GetSignature())
 	require.Equal(t, deposit.Index, deposit.GetIndex())
 }
+
+func TestDeposit_DataRoot(t *testing.T) {
+	deposit := generateValidDeposit()
+	deposit.Pubkey = crypto.BLSPubkey{0x01}
+	deposit.Signature = crypto.BLSSignature{0x02}
+	deposit.Proof[0] = common.Root{0x03}
+
+	hash := func(a, b []byte) []byte {
+		h := sha256.Sum256(append(append([]byte{}, a...), b...))
+		return h[:]
+	}
+	chunk := func(bz []byte) []byte {
+		out := make([]byte, 32)
+		copy(out, bz)
+		return out
+	}
+	var amount [8]byte
+	binary.LittleEndian.PutUint64(amount[:], uint64(deposit.Amount))
+
+	pubkeyRoot := hash(deposit.Pubkey[:32], chunk(deposit.Pubkey[32:]))
+	signatureRoot := hash(
+		hash(deposit.Signature[:32], deposit.Signature[32:64]),
+		hash(deposit.Signature[64:], make([]byte, 32)),
+	)
+	expected := hash(
+		hash(pubkeyRoot, deposit.Credentials[:]),
+		hash(chunk(amount[:]), signatureRoot),
+	)
+
+	root, err := deposit.DataRoot()
+	require.NoError(t, err)
+	require.Equal(t, common.Root(expected), root)
+
+	// The proof and index are not part of the deposit data.
+	deposit.Index++
+	deposit.Proof[1] = common.Root{0x04}
+	again, err := deposit.DataRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, again)
+}
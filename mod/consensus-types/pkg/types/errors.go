@@ -38,4 +38,14 @@ var (
 
 	// ErrNilPayloadHeader is an error for when the payload header is nil.
 	ErrNilPayloadHeader = errors.New("nil payload header")
+
+	// ErrPayloadHeaderMismatch is an error for when an execution payload
+	// does not match the header of the blinded block it unblinds.
+	ErrPayloadHeaderMismatch = errors.New(
+		"execution payload does not match payload header",
+	)
+
+	// ErrInvalidBuilderBid is an error for when the signature of a builder
+	// bid doesn't match.
+	ErrInvalidBuilderBid = errors.New("invalid builder bid")
 )
@@ -49,6 +49,11 @@ func (e *Eth1Data) New(
 	return e
 }
 
+// GetDepositRoot returns the root of the deposit tree.
+func (e *Eth1Data) GetDepositRoot() common.Root {
+	return e.DepositRoot
+}
+
 // GetDepositCount returns the deposit count.
 func (e *Eth1Data) GetDepositCount() math.U64 {
 	return math.U64(e.DepositCount)
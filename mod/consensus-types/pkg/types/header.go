@@ -29,14 +29,14 @@ import (
 type BeaconBlockHeaderBase struct {
 	// Slot represents the position of the block in the chain.
 	// TODO: Put back to math.Slot after fastssz fixes.
-	Slot uint64
+	Slot uint64 `json:"slot"`
 	// ProposerIndex is the index of the validator who proposed the block.
 	// TODO: Put back to math.ProposerIndex after fastssz fixes.
-	ProposerIndex uint64
+	ProposerIndex uint64 `json:"proposerIndex"`
 	// ParentBlockRoot is the hash of the parent block
-	ParentBlockRoot common.Root
+	ParentBlockRoot common.Root `json:"parentBlockRoot"`
 	// StateRoot is the hash of the state at the block.
-	StateRoot common.Root
+	StateRoot common.Root `json:"stateRoot"`
 }
 
 // GetSlot retrieves the slot of the BeaconBlockBase.
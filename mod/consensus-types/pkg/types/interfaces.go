@@ -45,6 +45,7 @@ type WriteOnlyBeaconBlockBody interface {
 	SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash])
 	SetRandaoReveal(crypto.BLSSignature)
 	SetGraffiti(common.Bytes32)
+	SetDepositProofs(bool)
 }
 
 // ReadOnlyBeaconBlockBody is the interface for
@@ -61,6 +62,7 @@ type ReadOnlyBeaconBlockBody interface {
 	GetExecutionPayload() *ExecutionPayload
 	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
 	GetTopLevelRoots() ([][32]byte, error)
+	HasDepositProofs() bool
 }
 
 // RawBeaconBlock is the interface for a beacon block.
@@ -422,6 +422,51 @@ func (_c *RawBeaconBlockBody_HashTreeRoot_Call) RunAndReturn(run func() ([32]byt
 	return _c
 }
 
+// HasDepositProofs provides a mock function with given fields:
+func (_m *RawBeaconBlockBody) HasDepositProofs() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasDepositProofs")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// RawBeaconBlockBody_HasDepositProofs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasDepositProofs'
+type RawBeaconBlockBody_HasDepositProofs_Call struct {
+	*mock.Call
+}
+
+// HasDepositProofs is a helper method to define mock.On call
+func (_e *RawBeaconBlockBody_Expecter) HasDepositProofs() *RawBeaconBlockBody_HasDepositProofs_Call {
+	return &RawBeaconBlockBody_HasDepositProofs_Call{Call: _e.mock.On("HasDepositProofs")}
+}
+
+func (_c *RawBeaconBlockBody_HasDepositProofs_Call) Run(run func()) *RawBeaconBlockBody_HasDepositProofs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RawBeaconBlockBody_HasDepositProofs_Call) Return(_a0 bool) *RawBeaconBlockBody_HasDepositProofs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RawBeaconBlockBody_HasDepositProofs_Call) RunAndReturn(run func() bool) *RawBeaconBlockBody_HasDepositProofs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsNil provides a mock function with given fields:
 func (_m *RawBeaconBlockBody) IsNil() bool {
 	ret := _m.Called()
@@ -660,6 +705,39 @@ func (_c *RawBeaconBlockBody_SetBlobKzgCommitments_Call) RunAndReturn(run func(e
 	return _c
 }
 
+// SetDepositProofs provides a mock function with given fields: _a0
+func (_m *RawBeaconBlockBody) SetDepositProofs(_a0 bool) {
+	_m.Called(_a0)
+}
+
+// RawBeaconBlockBody_SetDepositProofs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDepositProofs'
+type RawBeaconBlockBody_SetDepositProofs_Call struct {
+	*mock.Call
+}
+
+// SetDepositProofs is a helper method to define mock.On call
+//   - _a0 bool
+func (_e *RawBeaconBlockBody_Expecter) SetDepositProofs(_a0 interface{}) *RawBeaconBlockBody_SetDepositProofs_Call {
+	return &RawBeaconBlockBody_SetDepositProofs_Call{Call: _e.mock.On("SetDepositProofs", _a0)}
+}
+
+func (_c *RawBeaconBlockBody_SetDepositProofs_Call) Run(run func(_a0 bool)) *RawBeaconBlockBody_SetDepositProofs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *RawBeaconBlockBody_SetDepositProofs_Call) Return() *RawBeaconBlockBody_SetDepositProofs_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *RawBeaconBlockBody_SetDepositProofs_Call) RunAndReturn(run func(bool)) *RawBeaconBlockBody_SetDepositProofs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetDeposits provides a mock function with given fields: _a0
 func (_m *RawBeaconBlockBody) SetDeposits(_a0 []*types.Deposit) {
 	_m.Called(_a0)
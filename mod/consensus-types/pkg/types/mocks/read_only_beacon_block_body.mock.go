@@ -422,6 +422,51 @@ func (_c *ReadOnlyBeaconBlockBody_HashTreeRoot_Call) RunAndReturn(run func() ([3
 	return _c
 }
 
+// HasDepositProofs provides a mock function with given fields:
+func (_m *ReadOnlyBeaconBlockBody) HasDepositProofs() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasDepositProofs")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// ReadOnlyBeaconBlockBody_HasDepositProofs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasDepositProofs'
+type ReadOnlyBeaconBlockBody_HasDepositProofs_Call struct {
+	*mock.Call
+}
+
+// HasDepositProofs is a helper method to define mock.On call
+func (_e *ReadOnlyBeaconBlockBody_Expecter) HasDepositProofs() *ReadOnlyBeaconBlockBody_HasDepositProofs_Call {
+	return &ReadOnlyBeaconBlockBody_HasDepositProofs_Call{Call: _e.mock.On("HasDepositProofs")}
+}
+
+func (_c *ReadOnlyBeaconBlockBody_HasDepositProofs_Call) Run(run func()) *ReadOnlyBeaconBlockBody_HasDepositProofs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ReadOnlyBeaconBlockBody_HasDepositProofs_Call) Return(_a0 bool) *ReadOnlyBeaconBlockBody_HasDepositProofs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReadOnlyBeaconBlockBody_HasDepositProofs_Call) RunAndReturn(run func() bool) *ReadOnlyBeaconBlockBody_HasDepositProofs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsNil provides a mock function with given fields:
 func (_m *ReadOnlyBeaconBlockBody) IsNil() bool {
 	ret := _m.Called()
@@ -59,6 +59,39 @@ func (_c *WriteOnlyBeaconBlockBody_SetBlobKzgCommitments_Call) RunAndReturn(run
 	return _c
 }
 
+// SetDepositProofs provides a mock function with given fields: _a0
+func (_m *WriteOnlyBeaconBlockBody) SetDepositProofs(_a0 bool) {
+	_m.Called(_a0)
+}
+
+// WriteOnlyBeaconBlockBody_SetDepositProofs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDepositProofs'
+type WriteOnlyBeaconBlockBody_SetDepositProofs_Call struct {
+	*mock.Call
+}
+
+// SetDepositProofs is a helper method to define mock.On call
+//   - _a0 bool
+func (_e *WriteOnlyBeaconBlockBody_Expecter) SetDepositProofs(_a0 interface{}) *WriteOnlyBeaconBlockBody_SetDepositProofs_Call {
+	return &WriteOnlyBeaconBlockBody_SetDepositProofs_Call{Call: _e.mock.On("SetDepositProofs", _a0)}
+}
+
+func (_c *WriteOnlyBeaconBlockBody_SetDepositProofs_Call) Run(run func(_a0 bool)) *WriteOnlyBeaconBlockBody_SetDepositProofs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *WriteOnlyBeaconBlockBody_SetDepositProofs_Call) Return() *WriteOnlyBeaconBlockBody_SetDepositProofs_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *WriteOnlyBeaconBlockBody_SetDepositProofs_Call) RunAndReturn(run func(bool)) *WriteOnlyBeaconBlockBody_SetDepositProofs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetDeposits provides a mock function with given fields: _a0
 func (_m *WriteOnlyBeaconBlockBody) SetDeposits(_a0 []*types.Deposit) {
 	_m.Called(_a0)
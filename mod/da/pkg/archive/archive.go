@@ -0,0 +1,253 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// sidecarsPrefix is the prefix of the keys of the sidecars, stored by
+	// hash tree root so that the archive is content-addressed.
+	sidecarsPrefix = "sidecars"
+	// versionedHashesPrefix is the prefix of the keys of the index of the
+	// sidecars by the versioned hash of their blob.
+	versionedHashesPrefix = "versioned-hashes"
+	// slotsPrefix is the prefix of the keys of the index of the sidecars by
+	// the slot of their block.
+	slotsPrefix = "slots"
+)
+
+// Archive is the cold storage blob sidecars are exported to before they are
+// pruned from the availability store, so that they remain available past
+// the data availability window. Each sidecar is stored once under its hash
+// tree root, and indexed by the versioned hash of its blob and by slot.
+type Archive struct {
+	// objects is the object store the archive is kept in.
+	objects ObjectStore
+	// mu serializes the updates of the indexes.
+	mu sync.Mutex
+}
+
+// New creates a new archive kept in the given object store.
+func New(objects ObjectStore) *Archive {
+	return &Archive{objects: objects}
+}
+
+// NewFromConfig creates a new archive with the backend of the given
+// configuration. A relative directory of the filesystem backend is
+// resolved against the given home directory.
+func NewFromConfig(cfg Config, homeDir string) (*Archive, error) {
+	switch cfg.Backend {
+	case BackendFilesystem:
+		dir := cfg.Directory
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(homeDir, dir)
+		}
+		return New(NewFSStore(dir)), nil
+	case BackendS3:
+		objects, err := NewS3Store(cfg.S3)
+		if err != nil {
+			return nil, err
+		}
+		return New(objects), nil
+	default:
+		return nil, errors.Wrapf(ErrUnknownBackend, "%q", cfg.Backend)
+	}
+}
+
+// Archive exports the sidecars of the block at the given slot.
+func (a *Archive) Archive(
+	ctx context.Context,
+	slot math.Slot,
+	sidecars *types.BlobSidecars,
+) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	roots := make([]common.Root, 0, sidecars.Len())
+	for _, sidecar := range sidecars.Sidecars {
+		root, err := a.putSidecar(ctx, sidecar)
+		if err != nil {
+			return err
+		}
+		if err = a.addToIndex(
+			ctx,
+			versionedHashKey(sidecar.KzgCommitment.ToVersionedHash()),
+			root,
+		); err != nil {
+			return err
+		}
+		roots = append(roots, root)
+	}
+
+	bz, err := json.Marshal(roots)
+	if err != nil {
+		return err
+	}
+	return a.objects.PutObject(ctx, slotKey(slot), bz)
+}
+
+// GetBySlot returns the sidecars of the block at the given slot, none if
+// none were archived.
+func (a *Archive) GetBySlot(
+	ctx context.Context,
+	slot math.Slot,
+) (*types.BlobSidecars, error) {
+	roots, err := a.getIndex(ctx, slotKey(slot))
+	if errors.Is(err, ErrSidecarNotFound) {
+		return &types.BlobSidecars{Sidecars: []*types.BlobSidecar{}}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return a.getSidecars(ctx, roots)
+}
+
+// GetByVersionedHash returns the sidecars carrying the blob of the given
+// versioned hash, none if none were archived.
+func (a *Archive) GetByVersionedHash(
+	ctx context.Context,
+	versionedHash common.ExecutionHash,
+) (*types.BlobSidecars, error) {
+	roots, err := a.getIndex(ctx, versionedHashKey(versionedHash))
+	if errors.Is(err, ErrSidecarNotFound) {
+		return &types.BlobSidecars{Sidecars: []*types.BlobSidecar{}}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return a.getSidecars(ctx, roots)
+}
+
+// putSidecar stores the given sidecar under its hash tree root, unless it
+// is already stored, and returns the root.
+func (a *Archive) putSidecar(
+	ctx context.Context,
+	sidecar *types.BlobSidecar,
+) (common.Root, error) {
+	root, err := sidecar.HashTreeRoot()
+	if err != nil {
+		return common.Root{}, err
+	}
+	key := sidecarKey(root)
+	if found, err := a.objects.HasObject(ctx, key); err != nil || found {
+		return root, err
+	}
+	bz, err := sidecar.MarshalSSZ()
+	if err != nil {
+		return common.Root{}, err
+	}
+	return root, a.objects.PutObject(ctx, key, bz)
+}
+
+// addToIndex adds the given root to the index of the given key.
+func (a *Archive) addToIndex(
+	ctx context.Context,
+	key string,
+	root common.Root,
+) error {
+	roots, err := a.getIndex(ctx, key)
+	switch {
+	case errors.Is(err, ErrSidecarNotFound):
+	case err != nil:
+		return err
+	default:
+		for _, r := range roots {
+			if r == root {
+				return nil
+			}
+		}
+	}
+
+	bz, err := json.Marshal(append(roots, root))
+	if err != nil {
+		return err
+	}
+	return a.objects.PutObject(ctx, key, bz)
+}
+
+// getIndex returns the roots of the index of the given key.
+func (a *Archive) getIndex(
+	ctx context.Context,
+	key string,
+) ([]common.Root, error) {
+	bz, err := a.objects.GetObject(ctx, key)
+	if errors.Is(err, ErrObjectNotFound) {
+		return nil, errors.Wrapf(ErrSidecarNotFound, "%s", key)
+	} else if err != nil {
+		return nil, err
+	}
+	var roots []common.Root
+	if err = json.Unmarshal(bz, &roots); err != nil {
+		return nil, err
+	}
+	return roots, nil
+}
+
+// getSidecars returns the sidecars of the given roots.
+func (a *Archive) getSidecars(
+	ctx context.Context,
+	roots []common.Root,
+) (*types.BlobSidecars, error) {
+	sidecars := &types.BlobSidecars{
+		Sidecars: make([]*types.BlobSidecar, 0, len(roots)),
+	}
+	for _, root := range roots {
+		bz, err := a.objects.GetObject(ctx, sidecarKey(root))
+		if err != nil {
+			return nil, err
+		}
+		sidecar := new(types.BlobSidecar)
+		if err = sidecar.UnmarshalSSZ(bz); err != nil {
+			return nil, err
+		}
+		sidecars.Sidecars = append(sidecars.Sidecars, sidecar)
+	}
+	return sidecars, nil
+}
+
+// sidecarKey returns the key of the sidecar of the given root, fanned out
+// over two levels of directories by the first bytes of the root.
+func sidecarKey(root common.Root) string {
+	return fmt.Sprintf(
+		"%s/%02x/%02x/%x.ssz", sidecarsPrefix, root[0], root[1], root[:],
+	)
+}
+
+// versionedHashKey returns the key of the index of the given versioned
+// hash.
+func versionedHashKey(versionedHash common.ExecutionHash) string {
+	return fmt.Sprintf("%s/%x", versionedHashesPrefix, versionedHash[:])
+}
+
+// slotKey returns the key of the index of the given slot, padded so that
+// the keys sort by slot.
+func slotKey(slot math.Slot) string {
+	return fmt.Sprintf("%s/%020d", slotsPrefix, slot.Unwrap())
+}
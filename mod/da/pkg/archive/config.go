@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import "time"
+
+const (
+	// BackendFilesystem archives sidecars to a local directory.
+	BackendFilesystem = "filesystem"
+	// BackendS3 archives sidecars to an S3-compatible object store.
+	BackendS3 = "s3"
+
+	// defaultDirectory is the default directory of the filesystem backend,
+	// relative to the home directory of the node.
+	defaultDirectory = "data/blob-archive"
+	// defaultS3Region is the default region of the S3 backend.
+	defaultS3Region = "us-east-1"
+	// defaultS3Timeout is the default timeout of requests to the S3 backend.
+	defaultS3Timeout = 10 * time.Second
+)
+
+// Config is the configuration of the blob archive.
+type Config struct {
+	// Enabled enables exporting sidecars to the archive before they are
+	// pruned, and looking them up there once they are.
+	Enabled bool `mapstructure:"enabled"`
+	// Backend is the backend of the archive, either "filesystem" or "s3".
+	Backend string `mapstructure:"backend"`
+	// Directory is the directory of the filesystem backend. A relative path
+	// is relative to the home directory of the node.
+	Directory string `mapstructure:"directory"`
+	// S3 is the configuration of the S3 backend.
+	S3 S3Config `mapstructure:"s3"`
+}
+
+// S3Config is the configuration of an S3-compatible object store.
+type S3Config struct {
+	// Endpoint is the URL of the object store.
+	Endpoint string `mapstructure:"endpoint"`
+	// Bucket is the bucket sidecars are archived to.
+	Bucket string `mapstructure:"bucket"`
+	// Prefix is prepended to the key of every archived object.
+	Prefix string `mapstructure:"prefix"`
+	// Region is the region of the bucket, used to sign requests.
+	Region string `mapstructure:"region"`
+	// AccessKeyID is the access key ID requests are signed with.
+	AccessKeyID string `mapstructure:"access-key-id"`
+	// SecretAccessKey is the secret access key requests are signed with.
+	SecretAccessKey string `mapstructure:"secret-access-key"`
+	// Timeout is the timeout of requests to the object store.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// DefaultConfig returns the default configuration of the blob archive.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:   false,
+		Backend:   BackendFilesystem,
+		Directory: defaultDirectory,
+		S3: S3Config{
+			Region:  defaultS3Region,
+			Timeout: defaultS3Timeout,
+		},
+	}
+}
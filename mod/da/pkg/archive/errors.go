@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrObjectNotFound is returned when an object is not in the object
+	// store.
+	ErrObjectNotFound = errors.New("object not found")
+
+	// ErrSidecarNotFound is returned when a sidecar is not in the archive.
+	ErrSidecarNotFound = errors.New("sidecar not found in archive")
+
+	// ErrUnknownBackend is returned when the configured backend is unknown.
+	ErrUnknownBackend = errors.New("unknown blob archive backend")
+
+	// ErrInvalidObjectKey is returned when an object key would escape the
+	// root of the object store.
+	ErrInvalidObjectKey = errors.New("invalid object key")
+
+	// ErrUnexpectedStatus is returned when the object store responds with
+	// an unexpected HTTP status.
+	ErrUnexpectedStatus = errors.New("unexpected object store status")
+)
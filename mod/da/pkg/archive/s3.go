@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// S3Store is an ObjectStore backed by a bucket of an S3-compatible object
+// store, addressed path-style and authenticated with signature version 4.
+type S3Store struct {
+	// cfg is the configuration of the object store.
+	cfg S3Config
+	// endpoint is the parsed URL of the object store.
+	endpoint *url.URL
+	// client is the HTTP client the object store is reached with.
+	client *http.Client
+	// now returns the time requests are signed at.
+	now func() time.Time
+}
+
+// NewS3Store creates a new object store backed by the bucket of the given
+// configuration.
+func NewS3Store(cfg S3Config) (*S3Store, error) {
+	endpoint, err := url.Parse(strings.TrimSuffix(cfg.Endpoint, "/"))
+	if err != nil {
+		return nil, err
+	}
+	return &S3Store{
+		cfg:      cfg,
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: cfg.Timeout},
+		now:      time.Now,
+	}, nil
+}
+
+// PutObject stores the given data under the given key.
+func (s *S3Store) PutObject(
+	ctx context.Context,
+	key string,
+	data []byte,
+) error {
+	resp, err := s.do(ctx, http.MethodPut, key, data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return unexpectedStatus(resp)
+	}
+	return nil
+}
+
+// GetObject returns the data stored under the given key.
+func (s *S3Store) GetObject(
+	ctx context.Context,
+	key string,
+) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(resp.Body)
+	case http.StatusNotFound:
+		return nil, errors.Wrapf(ErrObjectNotFound, "%s", key)
+	default:
+		return nil, unexpectedStatus(resp)
+	}
+}
+
+// HasObject reports whether an object is stored under the given key.
+func (s *S3Store) HasObject(ctx context.Context, key string) (bool, error) {
+	resp, err := s.do(ctx, http.MethodHead, key, nil)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, unexpectedStatus(resp)
+	}
+}
+
+// do sends a signed request for the object of the given key.
+func (s *S3Store) do(
+	ctx context.Context,
+	method, key string,
+	body []byte,
+) (*http.Response, error) {
+	u := s.endpoint.JoinPath(s.cfg.Bucket, s.cfg.Prefix+key)
+	req, err := http.NewRequestWithContext(
+		ctx, method, u.String(), bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if body == nil {
+		req.Body, req.GetBody, req.ContentLength = http.NoBody, nil, 0
+	}
+	SignRequestV4(
+		req, hashHex(body),
+		s.cfg.AccessKeyID, s.cfg.SecretAccessKey, s.cfg.Region,
+		s.now(),
+	)
+	return s.client.Do(req)
+}
+
+// unexpectedStatus returns the error of an unexpected response.
+func unexpectedStatus(resp *http.Response) error {
+	//nolint:mnd // enough of the body to report the error.
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return errors.Wrapf(
+		ErrUnexpectedStatus, "%s: %s", resp.Status, bytes.TrimSpace(msg),
+	)
+}
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// sigV4Algorithm is the algorithm of AWS signature version 4.
+	sigV4Algorithm = "AWS4-HMAC-SHA256"
+	// sigV4Service is the service S3 requests are signed for.
+	sigV4Service = "s3"
+	// sigV4DateFormat is the format of the timestamp of a signature.
+	sigV4DateFormat = "20060102T150405Z"
+	// sigV4ScopeDateFormat is the format of the date of a credential scope.
+	sigV4ScopeDateFormat = "20060102"
+
+	// HeaderContentSHA256 is the header carrying the hash of the payload.
+	HeaderContentSHA256 = "X-Amz-Content-Sha256"
+	// HeaderDate is the header carrying the timestamp of the signature.
+	HeaderDate = "X-Amz-Date"
+)
+
+// SignRequestV4 signs the given S3 request with AWS signature version 4 at
+// the given time, given the hex-encoded SHA-256 of its payload. Every
+// header already set on the request is signed, along with the host.
+func SignRequestV4(
+	req *http.Request,
+	payloadHash string,
+	accessKeyID, secretAccessKey, region string,
+	now time.Time,
+) {
+	now = now.UTC()
+	req.Header.Set(HeaderContentSHA256, payloadHash)
+	req.Header.Set(HeaderDate, now.Format(sigV4DateFormat))
+
+	headers := map[string]string{"host": req.Host}
+	if req.Host == "" {
+		headers["host"] = req.URL.Host
+	}
+	for name, values := range req.Header {
+		if name == "Authorization" {
+			continue
+		}
+		headers[strings.ToLower(name)] = strings.TrimSpace(
+			strings.Join(values, ","),
+		)
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalURI(req.URL),
+		canonicalQuery(req.URL),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{
+		now.Format(sigV4ScopeDateFormat), region, sigV4Service,
+		"aws4_request",
+	}, "/")
+	stringToSign := strings.Join([]string{
+		sigV4Algorithm,
+		now.Format(sigV4DateFormat),
+		scope,
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := []byte("AWS4" + secretAccessKey)
+	for _, part := range strings.Split(scope, "/") {
+		key = hmacSHA256(key, []byte(part))
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, []byte(stringToSign)))
+
+	req.Header.Set("Authorization", sigV4Algorithm+
+		" Credential="+accessKeyID+"/"+scope+
+		", SignedHeaders="+signedHeaders+
+		", Signature="+signature,
+	)
+}
+
+// canonicalURI returns the URI-encoded path of the given URL.
+func canonicalURI(u *url.URL) string {
+	p := u.EscapedPath()
+	if p == "" {
+		return "/"
+	}
+	return p
+}
+
+// canonicalQuery returns the query of the given URL with its parameters
+// sorted and URI-encoded.
+func canonicalQuery(u *url.URL) string {
+	query := u.Query()
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	params := make([]string, 0, len(keys))
+	for _, key := range keys {
+		values := query[key]
+		sort.Strings(values)
+		for _, value := range values {
+			params = append(params, uriEncode(key)+"="+uriEncode(value))
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// uriEncode encodes every byte of s but the unreserved characters, as
+// signature version 4 requires.
+func uriEncode(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+// hashHex returns the hex-encoded SHA-256 of the given data.
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// hmacSHA256 returns the HMAC-SHA256 of the given data with the given key.
+func hmacSHA256(key, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
@@ -78,6 +78,10 @@ func (f *SidecarFactory[BeaconBlockT, BeaconBlockBodyT]) BuildSidecars(
 		g           = errgroup.Group{}
 	)
 
+	// Every sidecar must carry the same header, so it is taken once rather
+	// than racing with the state root being set on the block.
+	header := blk.GetHeader()
+
 	startTime := time.Now()
 	defer f.metrics.measureBuildSidecarsDuration(
 		startTime, math.U64(numBlobs),
@@ -91,7 +95,7 @@ func (f *SidecarFactory[BeaconBlockT, BeaconBlockBodyT]) BuildSidecars(
 				return err
 			}
 			sidecars[i] = types.BuildBlobSidecar(
-				math.U64(i), blk.GetHeader(),
+				math.U64(i), header,
 				blobs[i],
 				commitments[i],
 				proofs[i],
@@ -20,6 +20,14 @@
 
 package kzg
 
+import (
+	"os"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/ckzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/gokzg"
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
 const (
 	// defaultTrustedSetupPath is the default path to the trusted setup.
 	defaultTrustedSetupPath = "./testing/files/kzg-trusted-setup.json"
@@ -42,3 +50,30 @@ func DefaultConfig() Config {
 		Implementation:   defaultImplementation,
 	}
 }
+
+// Validate checks that the trusted setup file exists and that the requested
+// implementation is supported.
+func (c Config) Validate() error {
+	var errs []error
+	if impl := c.Implementation; impl != gokzg.Implementation &&
+		impl != ckzg.Implementation {
+		errs = append(errs, errors.Wrapf(
+			ErrUnsupportedKzgImplementation,
+			"implementation %q, supported: %s, %s",
+			impl, gokzg.Implementation, ckzg.Implementation,
+		))
+	}
+
+	info, err := os.Stat(c.TrustedSetupPath)
+	switch {
+	case err != nil:
+		errs = append(errs, errors.Wrapf(
+			err, "trusted-setup-path %q", c.TrustedSetupPath,
+		))
+	case info.IsDir():
+		errs = append(errs, errors.Wrapf(
+			ErrTrustedSetupIsDir, "trusted-setup-path %q", c.TrustedSetupPath,
+		))
+	}
+	return errors.Join(errs...)
+}
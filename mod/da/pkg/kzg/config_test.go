@@ -21,6 +21,8 @@
 package kzg_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
@@ -40,3 +42,23 @@ func TestDefaultConfig(t *testing.T) {
 		cfg.Implementation,
 	)
 }
+
+func TestConfigValidate(t *testing.T) {
+	dir := t.TempDir()
+	setup := filepath.Join(dir, "trusted-setup.json")
+	require.NoError(t, os.WriteFile(setup, []byte("{}"), 0o600))
+
+	cfg := kzg.DefaultConfig()
+	cfg.TrustedSetupPath = setup
+	require.NoError(t, cfg.Validate())
+
+	cfg.Implementation = "unknown"
+	require.ErrorIs(t, cfg.Validate(), kzg.ErrUnsupportedKzgImplementation)
+
+	cfg = kzg.DefaultConfig()
+	cfg.TrustedSetupPath = filepath.Join(dir, "missing.json")
+	require.ErrorIs(t, cfg.Validate(), os.ErrNotExist)
+
+	cfg.TrustedSetupPath = dir
+	require.ErrorIs(t, cfg.Validate(), kzg.ErrTrustedSetupIsDir)
+}
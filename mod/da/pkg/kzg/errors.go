@@ -28,4 +28,8 @@ var (
 	ErrUnsupportedKzgImplementation = errors.New(
 		"unsupported KZG implementation",
 	)
+
+	// ErrTrustedSetupIsDir is returned when the trusted setup path points to
+	// a directory.
+	ErrTrustedSetupIsDir = errors.New("trusted setup path is a directory")
 )
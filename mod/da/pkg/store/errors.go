@@ -34,4 +34,12 @@ var (
 	ErrAttemptedToVerifyNilSidecars = errors.New(
 		"attempted to verify nil sidecars",
 	)
+
+	// ErrSidecarNotFound is returned when a sidecar is neither in the store
+	// nor in the archive.
+	ErrSidecarNotFound = errors.New("sidecar not found")
+
+	// ErrInvalidIndexEntry is returned when an entry of the versioned hash
+	// index cannot be decoded.
+	ErrInvalidIndexEntry = errors.New("invalid versioned hash index entry")
 )
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/hex"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// blobLocationSize is the size of an encoded blob location.
+const blobLocationSize = 8 + 8 + 48
+
+// blobLocation is the entry of the versioned hash index, locating the
+// sidecar of a blob in the IndexDB.
+type blobLocation struct {
+	// Slot is the index the sidecar is stored at.
+	Slot math.Slot
+	// Index is the index of the blob in its block.
+	Index uint64
+	// Commitment is the key the sidecar is stored under.
+	Commitment eip4844.KZGCommitment
+}
+
+// MarshalBinary encodes the location as the big-endian slot and index
+// followed by the commitment.
+func (l *blobLocation) MarshalBinary() ([]byte, error) {
+	bz := make([]byte, 0, blobLocationSize)
+	bz = binary.BigEndian.AppendUint64(bz, l.Slot.Unwrap())
+	bz = binary.BigEndian.AppendUint64(bz, l.Index)
+	return append(bz, l.Commitment[:]...), nil
+}
+
+// UnmarshalBinary decodes a location encoded by MarshalBinary.
+//
+//nolint:mnd // offsets of the encoding.
+func (l *blobLocation) UnmarshalBinary(bz []byte) error {
+	if len(bz) != blobLocationSize {
+		return errors.Wrapf(
+			ErrInvalidIndexEntry, "expected %d bytes, got %d",
+			blobLocationSize, len(bz),
+		)
+	}
+	l.Slot = math.Slot(binary.BigEndian.Uint64(bz[:8]))
+	l.Index = binary.BigEndian.Uint64(bz[8:16])
+	copy(l.Commitment[:], bz[16:])
+	return nil
+}
+
+// versionedHashKey returns the key of the entry of the given versioned hash
+// in the versioned hash index. It is hex encoded, so that file-backed
+// databases can hold it.
+func versionedHashKey(versionedHash common.ExecutionHash) []byte {
+	return []byte(hex.FromBytes(versionedHash[:]).Unwrap())
+}
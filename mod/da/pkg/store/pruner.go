@@ -20,7 +20,10 @@
 
 package store
 
-import "github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
 
 func BuildPruneRangeFn[
 	BeaconBlockT BeaconBlock,
@@ -35,3 +38,14 @@ func BuildPruneRangeFn[
 		return 0, event.Data().GetSlot().Unwrap() - window
 	}
 }
+
+// BuildRewindIndexFn returns the slot the store is rewound to for a beacon
+// state, the slot of the state.
+func BuildRewindIndexFn[
+	BeaconStateT interface{ GetSlot() (math.Slot, error) },
+]() func(BeaconStateT) (uint64, error) {
+	return func(st BeaconStateT) (uint64, error) {
+		slot, err := st.GetSlot()
+		return slot.Unwrap(), err
+	}
+}
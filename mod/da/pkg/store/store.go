@@ -21,12 +21,15 @@
 package store
 
 import (
+	"cmp"
 	"context"
+	"slices"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/sourcegraph/conc/iter"
 )
@@ -35,42 +38,63 @@ import (
 type Store[BeaconBlockBodyT BeaconBlockBody] struct {
 	// IndexDB is a basic database interface.
 	IndexDB
+	// hashIndex maps the versioned hash of every stored blob to the
+	// location of its sidecar in the IndexDB.
+	hashIndex KeyValueDB
 	// logger is used for logging.
 	logger log.Logger[any]
 	// chainSpec contains the chain specification.
 	chainSpec common.ChainSpec
+	// archive is the cold storage sidecars are exported to before they are
+	// pruned, nil if they are dropped.
+	archive Archive
+	// prunedUpTo is the slot below which sidecars were already pruned.
+	prunedUpTo uint64
 }
 
 // New creates a new instance of the AvailabilityStore.
 func New[BeaconBlockT BeaconBlockBody](
 	db IndexDB,
+	hashIndex KeyValueDB,
 	logger log.Logger[any],
 	chainSpec common.ChainSpec,
+	opts ...Option[BeaconBlockT],
 ) *Store[BeaconBlockT] {
-	return &Store[BeaconBlockT]{
+	s := &Store[BeaconBlockT]{
 		IndexDB:   db,
+		hashIndex: hashIndex,
 		chainSpec: chainSpec,
 		logger:    logger,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // IsDataAvailable ensures that all blobs referenced in the block are
-// stored before it returns without an error.
+// stored before it returns without an error. The sidecars are read back
+// and decoded, so that a corrupted or truncated sidecar is not available.
 func (s *Store[BeaconBlockBodyT]) IsDataAvailable(
 	_ context.Context,
 	slot math.Slot,
 	body BeaconBlockBodyT,
 ) bool {
 	for _, commitment := range body.GetBlobKzgCommitments() {
-		// Check if the block data is available in the IndexDB
-		blockData, err := s.IndexDB.Has(uint64(slot), commitment[:])
-		if err != nil || !blockData {
+		sidecar, err := s.getBlobSidecar(slot, commitment)
+		if err != nil || sidecar.KzgCommitment != commitment {
 			return false
 		}
 	}
 	return true
 }
 
+// ValidateSidecar checks that the given bytes decode into a blob sidecar.
+// It validates the sidecars stored before they carried a checksum.
+func ValidateSidecar(bz []byte) error {
+	return new(types.BlobSidecar).UnmarshalSSZ(bz)
+}
+
 // Persist ensures the sidecar data remains accessible, utilizing parallel
 // processing for efficiency.
 func (s *Store[BeaconBlockT]) Persist(
@@ -83,7 +107,8 @@ func (s *Store[BeaconBlockT]) Persist(
 	}
 
 	// Check to see if we are required to store the sidecar anymore, if
-	// this sidecar is from outside the required DA period, we can skip it.
+	// this sidecar is from outside the required DA period, we can skip it,
+	// exporting it straight to the archive if any.
 	if !s.chainSpec.WithinDAPeriod(
 		// slot in which the sidecar was included.
 		// (Safe to assume all sidecars are in same slot at this point).
@@ -91,7 +116,14 @@ func (s *Store[BeaconBlockT]) Persist(
 		// current slot
 		slot,
 	) {
-		return nil
+		if s.archive == nil {
+			return nil
+		}
+		return s.archive.Archive(
+			context.TODO(),
+			sidecars.Sidecars[0].BeaconBlockHeader.GetSlot(),
+			sidecars,
+		)
 	}
 
 	// Store each sidecar in parallel.
@@ -106,7 +138,10 @@ func (s *Store[BeaconBlockT]) Persist(
 			if err != nil {
 				return err
 			}
-			return s.Set(uint64(slot), sc.KzgCommitment[:], bz)
+			if err = s.Set(uint64(slot), sc.KzgCommitment[:], bz); err != nil {
+				return err
+			}
+			return s.index(slot, sc)
 		},
 	)...); err != nil {
 		return err
@@ -115,3 +150,294 @@ func (s *Store[BeaconBlockT]) Persist(
 	s.logger.Info("Successfully stored all blob sidecars 🚗", "slot", slot)
 	return nil
 }
+
+// GetBlobSidecars returns the sidecars of the given commitments of the block
+// at the given slot. Sidecars already pruned are looked up in the archive,
+// if any.
+func (s *Store[BeaconBlockT]) GetBlobSidecars(
+	ctx context.Context,
+	slot math.Slot,
+	commitments []eip4844.KZGCommitment,
+) (*types.BlobSidecars, error) {
+	var (
+		sidecars = &types.BlobSidecars{
+			Sidecars: make([]*types.BlobSidecar, 0, len(commitments)),
+		}
+		archived *types.BlobSidecars
+	)
+	for _, commitment := range commitments {
+		sidecar, err := s.getBlobSidecar(slot, commitment)
+		if errors.Is(err, ErrSidecarNotFound) && s.archive != nil {
+			if archived == nil {
+				if archived, err = s.archive.GetBySlot(ctx, slot); err != nil {
+					return nil, err
+				}
+			}
+			sidecar, err = findBlobSidecar(archived, commitment)
+		}
+		if err != nil {
+			return nil, err
+		}
+		sidecars.Sidecars = append(sidecars.Sidecars, sidecar)
+	}
+	return sidecars, nil
+}
+
+// GetBlobSidecarsByVersionedHashes returns the sidecars carrying the blobs
+// of the given versioned hashes, in the same order. Sidecars already pruned
+// are looked up in the archive, if any.
+func (s *Store[BeaconBlockT]) GetBlobSidecarsByVersionedHashes(
+	ctx context.Context,
+	versionedHashes []common.ExecutionHash,
+) (*types.BlobSidecars, error) {
+	sidecars := &types.BlobSidecars{
+		Sidecars: make([]*types.BlobSidecar, 0, len(versionedHashes)),
+	}
+	for _, versionedHash := range versionedHashes {
+		sidecar, err := s.getByVersionedHash(versionedHash)
+		if errors.Is(err, ErrSidecarNotFound) && s.archive != nil {
+			sidecar, err = s.getArchivedByVersionedHash(ctx, versionedHash)
+		}
+		if err != nil {
+			return nil, err
+		}
+		sidecars.Sidecars = append(sidecars.Sidecars, sidecar)
+	}
+	return sidecars, nil
+}
+
+// Prune removes the sidecars of the slots in [start, end) from the store,
+// along with their entries in the versioned hash index, exporting them to
+// the archive first if any. Nothing is removed unless every sidecar was
+// exported.
+func (s *Store[BeaconBlockT]) Prune(start, end uint64) error {
+	start = max(start, s.prunedUpTo)
+	if s.archive != nil {
+		for slot := start; slot < end; slot++ {
+			if err := s.archiveSlot(context.TODO(), slot); err != nil {
+				return err
+			}
+		}
+	}
+	for slot := start; slot < end; slot++ {
+		if err := s.unindexSlot(slot); err != nil {
+			return err
+		}
+	}
+	if err := s.IndexDB.Prune(start, end); err != nil {
+		return err
+	}
+	s.prunedUpTo = max(s.prunedUpTo, end)
+	return nil
+}
+
+// CanRewind always succeeds: rewinding only drops the sidecars stored
+// after the given slot, the ones at or before it are not needed again.
+func (s *Store[BeaconBlockT]) CanRewind(uint64) error {
+	return nil
+}
+
+// Rewind removes the sidecars of the slots after the given one from the
+// store, along with their entries in the versioned hash index. A blob also
+// stored at an earlier slot is no longer found by its versioned hash.
+func (s *Store[BeaconBlockT]) Rewind(slot uint64) error {
+	indexes, err := s.IndexDB.Indexes()
+	if err != nil {
+		return err
+	}
+	for _, index := range indexes {
+		if index <= slot {
+			continue
+		}
+		if err = s.unindexSlot(index); err != nil {
+			return err
+		}
+		if err = s.IndexDB.DeleteRange(index, index+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// index records the location of the given sidecar, stored at the given
+// slot, in the versioned hash index.
+func (s *Store[BeaconBlockT]) index(
+	slot math.Slot,
+	sidecar *types.BlobSidecar,
+) error {
+	location := &blobLocation{
+		Slot:       slot,
+		Index:      sidecar.Index,
+		Commitment: sidecar.KzgCommitment,
+	}
+	bz, err := location.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return s.hashIndex.Set(
+		versionedHashKey(sidecar.KzgCommitment.ToVersionedHash()), bz,
+	)
+}
+
+// unindexSlot removes the entries of the sidecars stored at the given slot
+// from the versioned hash index. An entry is kept if the blob was stored
+// again at another slot since.
+func (s *Store[BeaconBlockT]) unindexSlot(slot uint64) error {
+	keys, err := s.IndexDB.Keys(slot)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		var commitment eip4844.KZGCommitment
+		copy(commitment[:], key)
+		location, err := s.getLocation(commitment.ToVersionedHash())
+		if errors.Is(err, ErrSidecarNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if location.Slot.Unwrap() != slot {
+			continue
+		}
+		if err = s.hashIndex.Delete(
+			versionedHashKey(commitment.ToVersionedHash()),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getLocation returns the location of the sidecar of the given versioned
+// hash, or ErrSidecarNotFound.
+func (s *Store[BeaconBlockT]) getLocation(
+	versionedHash common.ExecutionHash,
+) (*blobLocation, error) {
+	key := versionedHashKey(versionedHash)
+	found, err := s.hashIndex.Has(key)
+	if err != nil {
+		return nil, err
+	} else if !found {
+		return nil, errors.Wrapf(
+			ErrSidecarNotFound, "versioned hash %s", versionedHash,
+		)
+	}
+	bz, err := s.hashIndex.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	location := new(blobLocation)
+	return location, location.UnmarshalBinary(bz)
+}
+
+// getByVersionedHash returns the stored sidecar of the given versioned
+// hash, or ErrSidecarNotFound.
+func (s *Store[BeaconBlockT]) getByVersionedHash(
+	versionedHash common.ExecutionHash,
+) (*types.BlobSidecar, error) {
+	location, err := s.getLocation(versionedHash)
+	if err != nil {
+		return nil, err
+	}
+	return s.getBlobSidecar(location.Slot, location.Commitment)
+}
+
+// getArchivedByVersionedHash returns the archived sidecar of the given
+// versioned hash included last, or ErrSidecarNotFound.
+func (s *Store[BeaconBlockT]) getArchivedByVersionedHash(
+	ctx context.Context,
+	versionedHash common.ExecutionHash,
+) (*types.BlobSidecar, error) {
+	archived, err := s.archive.GetByVersionedHash(ctx, versionedHash)
+	if err != nil {
+		return nil, err
+	}
+	if archived.Len() == 0 {
+		return nil, errors.Wrapf(
+			ErrSidecarNotFound, "versioned hash %s", versionedHash,
+		)
+	}
+	return slices.MaxFunc(
+		archived.Sidecars,
+		func(a, b *types.BlobSidecar) int {
+			return cmp.Compare(
+				a.BeaconBlockHeader.GetSlot(), b.BeaconBlockHeader.GetSlot(),
+			)
+		},
+	), nil
+}
+
+// archiveSlot exports the sidecars stored at the given slot to the archive.
+func (s *Store[BeaconBlockT]) archiveSlot(
+	ctx context.Context,
+	slot uint64,
+) error {
+	keys, err := s.IndexDB.Keys(slot)
+	if err != nil || len(keys) == 0 {
+		return err
+	}
+
+	sidecars := &types.BlobSidecars{
+		Sidecars: make([]*types.BlobSidecar, 0, len(keys)),
+	}
+	for _, key := range keys {
+		bz, err := s.IndexDB.Get(slot, key)
+		if err != nil {
+			return err
+		}
+		sidecar := new(types.BlobSidecar)
+		if err = sidecar.UnmarshalSSZ(bz); err != nil {
+			return err
+		}
+		sidecars.Sidecars = append(sidecars.Sidecars, sidecar)
+	}
+	slices.SortFunc(sidecars.Sidecars, func(a, b *types.BlobSidecar) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+
+	if err = s.archive.Archive(ctx, math.Slot(slot), sidecars); err != nil {
+		return err
+	}
+	s.logger.Info(
+		"Archived blob sidecars 🧊", "slot", slot, "count", sidecars.Len(),
+	)
+	return nil
+}
+
+// getBlobSidecar returns the sidecar of the given commitment stored at the
+// given slot, or ErrSidecarNotFound.
+func (s *Store[BeaconBlockT]) getBlobSidecar(
+	slot math.Slot,
+	commitment eip4844.KZGCommitment,
+) (*types.BlobSidecar, error) {
+	found, err := s.IndexDB.Has(slot.Unwrap(), commitment[:])
+	if err != nil {
+		return nil, err
+	} else if !found {
+		return nil, errors.Wrapf(
+			ErrSidecarNotFound, "slot %d, commitment %s", slot, commitment,
+		)
+	}
+	bz, err := s.IndexDB.Get(slot.Unwrap(), commitment[:])
+	if err != nil {
+		return nil, err
+	}
+	sidecar := new(types.BlobSidecar)
+	return sidecar, sidecar.UnmarshalSSZ(bz)
+}
+
+// findBlobSidecar returns the sidecar of the given commitment among the
+// given sidecars, or ErrSidecarNotFound.
+func findBlobSidecar(
+	sidecars *types.BlobSidecars,
+	commitment eip4844.KZGCommitment,
+) (*types.BlobSidecar, error) {
+	for _, sidecar := range sidecars.Sidecars {
+		if sidecar.KzgCommitment == commitment {
+			return sidecar, nil
+		}
+	}
+	return nil, errors.Wrapf(
+		ErrSidecarNotFound, "commitment %s", commitment,
+	)
+}
@@ -0,0 +1,338 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/archive"
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// memIndexDB is an in-memory IndexDB.
+type memIndexDB map[uint64]map[string][]byte
+
+func (db memIndexDB) Get(index uint64, key []byte) ([]byte, error) {
+	value, ok := db[index][string(key)]
+	if !ok {
+		return nil, fmt.Errorf("key %x not found at %d", key, index)
+	}
+	return value, nil
+}
+
+func (db memIndexDB) Has(index uint64, key []byte) (bool, error) {
+	_, ok := db[index][string(key)]
+	return ok, nil
+}
+
+func (db memIndexDB) Set(index uint64, key []byte, value []byte) error {
+	if db[index] == nil {
+		db[index] = make(map[string][]byte)
+	}
+	db[index][string(key)] = value
+	return nil
+}
+
+func (db memIndexDB) Keys(index uint64) ([][]byte, error) {
+	keys := make([][]byte, 0, len(db[index]))
+	for key := range db[index] {
+		keys = append(keys, []byte(key))
+	}
+	return keys, nil
+}
+
+func (db memIndexDB) Prune(start, end uint64) error {
+	for index := start; index < end; index++ {
+		delete(db, index)
+	}
+	return nil
+}
+
+func (db memIndexDB) Indexes() ([]uint64, error) {
+	indexes := make([]uint64, 0, len(db))
+	for index := range db {
+		indexes = append(indexes, index)
+	}
+	slices.Sort(indexes)
+	return indexes, nil
+}
+
+func (db memIndexDB) DeleteRange(from, to uint64) error {
+	return db.Prune(from, to)
+}
+
+// memKeyValueDB is an in-memory KeyValueDB.
+type memKeyValueDB map[string][]byte
+
+func (db memKeyValueDB) Get(key []byte) ([]byte, error) {
+	value, ok := db[string(key)]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found", key)
+	}
+	return value, nil
+}
+
+func (db memKeyValueDB) Has(key []byte) (bool, error) {
+	_, ok := db[string(key)]
+	return ok, nil
+}
+
+func (db memKeyValueDB) Set(key []byte, value []byte) error {
+	db[string(key)] = value
+	return nil
+}
+
+func (db memKeyValueDB) Delete(key []byte) error {
+	delete(db, string(key))
+	return nil
+}
+
+// emptyBody is a block body without commitments.
+type emptyBody struct{}
+
+func (emptyBody) GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash] {
+	return nil
+}
+
+func TestPruneArchivesSidecars(t *testing.T) {
+	ctx := context.Background()
+	db := make(memIndexDB)
+	s := store.New[emptyBody](
+		db,
+		make(memKeyValueDB),
+		noop.NewLogger(),
+		nil,
+		store.WithArchive[emptyBody](
+			archive.New(archive.NewFSStore(t.TempDir())),
+		),
+	)
+
+	commitments := []eip4844.KZGCommitment{{0x01}, {0x02}}
+	for i, commitment := range commitments {
+		sidecar := &types.BlobSidecar{
+			Index:         uint64(i),
+			KzgCommitment: commitment,
+			BeaconBlockHeader: ctypes.NewBeaconBlockHeader(
+				3, 0, common.Root{}, common.Root{}, common.Root{},
+			),
+			InclusionProof: make([][32]byte, 8),
+		}
+		bz, err := sidecar.MarshalSSZ()
+		require.NoError(t, err)
+		require.NoError(t, db.Set(3, commitment[:], bz))
+	}
+
+	local, err := s.GetBlobSidecars(ctx, 3, commitments)
+	require.NoError(t, err)
+	require.Equal(t, 2, local.Len())
+
+	require.NoError(t, s.Prune(0, 4))
+	require.Empty(t, db)
+
+	// Pruned sidecars are served from the archive, in the requested order.
+	archived, err := s.GetBlobSidecars(
+		ctx, 3, []eip4844.KZGCommitment{commitments[1], commitments[0]},
+	)
+	require.NoError(t, err)
+	require.Equal(t, local.Sidecars[1], archived.Sidecars[0])
+	require.Equal(t, local.Sidecars[0], archived.Sidecars[1])
+
+	_, err = s.GetBlobSidecars(
+		ctx, 3, []eip4844.KZGCommitment{{0x03}},
+	)
+	require.ErrorIs(t, err, store.ErrSidecarNotFound)
+	_, err = s.GetBlobSidecars(ctx, math.Slot(2), commitments)
+	require.ErrorIs(t, err, store.ErrSidecarNotFound)
+
+	// Versioned hashes are looked up in the archive as well.
+	byHash, err := s.GetBlobSidecarsByVersionedHashes(
+		ctx, []common.ExecutionHash{commitments[1].ToVersionedHash()},
+	)
+	require.NoError(t, err)
+	require.Equal(t, local.Sidecars[1], byHash.Sidecars[0])
+}
+
+func TestVersionedHashIndex(t *testing.T) {
+	ctx := context.Background()
+	db, hashIndex := make(memIndexDB), make(memKeyValueDB)
+	s := store.New[emptyBody](
+		db,
+		hashIndex,
+		noop.NewLogger(),
+		chain.NewChainSpec(
+			chain.SpecData[
+				bytes.B4, math.U64, common.ExecutionAddress, math.U64, any,
+			]{
+				SlotsPerEpoch:                    32,
+				MinEpochsForBlobsSidecarsRequest: 5,
+			},
+		),
+	)
+
+	// The blob of commitment 0x02 is included at both slots.
+	persist := func(slot math.Slot, commitments ...byte) {
+		sidecars := &types.BlobSidecars{}
+		for i, commitment := range commitments {
+			sidecars.Sidecars = append(sidecars.Sidecars, &types.BlobSidecar{
+				Index:         uint64(i),
+				KzgCommitment: eip4844.KZGCommitment{commitment},
+				BeaconBlockHeader: ctypes.NewBeaconBlockHeader(
+					slot, 0, common.Root{}, common.Root{}, common.Root{},
+				),
+				InclusionProof: make([][32]byte, 8),
+			})
+		}
+		require.NoError(t, s.Persist(slot, sidecars))
+	}
+	persist(3, 0x01, 0x02)
+	persist(4, 0x02)
+
+	first := eip4844.KZGCommitment{0x01}.ToVersionedHash()
+	second := eip4844.KZGCommitment{0x02}.ToVersionedHash()
+	sidecars, err := s.GetBlobSidecarsByVersionedHashes(
+		ctx, []common.ExecutionHash{second, first},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 2, sidecars.Len())
+	slotOf := func(sidecar *types.BlobSidecar) math.Slot {
+		return sidecar.BeaconBlockHeader.GetSlot()
+	}
+	require.Equal(t, math.Slot(4), slotOf(sidecars.Sidecars[0]))
+	require.Equal(t, uint64(0), sidecars.Sidecars[0].Index)
+	require.Equal(t, math.Slot(3), slotOf(sidecars.Sidecars[1]))
+	require.Equal(t,
+		eip4844.KZGCommitment{0x01}, sidecars.Sidecars[1].KzgCommitment,
+	)
+
+	// Pruning the first slot drops the entries pointing at it only.
+	require.NoError(t, s.Prune(0, 4))
+	require.Len(t, hashIndex, 1)
+	_, err = s.GetBlobSidecarsByVersionedHashes(
+		ctx, []common.ExecutionHash{first},
+	)
+	require.ErrorIs(t, err, store.ErrSidecarNotFound)
+	sidecars, err = s.GetBlobSidecarsByVersionedHashes(
+		ctx, []common.ExecutionHash{second},
+	)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(4), slotOf(sidecars.Sidecars[0]))
+
+	require.NoError(t, s.Prune(0, 5))
+	require.Empty(t, hashIndex)
+}
+
+func TestRewind(t *testing.T) {
+	ctx := context.Background()
+	db, hashIndex := make(memIndexDB), make(memKeyValueDB)
+	s := store.New[emptyBody](
+		db,
+		hashIndex,
+		noop.NewLogger(),
+		chain.NewChainSpec(
+			chain.SpecData[
+				bytes.B4, math.U64, common.ExecutionAddress, math.U64, any,
+			]{
+				SlotsPerEpoch:                    32,
+				MinEpochsForBlobsSidecarsRequest: 5,
+			},
+		),
+	)
+
+	for slot := math.Slot(3); slot <= 5; slot++ {
+		require.NoError(t, s.Persist(slot, &types.BlobSidecars{
+			Sidecars: []*types.BlobSidecar{{
+				KzgCommitment: eip4844.KZGCommitment{byte(slot)},
+				BeaconBlockHeader: ctypes.NewBeaconBlockHeader(
+					slot, 0, common.Root{}, common.Root{}, common.Root{},
+				),
+				InclusionProof: make([][32]byte, 8),
+			}},
+		}))
+	}
+
+	require.NoError(t, s.CanRewind(3))
+	require.NoError(t, s.Rewind(3))
+	require.Len(t, db, 1)
+	require.Len(t, hashIndex, 1)
+
+	_, err := s.GetBlobSidecars(ctx, 4, []eip4844.KZGCommitment{{0x04}})
+	require.ErrorIs(t, err, store.ErrSidecarNotFound)
+	sidecars, err := s.GetBlobSidecarsByVersionedHashes(
+		ctx,
+		[]common.ExecutionHash{eip4844.KZGCommitment{0x03}.ToVersionedHash()},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, sidecars.Len())
+}
+
+// commitmentsBody is a block body with the given commitments.
+type commitmentsBody eip4844.KZGCommitments[common.ExecutionHash]
+
+func (
+	b commitmentsBody,
+) GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash] {
+	return eip4844.KZGCommitments[common.ExecutionHash](b)
+}
+
+func TestIsDataAvailable(t *testing.T) {
+	ctx := context.Background()
+	db := make(memIndexDB)
+	s := store.New[commitmentsBody](
+		db, make(memKeyValueDB), noop.NewLogger(), nil,
+	)
+
+	commitments := commitmentsBody{{0x01}, {0x02}}
+	sidecar := &types.BlobSidecar{
+		KzgCommitment: commitments[0],
+		BeaconBlockHeader: ctypes.NewBeaconBlockHeader(
+			3, 0, common.Root{}, common.Root{}, common.Root{},
+		),
+		InclusionProof: make([][32]byte, 8),
+	}
+	bz, err := sidecar.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, db.Set(3, commitments[0][:], bz))
+	require.True(t, s.IsDataAvailable(ctx, 3, commitments[:1]))
+	require.False(t, s.IsDataAvailable(ctx, 3, commitments))
+
+	// A sidecar cut short is not available.
+	require.NoError(t, db.Set(3, commitments[1][:], bz[:len(bz)/2]))
+	require.False(t, s.IsDataAvailable(ctx, 3, commitments))
+
+	// Neither is a sidecar of another commitment.
+	require.NoError(t, db.Set(3, commitments[1][:], bz))
+	require.False(t, s.IsDataAvailable(ctx, 3, commitments))
+
+	require.NoError(t, store.ValidateSidecar(bz))
+	require.Error(t, store.ValidateSidecar(bz[:len(bz)/2]))
+}
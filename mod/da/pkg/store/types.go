@@ -21,6 +21,9 @@
 package store
 
 import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -38,8 +41,42 @@ type BlockEvent[BeaconBlockT BeaconBlock] interface {
 
 // IndexDB is a database that allows prefixing by index.
 type IndexDB interface {
+	Get(index uint64, key []byte) ([]byte, error)
 	Has(index uint64, key []byte) (bool, error)
 	Set(index uint64, key []byte, value []byte) error
+	// Keys returns the keys stored at the given index.
+	Keys(index uint64) ([][]byte, error)
+	// Prune removes all values in the given range [start, end).
+	Prune(start, end uint64) error
+	// Indexes returns the indexes holding values, in ascending order.
+	Indexes() ([]uint64, error)
+	// DeleteRange removes all values in the given range [from, to).
+	DeleteRange(from, to uint64) error
+}
+
+// KeyValueDB is a basic key-value database.
+type KeyValueDB interface {
+	Get(key []byte) ([]byte, error)
+	Has(key []byte) (bool, error)
+	Set(key []byte, value []byte) error
+	Delete(key []byte) error
+}
+
+// Archive is the cold storage sidecars are exported to before they are
+// pruned.
+type Archive interface {
+	// Archive exports the sidecars of the block at the given slot.
+	Archive(
+		ctx context.Context, slot math.Slot, sidecars *types.BlobSidecars,
+	) error
+	// GetBySlot returns the sidecars of the block at the given slot, none
+	// if none were archived.
+	GetBySlot(ctx context.Context, slot math.Slot) (*types.BlobSidecars, error)
+	// GetByVersionedHash returns the sidecars carrying the blob of the given
+	// versioned hash, none if none were archived.
+	GetByVersionedHash(
+		ctx context.Context, versionedHash common.ExecutionHash,
+	) (*types.BlobSidecars, error)
 }
 
 // BeaconBlockBody is the body of a beacon block.
@@ -22,6 +22,7 @@ package types
 
 import (
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/sourcegraph/conc/iter"
 )
 
@@ -88,3 +89,13 @@ func (bs *BlobSidecars) VerifyInclusionProofs(
 func (bs *BlobSidecars) Len() int {
 	return len(bs.Sidecars)
 }
+
+// GetSlot returns the slot of the block the sidecars belong to, zero if
+// there are none.
+func (bs *BlobSidecars) GetSlot() math.Slot {
+	if bs.IsNil() || len(bs.Sidecars) == 0 ||
+		bs.Sidecars[0] == nil || bs.Sidecars[0].BeaconBlockHeader == nil {
+		return 0
+	}
+	return bs.Sidecars[0].BeaconBlockHeader.GetSlot()
+}
@@ -23,8 +23,7 @@ package engineprimitives
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	fastssz "github.com/ferranbt/fastssz"
 )
 
 // Transactions is a typealias for [][]byte, which is how transactions are
@@ -33,17 +32,36 @@ type Transactions [][]byte
 
 // HashTreeRoot returns the hash tree root of the Transactions list.
 func (txs Transactions) HashTreeRoot() (common.Root, error) {
-	var err error
+	hh := fastssz.DefaultHasherPool.Get()
+	defer fastssz.DefaultHasherPool.Put(hh)
+	if err := txs.HashTreeRootWith(hh); err != nil {
+		return common.Root{}, err
+	}
+	return hh.HashRoot()
+}
 
-	roots := make([]common.Root, len(txs))
-	for i, tx := range txs {
-		roots[i], err = ssz.MerkleizeByteSlice[math.U64, common.Root](tx)
-		if err != nil {
-			return common.Root{}, err
-		}
+// HashTreeRootWith ssz hashes the Transactions list with a hasher. Each
+// transaction is hashed as a byte list bounded by MaxBytesPerTx, so that
+// the root matches the one of the transactions field of the payload.
+func (txs Transactions) HashTreeRootWith(hh fastssz.HashWalker) error {
+	if uint64(len(txs)) > constants.MaxTxsPerPayload {
+		return fastssz.ErrIncorrectListSize
 	}
 
-	return ssz.MerkleizeListComposite[any, math.U64](
-		roots, constants.MaxTxsPerPayload,
+	indx := hh.Index()
+	for _, tx := range txs {
+		if uint64(len(tx)) > constants.MaxBytesPerTx {
+			return fastssz.ErrIncorrectListSize
+		}
+		elemIndx := hh.Index()
+		hh.AppendBytes32(tx)
+		//nolint:mnd // 31 rounds the division up.
+		hh.MerkleizeWithMixin(
+			elemIndx, uint64(len(tx)), (constants.MaxBytesPerTx+31)/32,
+		)
+	}
+	hh.MerkleizeWithMixin(
+		indx, uint64(len(txs)), constants.MaxTxsPerPayload,
 	)
+	return nil
 }
@@ -24,52 +24,52 @@ import (
 	"context"
 	"net/http"
 	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
 )
 
-// jwtRefreshLoop refreshes the JWT token for the execution client.
+// jwtReloadLoop reloads the JWT secret from disk whenever it changes, so
+// that it can be rotated without restarting the node.
 func (s *EngineClient[
 	ExecutionPayloadT, PayloadAttributesT,
-]) jwtRefreshLoop(
+]) jwtReloadLoop(
 	ctx context.Context,
 ) {
-	s.logger.Info("Starting JWT refresh loop 🔄")
+	s.logger.Info("Starting JWT reload loop 🔄")
 	ticker := time.NewTicker(s.cfg.RPCJWTRefreshInterval)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
-			ticker.Stop()
 			return
 		case <-ticker.C:
-			if err := s.dialExecutionRPCClient(ctx); err != nil {
+			changed, err := s.keyring.Reload()
+			if err != nil {
+				s.metrics.incrementJWTReloadFailureCounter()
 				s.logger.Error(
-					"failed to refresh engine auth token",
-					"err",
-					err,
+					"failed to reload engine auth secret", "err", err,
+				)
+				continue
+			}
+			if changed {
+				s.metrics.incrementJWTReloadCounter()
+				s.logger.Info(
+					"Reloaded engine auth secret",
+					"secret", s.keyring.Current().String(),
 				)
-			} else {
-				s.logger.Info("Successfully refreshed engine auth token")
 			}
 		}
 	}
 }
 
-// buildJWTHeader builds an http.Header that has the JWT token
-// attached for authorization.
-//
-
+// newAuthHTTPClient returns an HTTP client authenticating every request to
+// the execution client with a freshly signed JWT.
 func (s *EngineClient[
 	ExecutionPayloadT, PayloadAttributesT,
-]) buildJWTHeader() (http.Header, error) {
-	header := make(http.Header)
-
-	// Build the JWT token.
-	token, err := buildSignedJWT(s.jwtSecret)
-	if err != nil {
-		s.logger.Error("failed to build JWT token", "err", err)
-		return header, err
+]) newAuthHTTPClient() *http.Client {
+	return &http.Client{
+		Transport: jwt.NewTransport(
+			nil, s.keyring, s.metrics.incrementAuthFailureCounter,
+		),
 	}
-
-	// Add the JWT token to the headers.
-	header.Set("Authorization", "Bearer "+token)
-	return header, nil
 }
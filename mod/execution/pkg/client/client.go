@@ -24,7 +24,6 @@ import (
 	"context"
 	"encoding/json"
 	"math/big"
-	"net/http"
 	"strings"
 	"time"
 
@@ -34,6 +33,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // EngineClient is a struct that holds a pointer to an Eth1Client.
@@ -53,12 +53,15 @@ type EngineClient[
 	cfg *Config
 	// logger is the logger for the engine client.
 	logger log.Logger[any]
-	// jwtSecret is the JWT secret for the execution client.
-	jwtSecret *jwt.Secret
+	// keyring holds the JWT secrets for the execution client, nil if
+	// requests are not authenticated.
+	keyring *jwt.Keyring
 	// eth1ChainID is the chain ID of the execution client.
 	eth1ChainID *big.Int
 	// clientMetrics is the metrics for the engine client.
 	metrics *clientMetrics
+	// tracer is used to trace Engine API calls.
+	tracer trace.Tracer
 	// capabilities is a map of capabilities that the execution client has.
 	capabilities map[string]struct{}
 	// engineCache is an all-in-one cache for data
@@ -82,19 +85,25 @@ func New[
 	logger log.Logger[any],
 	jwtSecret *jwt.Secret,
 	telemetrySink TelemetrySink,
+	tracer trace.Tracer,
 	eth1ChainID *big.Int,
 ) *EngineClient[
 	ExecutionPayloadT, PayloadAttributesT,
 ] {
+	var keyring *jwt.Keyring
+	if jwtSecret != nil {
+		keyring = jwt.NewKeyring(cfg.JWTSecretPath, jwtSecret)
+	}
 	return &EngineClient[ExecutionPayloadT, PayloadAttributesT]{
 		cfg:          cfg,
 		logger:       logger,
-		jwtSecret:    jwtSecret,
+		keyring:      keyring,
 		Eth1Client:   new(ethclient.Eth1Client[ExecutionPayloadT]),
 		capabilities: make(map[string]struct{}),
 		engineCache:  cache.NewEngineCacheWithDefaultConfig(),
 		eth1ChainID:  eth1ChainID,
 		metrics:      newClientMetrics(telemetrySink, logger),
+		tracer:       tracer,
 	}
 }
 
@@ -112,16 +121,16 @@ func (s *EngineClient[
 	ctx context.Context,
 ) error {
 	if s.cfg.RPCDialURL.IsHTTP() || s.cfg.RPCDialURL.IsHTTPS() {
-		// If we are dialing with HTTP(S), start the JWT refresh loop.
+		// If we are dialing with HTTP(S), start the JWT reload loop.
 		defer func() {
-			if s.jwtSecret == nil {
+			if s.keyring == nil {
 				s.logger.Warn(
 					"JWT secret not provided for http(s) connection" +
 						" - please verify your configuration settings",
 				)
 				return
 			}
-			go s.jwtRefreshLoop(ctx)
+			go s.jwtReloadLoop(ctx)
 		}()
 	}
 
@@ -219,6 +228,13 @@ func (s *EngineClient[
 		s.logger.Error("failed to exchange capabilities", "err", err)
 		return err
 	}
+
+	// Report the execution client we are connected to. Not every execution
+	// client supports engine_getClientVersionV1, so this is best effort.
+	versions, versionErr := s.GetClientVersionV1(ctx)
+	if versionErr == nil && len(versions) > 0 {
+		s.metrics.setExecutionClient(versions[0].Name, versions[0].Version)
+	}
 	return nil
 }
 
@@ -240,14 +256,11 @@ func (s *EngineClient[
 	// Dial the execution client based on the URL scheme.
 	switch {
 	case s.cfg.RPCDialURL.IsHTTP(), s.cfg.RPCDialURL.IsHTTPS():
-		// Build an http.Header with the JWT token attached.
-		if s.jwtSecret != nil {
-			var header http.Header
-			if header, err = s.buildJWTHeader(); err != nil {
-				return err
-			}
+		// Authenticate every request with a freshly signed JWT.
+		if s.keyring != nil {
 			if client, err = ethrpc.DialOptions(
-				ctx, s.cfg.RPCDialURL.String(), ethrpc.WithHeaders(header),
+				ctx, s.cfg.RPCDialURL.String(),
+				ethrpc.WithHTTPClient(s.newAuthHTTPClient()),
 			); err != nil {
 				return err
 			}
@@ -23,6 +23,8 @@ package client
 import (
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
 )
 
@@ -63,8 +65,51 @@ type Config struct {
 	RPCTimeout time.Duration `mapstructure:"rpc-timeout"`
 	// RPCStartupCheckInterval is the Interval for the startup check.
 	RPCStartupCheckInterval time.Duration `mapstructure:"rpc-startup-check-interval"`
-	// JWTRefreshInterval is the Interval for the JWT refresh.
+	// RPCJWTRefreshInterval is the interval at which the JWT secret is
+	// reloaded from disk.
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
 }
+
+// Validate checks that the execution client can be dialed and authenticated
+// against with the configured values. All problems found are returned joined.
+func (c Config) Validate() error {
+	var errs []error
+	switch {
+	case c.RPCDialURL == nil || c.RPCDialURL.URL == nil:
+		errs = append(errs, errors.Wrap(ErrMissingDialURL, "rpc-dial-url"))
+	case !c.RPCDialURL.IsHTTP() &&
+		!c.RPCDialURL.IsHTTPS() &&
+		!c.RPCDialURL.IsIPC():
+		errs = append(errs, errors.Wrapf(
+			ErrUnsupportedDialURL, "rpc-dial-url %q", c.RPCDialURL,
+		))
+	}
+
+	for _, d := range []struct {
+		key   string
+		value time.Duration
+	}{
+		{"rpc-timeout", c.RPCTimeout},
+		{"rpc-startup-check-interval", c.RPCStartupCheckInterval},
+		{"rpc-jwt-refresh-interval", c.RPCJWTRefreshInterval},
+	} {
+		if d.value <= 0 {
+			errs = append(errs, errors.Wrap(ErrNonPositiveDuration, d.key))
+		}
+	}
+
+	// IPC connections are not authenticated, so the secret is only required
+	// when dialing over HTTP(S).
+	if c.RPCDialURL != nil && c.RPCDialURL.URL != nil &&
+		c.RPCDialURL.IsIPC() {
+		return errors.Join(errs...)
+	}
+	if _, err := jwt.NewFromFile(c.JWTSecretPath); err != nil {
+		errs = append(errs, errors.Wrapf(
+			err, "jwt-secret-path %q", c.JWTSecretPath,
+		))
+	}
+	return errors.Join(errs...)
+}
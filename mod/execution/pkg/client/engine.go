@@ -29,7 +29,9 @@ import (
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/telemetry"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 /* -------------------------------------------------------------------------- */
@@ -44,7 +46,14 @@ func (s *EngineClient[
 	payload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *common.Root,
-) (*common.ExecutionHash, error) {
+) (_ *common.ExecutionHash, err error) {
+	ctx, span := s.startSpan(
+		ctx, "engine_newPayload",
+		attribute.String("fork_version", version.Name(payload.Version())),
+		attribute.Int("num_versioned_hashes", len(versionedHashes)),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	var (
 		startTime    = time.Now()
 		cctx, cancel = s.createContextWithTimeout(ctx)
@@ -90,7 +99,17 @@ func (s *EngineClient[
 	state *engineprimitives.ForkchoiceStateV1,
 	attrs engineprimitives.PayloadAttributer,
 	forkVersion uint32,
-) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
+) (_ *engineprimitives.PayloadID, _ *common.ExecutionHash, err error) {
+	ctx, span := s.startSpan(
+		ctx, "engine_forkchoiceUpdated",
+		attribute.String("fork_version", version.Name(forkVersion)),
+		attribute.String("head_block_hash", state.HeadBlockHash.Hex()),
+		attribute.Bool(
+			"has_payload_attributes", attrs != nil && !attrs.IsNil(),
+		),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	var (
 		startTime    = time.Now()
 		cctx, cancel = s.createContextWithTimeout(ctx)
@@ -140,7 +159,14 @@ func (s *EngineClient[
 	ctx context.Context,
 	payloadID engineprimitives.PayloadID,
 	forkVersion uint32,
-) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+) (_ engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], err error) {
+	ctx, span := s.startSpan(
+		ctx, "engine_getPayload",
+		attribute.String("fork_version", version.Name(forkVersion)),
+		attribute.String("payload_id", payloadID.String()),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	var (
 		startTime    = time.Now()
 		cctx, cancel = s.createContextWithTimeout(ctx)
@@ -171,7 +197,10 @@ func (s *EngineClient[
 	ExecutionPayloadT, PayloadAttributesT,
 ]) ExchangeCapabilities(
 	ctx context.Context,
-) ([]string, error) {
+) (_ []string, err error) {
+	ctx, span := s.startSpan(ctx, "engine_exchangeCapabilities")
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	result, err := s.Eth1Client.ExchangeCapabilities(
 		ctx, ethclient.BeaconKitSupportedCapabilities(),
 	)
@@ -197,3 +226,14 @@ func (s *EngineClient[
 
 	return result, nil
 }
+
+// GetClientVersionV1 calls the engine_getClientVersionV1 method via JSON-RPC.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) GetClientVersionV1(
+	ctx context.Context,
+) (_ []engineprimitives.ClientVersionV1, err error) {
+	ctx, span := s.startSpan(ctx, "engine_getClientVersionV1")
+	defer func() { telemetry.EndSpan(span, err) }()
+	return s.Eth1Client.GetClientVersionV1(ctx)
+}
@@ -54,6 +54,19 @@ var (
 	// ErrMismatchedEth1ChainID is returned when the chainID does not
 	// match the expected chain ID.
 	ErrMismatchedEth1ChainID = errors.New("mismatched chain ID")
+
+	// ErrMissingDialURL is returned when no execution client URL is set.
+	ErrMissingDialURL = errors.New("execution client URL is not set")
+
+	// ErrUnsupportedDialURL is returned when the execution client URL uses
+	// a scheme other than http, https or ipc.
+	ErrUnsupportedDialURL = errors.New(
+		"unsupported scheme, expected http, https or ipc",
+	)
+
+	// ErrNonPositiveDuration is returned when a timeout or interval is not
+	// strictly positive.
+	ErrNonPositiveDuration = errors.New("must be greater than zero")
 )
 
 // Handles errors received from the RPC server according to the specification.
@@ -26,6 +26,9 @@ import (
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/telemetry"
+	"github.com/ethereum/go-ethereum/rpc"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // HeaderByNumber retrieves the block header by its number.
@@ -34,22 +37,28 @@ func (s *EngineClient[
 ]) HeaderByNumber(
 	ctx context.Context,
 	number *big.Int,
-) (*engineprimitives.Header, error) {
+) (_ *engineprimitives.Header, err error) {
+	ctx, span := s.startSpan(ctx, "eth_getHeaderByNumber")
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	// Infer the latest height if the number is nil.
 	if number == nil {
-		latest, err := s.BlockNumber(ctx)
-		if err != nil {
+		var latest uint64
+		if latest, err = s.BlockNumber(ctx); err != nil {
 			return nil, err
 		}
 		number = new(big.Int).SetUint64(latest)
 	}
+	span.SetAttributes(attribute.Int64("block_number", number.Int64()))
 
 	// Check the cache for the header.
-	if header, ok := s.engineCache.HeaderByNumber(number.Uint64()); ok {
+	header, ok := s.engineCache.HeaderByNumber(number.Uint64())
+	span.SetAttributes(attribute.Bool("cache_hit", ok))
+	if ok {
 		return header, nil
 	}
 
-	header, err := s.Client.HeaderByNumber(ctx, number)
+	header, err = s.Client.HeaderByNumber(ctx, number)
 	if err != nil {
 		return nil, err
 	}
@@ -66,16 +75,55 @@ func (s *EngineClient[
 ]) HeaderByHash(
 	ctx context.Context,
 	hash common.ExecutionHash,
-) (*engineprimitives.Header, error) {
+) (_ *engineprimitives.Header, err error) {
+	ctx, span := s.startSpan(
+		ctx, "eth_getHeaderByHash",
+		attribute.String("block_hash", hash.Hex()),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	// Check the cache for the header.
 	header, ok := s.engineCache.HeaderByHash(hash)
+	span.SetAttributes(attribute.Bool("cache_hit", ok))
 	if ok {
 		return header, nil
 	}
-	header, err := s.Client.HeaderByHash(ctx, hash)
+	header, err = s.Client.HeaderByHash(ctx, hash)
 	if err != nil {
 		return nil, err
 	}
 	s.engineCache.AddHeader(header)
 	return header, nil
 }
+
+// ExecutionBlockByHash fetches an execution block by its hash.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) ExecutionBlockByHash(
+	ctx context.Context,
+	hash common.ExecutionHash,
+	withTxs bool,
+) (_ *engineprimitives.Block, err error) {
+	ctx, span := s.startSpan(
+		ctx, "eth_getBlockByHash",
+		attribute.String("block_hash", hash.Hex()),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+	return s.Eth1Client.ExecutionBlockByHash(ctx, hash, withTxs)
+}
+
+// ExecutionBlockByNumber fetches an execution block by its number.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) ExecutionBlockByNumber(
+	ctx context.Context,
+	number rpc.BlockNumber,
+	withTxs bool,
+) (_ *engineprimitives.Block, err error) {
+	ctx, span := s.startSpan(
+		ctx, "eth_getBlockByNumber",
+		attribute.Int64("block_number", number.Int64()),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+	return s.Eth1Client.ExecutionBlockByNumber(ctx, number, withTxs)
+}
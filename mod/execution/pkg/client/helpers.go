@@ -26,10 +26,9 @@ import (
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
-	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
-	gjwt "github.com/golang-jwt/jwt/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // createContextWithTimeout creates a context with a timeout and returns it
@@ -49,6 +48,23 @@ func (s *EngineClient[
 	return dctx, cancel
 }
 
+// startSpan starts a client span for the given Engine API method.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) startSpan(
+	ctx context.Context,
+	method string,
+	attrs ...attribute.KeyValue,
+) (context.Context, trace.Span) {
+	return s.tracer.Start(
+		ctx, method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			append(attrs, attribute.String("rpc.method", method))...,
+		),
+	)
+}
+
 // processPayloadStatusResult processes the payload status result and
 // returns the latest valid hash or an error.
 func processPayloadStatusResult(
@@ -67,15 +83,3 @@ func processPayloadStatusResult(
 		return nil, engineerrors.ErrUnknownPayloadStatus
 	}
 }
-
-// buildSignedJWT builds a signed JWT from the provided JWT secret.
-func buildSignedJWT(s *jwt.Secret) (string, error) {
-	token := gjwt.NewWithClaims(gjwt.SigningMethodHS256, gjwt.MapClaims{
-		"iat": &gjwt.NumericDate{Time: time.Now()},
-	})
-	str, err := token.SignedString(s[:])
-	if err != nil {
-		return "", errors.Newf("failed to create JWT token: %w", err)
-	}
-	return str, nil
-}
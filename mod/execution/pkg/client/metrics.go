@@ -73,6 +73,17 @@ func (cm *clientMetrics) measureGetPayloadDuration(startTime time.Time) {
 	)
 }
 
+// setExecutionClient records the name and version of the execution client
+// the engine client is connected to.
+func (cm *clientMetrics) setExecutionClient(name, version string) {
+	cm.sink.SetGauge(
+		"beacon_kit.chain.execution_client",
+		1,
+		"client", name,
+		"version", version,
+	)
+}
+
 // incrementForkchoiceUpdateTimeout increments the timeout counter
 // for forkchoice update.
 func (cm *clientMetrics) incrementForkchoiceUpdateTimeout() {
@@ -99,6 +110,23 @@ func (cm *clientMetrics) incrementHTTPTimeoutCounter() {
 	cm.incrementTimeoutCounter("beacon_kit.execution.client.http")
 }
 
+// incrementAuthFailureCounter increments the counter of requests rejected
+// by the execution client as unauthorized.
+func (cm *clientMetrics) incrementAuthFailureCounter() {
+	cm.incrementErrorCounter("beacon_kit.execution.client.auth_failure")
+}
+
+// incrementJWTReloadCounter increments the counter of JWT secret reloads.
+func (cm *clientMetrics) incrementJWTReloadCounter() {
+	cm.sink.IncrementCounter("beacon_kit.execution.client.jwt_reload")
+}
+
+// incrementJWTReloadFailureCounter increments the counter of failed JWT
+// secret reloads.
+func (cm *clientMetrics) incrementJWTReloadFailureCounter() {
+	cm.incrementErrorCounter("beacon_kit.execution.client.jwt_reload_failure")
+}
+
 // incrementTimeoutCounter increments the timeout counter for
 // the given metric.
 func (cm *clientMetrics) incrementTimeoutCounter(metricName string) {
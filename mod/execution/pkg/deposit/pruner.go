@@ -48,3 +48,13 @@ func BuildPruneRangeFn[
 		return index - cs.MaxDepositsPerBlock(), end
 	}
 }
+
+// BuildRewindIndexFn returns the deposit count the store is rewound to for a
+// beacon state, the index of the next deposit it processes.
+func BuildRewindIndexFn[
+	BeaconStateT interface{ GetEth1DepositIndex() (uint64, error) },
+]() func(BeaconStateT) (uint64, error) {
+	return func(st BeaconStateT) (uint64, error) {
+		return st.GetEth1DepositIndex()
+	}
+}
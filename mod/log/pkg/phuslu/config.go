@@ -22,16 +22,79 @@ package phuslu
 
 import "time"
 
+const (
+	// FormatConsole is the human readable, coloured console format.
+	FormatConsole = "console"
+	// FormatJSON emits one JSON object per log line.
+	FormatJSON = "json"
+	// FormatLogfmt emits logfmt encoded key=value lines.
+	FormatLogfmt = "logfmt"
+)
+
 // Config is a structure that defines the configuration for the logger.
 type Config struct {
 	// TimeFormat is a string that defines the format of the time in
 	// the logger.
-	TimeFormat string
+	TimeFormat string `mapstructure:"time-format"`
+	// Format is the output format of the logger, one of "console", "json"
+	// or "logfmt".
+	Format string `mapstructure:"format"`
+	// ModuleLevels overrides the global log level for loggers created with
+	// the matching "service" context key, i.e. With("service", name).
+	ModuleLevels map[string]string `mapstructure:"module-levels"`
+	// File is the configuration for the optional rotating file writer.
+	File FileConfig `mapstructure:"file"`
+	// Sampling is the configuration for sampling debug log lines.
+	Sampling SamplingConfig `mapstructure:"sampling"`
+}
+
+// FileConfig is the configuration for the rotating file writer.
+type FileConfig struct {
+	// Enabled determines if log lines are also written to a file.
+	Enabled bool `mapstructure:"enabled"`
+	// Path is the path of the log file.
+	Path string `mapstructure:"path"`
+	// MaxSize is the maximum size in bytes of the log file before it
+	// gets rotated.
+	MaxSize int64 `mapstructure:"max-size"`
+	// MaxBackups is the maximum number of rotated log files to retain.
+	MaxBackups int `mapstructure:"max-backups"`
+}
+
+// SamplingConfig is the configuration for sampling hot debug log lines.
+// Within every Tick, the first Initial lines with a given message are logged,
+// after which only every Thereafter-th line is logged.
+type SamplingConfig struct {
+	// Enabled determines if debug log lines are sampled.
+	Enabled bool `mapstructure:"enabled"`
+	// Initial is the number of lines per message logged every tick before
+	// sampling kicks in.
+	Initial uint64 `mapstructure:"initial"`
+	// Thereafter is the sampling rate once Initial has been exceeded.
+	Thereafter uint64 `mapstructure:"thereafter"`
+	// Tick is the interval after which the sampling counters are reset.
+	Tick time.Duration `mapstructure:"tick"`
 }
 
 // DefaultConfig is a function that returns a new Config with default values.
+//
+//nolint:mnd // default values.
 func DefaultConfig() *Config {
 	return &Config{
-		TimeFormat: time.RFC3339,
+		TimeFormat:   time.RFC3339,
+		Format:       FormatConsole,
+		ModuleLevels: make(map[string]string),
+		File: FileConfig{
+			Enabled:    false,
+			Path:       "",
+			MaxSize:    100 * 1024 * 1024,
+			MaxBackups: 10,
+		},
+		Sampling: SamplingConfig{
+			Enabled:    false,
+			Initial:    100,
+			Thereafter: 100,
+			Tick:       time.Second,
+		},
 	}
 }
@@ -21,6 +21,7 @@
 package phuslu
 
 import (
+	"fmt"
 	"io"
 
 	"github.com/phuslu/log"
@@ -32,25 +33,36 @@ type Logger[ImplT any] struct {
 	logger *log.Logger
 	// context is a map of key-value pairs that are added to every log entry.
 	context log.Fields
+	// moduleLevels maps a service name to its log level override.
+	moduleLevels map[string]log.Level
+	// sampler is used to sample debug log lines, nil if disabled.
+	sampler *sampler
 }
 
-// NewLogger creates a new logger with the given log level, ConsoleWriter, and
-// default configuration.
+// NewLogger creates a new logger with the given log level, output and
+// configuration. If cfg is nil, the default configuration is used.
 func NewLogger[ImplT any](
-	level string, out io.Writer,
+	level string, out io.Writer, cfg *Config,
 ) *Logger[ImplT] {
-	cfg := DefaultConfig()
+	if cfg == nil {
+		cfg = DefaultConfig()
+	}
 	logger := &log.Logger{
 		Level:      log.ParseLevel(level),
 		TimeFormat: cfg.TimeFormat,
-		Writer: &log.ConsoleWriter{
-			Writer:    out,
-			Formatter: (NewFormatter().Format),
-		},
+		Writer:     newWriter(out, cfg),
+	}
+
+	moduleLevels := make(map[string]log.Level, len(cfg.ModuleLevels))
+	for module, lvl := range cfg.ModuleLevels {
+		moduleLevels[module] = log.ParseLevel(lvl)
 	}
+
 	return &Logger[ImplT]{
-		logger:  logger,
-		context: make(log.Fields),
+		logger:       logger,
+		context:      make(log.Fields),
+		moduleLevels: moduleLevels,
+		sampler:      newSampler(cfg.Sampling),
 	}
 }
 
@@ -79,6 +91,9 @@ func (l *Logger[ImplT]) Debug(msg string, keyVals ...any) {
 	if l.logger.Level > log.DebugLevel {
 		return
 	}
+	if l.sampler != nil && !l.sampler.Allow(msg) {
+		return
+	}
 	l.msgWithContext(msg, l.logger.Debug(), keyVals...)
 }
 
@@ -104,6 +119,19 @@ func (l Logger[ImplT]) With(keyVals ...any) ImplT {
 			continue
 		}
 		newLogger.context[key] = keyVals[i+1]
+
+		// If the logger is scoped to a service with a level override, it
+		// gets its own copy of the underlying logger at that level. The
+		// writer is shared between all copies.
+		if key != "service" {
+			continue
+		}
+		lvl, found := newLogger.moduleLevels[fmt.Sprint(keyVals[i+1])]
+		if found {
+			scoped := *l.logger
+			scoped.Level = lvl
+			newLogger.logger = &scoped
+		}
 	}
 
 	return any(&newLogger).(ImplT)
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
+)
+
+type testLogger = *phuslu.Logger[any]
+
+func newTestLogger(
+	level string, cfg *phuslu.Config,
+) (*phuslu.Logger[any], *bytes.Buffer) {
+	buf := &bytes.Buffer{}
+	return phuslu.NewLogger[any](level, buf, cfg), buf
+}
+
+func TestLogger_JSONFormat(t *testing.T) {
+	cfg := phuslu.DefaultConfig()
+	cfg.Format = phuslu.FormatJSON
+	logger, buf := newTestLogger("info", cfg)
+
+	logger.Info("hello", "slot", 42)
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("expected valid JSON, got %q: %v", buf.String(), err)
+	}
+	if entry["message"] != "hello" {
+		t.Errorf("expected message %q, got %v", "hello", entry["message"])
+	}
+	if entry["level"] != "info" {
+		t.Errorf("expected level %q, got %v", "info", entry["level"])
+	}
+	if entry["slot"] != float64(42) {
+		t.Errorf("expected slot 42, got %v", entry["slot"])
+	}
+}
+
+func TestLogger_LogfmtFormat(t *testing.T) {
+	cfg := phuslu.DefaultConfig()
+	cfg.Format = phuslu.FormatLogfmt
+	logger, buf := newTestLogger("info", cfg)
+
+	logger.Info("hello", "slot", 42)
+
+	out := buf.String()
+	for _, want := range []string{"level=info", "slot=42", "hello"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output %q", want, out)
+		}
+	}
+}
+
+func TestLogger_ModuleLevels(t *testing.T) {
+	cfg := phuslu.DefaultConfig()
+	cfg.Format = phuslu.FormatJSON
+	cfg.ModuleLevels = map[string]string{
+		"engine":     "debug",
+		"blockchain": "error",
+	}
+	logger, buf := newTestLogger("info", cfg)
+
+	engine, ok := logger.With("service", "engine").(testLogger)
+	if !ok {
+		t.Fatal("expected With to return a phuslu logger")
+	}
+	chain, ok := logger.With("service", "blockchain").(testLogger)
+	if !ok {
+		t.Fatal("expected With to return a phuslu logger")
+	}
+
+	engine.Debug("engine debug")
+	chain.Info("chain info")
+	logger.Debug("root debug")
+
+	out := buf.String()
+	if !strings.Contains(out, "engine debug") {
+		t.Errorf("expected engine debug line in output %q", out)
+	}
+	if strings.Contains(out, "chain info") {
+		t.Errorf("expected chain info line to be filtered, got %q", out)
+	}
+	if strings.Contains(out, "root debug") {
+		t.Errorf("expected root debug line to be filtered, got %q", out)
+	}
+}
+
+func TestLogger_Sampling(t *testing.T) {
+	cfg := phuslu.DefaultConfig()
+	cfg.Format = phuslu.FormatJSON
+	cfg.Sampling = phuslu.SamplingConfig{
+		Enabled:    true,
+		Initial:    2,
+		Thereafter: 5,
+		Tick:       time.Hour,
+	}
+	logger, buf := newTestLogger("debug", cfg)
+
+	for range 12 {
+		logger.Debug("hot line")
+	}
+	logger.Info("info line")
+
+	// 2 initial lines, then lines 7 and 12.
+	if got := strings.Count(buf.String(), "hot line"); got != 4 {
+		t.Errorf("expected 4 sampled lines, got %d", got)
+	}
+	if !strings.Contains(buf.String(), "info line") {
+		t.Errorf("expected info lines not to be sampled")
+	}
+}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// sampler limits the number of log lines emitted per message. Within every
+// tick the first initial lines for a message are allowed, after which only
+// every thereafter-th line is allowed.
+type sampler struct {
+	initial    uint64
+	thereafter uint64
+	tick       time.Duration
+
+	// counters maps a message to its counter for the current tick.
+	counters sync.Map
+}
+
+// counter tracks the number of lines seen for a message in the current tick.
+type counter struct {
+	resetAt atomic.Int64
+	count   atomic.Uint64
+}
+
+// newSampler creates a new sampler from the given configuration. It returns
+// nil if sampling is disabled.
+func newSampler(cfg SamplingConfig) *sampler {
+	if !cfg.Enabled {
+		return nil
+	}
+	return &sampler{
+		initial:    cfg.Initial,
+		thereafter: cfg.Thereafter,
+		tick:       cfg.Tick,
+	}
+}
+
+// Allow returns true if a line with the given message should be logged.
+func (s *sampler) Allow(msg string) bool {
+	now := time.Now().UnixNano()
+	v, _ := s.counters.LoadOrStore(msg, &counter{})
+	c, ok := v.(*counter)
+	if !ok {
+		return true
+	}
+
+	resetAt := c.resetAt.Load()
+	if now > resetAt && c.resetAt.CompareAndSwap(
+		resetAt, now+s.tick.Nanoseconds(),
+	) {
+		c.count.Store(0)
+	}
+
+	n := c.count.Add(1)
+	if n <= s.initial {
+		return true
+	}
+	if s.thereafter == 0 {
+		return false
+	}
+	return (n-s.initial)%s.thereafter == 0
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu
+
+import (
+	"io"
+
+	"github.com/phuslu/log"
+)
+
+// newWriter builds the log.Writer for the given output and configuration.
+// If a file writer is enabled, entries are written to both the output and
+// the rotating log file, using the same format.
+func newWriter(out io.Writer, cfg *Config) log.Writer {
+	writer := newFormatWriter(out, cfg.Format)
+	if !cfg.File.Enabled || cfg.File.Path == "" {
+		return writer
+	}
+
+	return &log.MultiEntryWriter{
+		writer,
+		newFormatWriter(&log.FileWriter{
+			Filename:     cfg.File.Path,
+			MaxSize:      cfg.File.MaxSize,
+			MaxBackups:   cfg.File.MaxBackups,
+			EnsureFolder: true,
+		}, fileFormat(cfg.Format)),
+	}
+}
+
+// newFormatWriter returns a log.Writer that writes to out in the given
+// format. Unknown formats fall back to the console format.
+func newFormatWriter(out io.Writer, format string) log.Writer {
+	switch format {
+	case FormatJSON:
+		return &log.IOWriter{Writer: out}
+	case FormatLogfmt:
+		return &log.ConsoleWriter{
+			Writer:    out,
+			Formatter: log.LogfmtFormatter{TimeField: "time"}.Formatter,
+		}
+	default:
+		return &log.ConsoleWriter{
+			Writer:    out,
+			Formatter: NewFormatter().Format,
+		}
+	}
+}
+
+// fileFormat returns the format used for the log file. Colour escape codes
+// are not wanted in files, so the console format is written as logfmt.
+func fileFormat(format string) string {
+	if format == FormatJSON {
+		return FormatJSON
+	}
+	return FormatLogfmt
+}
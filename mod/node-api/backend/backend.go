@@ -24,13 +24,21 @@ import (
 	"context"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4881"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 type Backend struct {
-	getNewStateDB func(context.Context, string) StateDB
+	getNewStateDB  func(context.Context, string) StateDB
+	depositTree    DepositTree
+	blockStore     BlockStore
+	blockPublisher BlindedBlockPublisher
+	blobStore      BlobStore
+	proposerConfig ProposerConfig
 }
 
 // TODO: need to add state_id resolver; possible values are: "head" (canonical
@@ -38,12 +46,90 @@ type Backend struct {
 // encoded stateRoot with 0x prefix>.
 func New(
 	getNewStateDB func(ctx context.Context, stateId string) StateDB,
+	depositTree DepositTree,
+	blockStore BlockStore,
+	blockPublisher BlindedBlockPublisher,
+	blobStore BlobStore,
+	proposerConfig ProposerConfig,
 ) *Backend {
 	return &Backend{
-		getNewStateDB: getNewStateDB,
+		getNewStateDB:  getNewStateDB,
+		depositTree:    depositTree,
+		blockStore:     blockStore,
+		blockPublisher: blockPublisher,
+		blobStore:      blobStore,
+		proposerConfig: proposerConfig,
 	}
 }
 
+// DepositTree is the deposit tree the deposit snapshot is served from.
+type DepositTree interface {
+	Snapshot() *eip4881.Snapshot
+}
+
+// BlockStore is the store the blinded blocks are served from.
+type BlockStore interface {
+	// GetBlock returns the block with the given block ID, or
+	// ErrBlockNotFound.
+	GetBlock(ctx context.Context, blockID string) (*types.BeaconBlock, error)
+}
+
+// BlindedBlockPublisher unblinds and publishes the blocks submitted in
+// blinded form.
+type BlindedBlockPublisher interface {
+	PublishBlindedBlock(
+		ctx context.Context,
+		blk *types.SignedBlindedBeaconBlockDeneb,
+	) error
+}
+
+// BlobStore is the store the blob sidecars are served from, falling back to
+// the archive for the sidecars already pruned.
+type BlobStore interface {
+	// GetBlobSidecars returns the sidecars of the given commitments of the
+	// block at the given slot.
+	GetBlobSidecars(
+		ctx context.Context,
+		slot math.Slot,
+		commitments []eip4844.KZGCommitment,
+	) (*datypes.BlobSidecars, error)
+	// GetBlobSidecarsByVersionedHashes returns the sidecars carrying the
+	// blobs of the given versioned hashes.
+	GetBlobSidecarsByVersionedHashes(
+		ctx context.Context,
+		versionedHashes []common.ExecutionHash,
+	) (*datypes.BlobSidecars, error)
+}
+
+// ProposerConfig is the config the fee recipient, gas limit and graffiti of
+// the validator keys are served from and edited in.
+type ProposerConfig interface {
+	// FeeRecipient returns the fee recipient of the given key.
+	FeeRecipient(pubkey crypto.BLSPubkey) common.ExecutionAddress
+	// SetFeeRecipient sets the fee recipient of the given key.
+	SetFeeRecipient(
+		pubkey crypto.BLSPubkey,
+		feeRecipient common.ExecutionAddress,
+	) error
+	// DeleteFeeRecipient reverts the fee recipient of the given key to the
+	// default one.
+	DeleteFeeRecipient(pubkey crypto.BLSPubkey) error
+	// GasLimit returns the gas limit of the given key.
+	GasLimit(pubkey crypto.BLSPubkey) math.U64
+	// SetGasLimit sets the gas limit of the given key.
+	SetGasLimit(pubkey crypto.BLSPubkey, gasLimit math.U64) error
+	// DeleteGasLimit reverts the gas limit of the given key to the default
+	// one.
+	DeleteGasLimit(pubkey crypto.BLSPubkey) error
+	// Graffiti returns the graffiti of the given key.
+	Graffiti(pubkey crypto.BLSPubkey) string
+	// SetGraffiti sets the graffiti of the given key.
+	SetGraffiti(pubkey crypto.BLSPubkey, graffiti string) error
+	// DeleteGraffiti reverts the graffiti of the given key to the default
+	// one.
+	DeleteGraffiti(pubkey crypto.BLSPubkey) error
+}
+
 type StateDB interface {
 	GetGenesisValidatorsRoot() (common.Root, error)
 	GetSlot() (math.Slot, error)
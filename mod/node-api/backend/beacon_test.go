@@ -24,9 +24,17 @@ import (
 	"context"
 	"testing"
 
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/node-api/backend"
 	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
+	serverType "github.com/berachain/beacon-kit/mod/node-api/server/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4881"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/stretchr/testify/require"
 )
 
@@ -34,9 +42,211 @@ func TestGetGenesisValidatorsRoot(t *testing.T) {
 	sdb := &mocks.StateDB{}
 	b := backend.New(func(context.Context, string) backend.StateDB {
 		return sdb
-	})
+	}, eip4881.NewDepositTree(), nil, nil, nil, nil)
 	sdb.EXPECT().GetGenesisValidatorsRoot().Return(common.Root{0x01}, nil)
 	root, err := b.GetGenesis(context.Background())
 	require.NoError(t, err)
 	require.Equal(t, common.Root{0x01}, root)
 }
+
+func TestGetDepositSnapshot(t *testing.T) {
+	tree := eip4881.NewDepositTree()
+	for i := range byte(5) {
+		require.NoError(t, tree.PushLeaf(common.Root{i}))
+	}
+	require.NoError(t, tree.Finalize(4, common.ExecutionHash{0x02}, 7))
+
+	b := backend.New(func(context.Context, string) backend.StateDB {
+		return &mocks.StateDB{}
+	}, tree, nil, nil, nil, nil)
+	snapshot, err := b.GetDepositSnapshot(context.Background())
+	require.NoError(t, err)
+
+	expected := tree.Snapshot()
+	require.Equal(t, expected.Finalized, snapshot.Finalized)
+	require.Equal(t, expected.DepositRoot, snapshot.DepositRoot)
+	require.Equal(t, uint64(4), snapshot.DepositCount)
+	require.Equal(t, common.ExecutionHash{0x02}, snapshot.ExecutionBlockHash)
+	require.Equal(t, uint64(7), snapshot.ExecutionBlockHeight)
+}
+
+type blockStore map[string]*types.BeaconBlock
+
+func (s blockStore) GetBlock(
+	_ context.Context, blockID string,
+) (*types.BeaconBlock, error) {
+	blk, ok := s[blockID]
+	if !ok {
+		return nil, serverType.ErrBlockNotFound
+	}
+	return blk, nil
+}
+
+type blockPublisher []*types.SignedBlindedBeaconBlockDeneb
+
+func (p *blockPublisher) PublishBlindedBlock(
+	_ context.Context, blk *types.SignedBlindedBeaconBlockDeneb,
+) error {
+	*p = append(*p, blk)
+	return nil
+}
+
+func TestBlindedBlocks(t *testing.T) {
+	blk := &types.BeaconBlockDeneb{
+		BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{Slot: 3},
+		Body: &types.BeaconBlockBodyDeneb{
+			ExecutionPayload: &types.ExecutableDataDeneb{
+				LogsBloom:    make([]byte, 256),
+				ExtraData:    []byte{},
+				Transactions: [][]byte{{0x01}},
+				Withdrawals:  []*engineprimitives.Withdrawal{},
+			},
+			BlobKzgCommitments: []eip4844.KZGCommitment{{0x01}},
+		},
+	}
+	publisher := &blockPublisher{}
+	b := backend.New(
+		func(context.Context, string) backend.StateDB {
+			return &mocks.StateDB{}
+		},
+		eip4881.NewDepositTree(),
+		blockStore{"head": {RawBeaconBlock: blk}},
+		publisher,
+		nil,
+		nil,
+	)
+
+	blinded, err := b.GetBlindedBlock(context.Background(), "head")
+	require.NoError(t, err)
+	blkRoot, err := blk.HashTreeRoot()
+	require.NoError(t, err)
+	blindedRoot, err := blinded.Message.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, blkRoot, blindedRoot)
+
+	_, err = b.GetBlindedBlock(context.Background(), "genesis")
+	require.ErrorIs(t, err, serverType.ErrBlockNotFound)
+
+	require.NoError(t, b.PublishBlindedBlock(context.Background(), blinded))
+	require.Equal(t, blockPublisher{blinded}, *publisher)
+	require.ErrorIs(
+		t,
+		b.PublishBlindedBlock(
+			context.Background(), &types.SignedBlindedBeaconBlockDeneb{},
+		),
+		serverType.ErrInvalidBlindedBlock,
+	)
+}
+
+type blobStore map[eip4844.KZGCommitment]*datypes.BlobSidecar
+
+func (s blobStore) GetBlobSidecars(
+	_ context.Context, slot math.Slot, commitments []eip4844.KZGCommitment,
+) (*datypes.BlobSidecars, error) {
+	sidecars := &datypes.BlobSidecars{}
+	for _, commitment := range commitments {
+		sidecar, ok := s[commitment]
+		if !ok || sidecar.BeaconBlockHeader.GetSlot() != slot {
+			return nil, dastore.ErrSidecarNotFound
+		}
+		sidecars.Sidecars = append(sidecars.Sidecars, sidecar)
+	}
+	return sidecars, nil
+}
+
+func (s blobStore) GetBlobSidecarsByVersionedHashes(
+	_ context.Context, versionedHashes []common.ExecutionHash,
+) (*datypes.BlobSidecars, error) {
+	byHash := make(map[common.ExecutionHash]*datypes.BlobSidecar, len(s))
+	for commitment, sidecar := range s {
+		byHash[commitment.ToVersionedHash()] = sidecar
+	}
+	sidecars := &datypes.BlobSidecars{}
+	for _, versionedHash := range versionedHashes {
+		sidecar, ok := byHash[versionedHash]
+		if !ok {
+			return nil, dastore.ErrSidecarNotFound
+		}
+		sidecars.Sidecars = append(sidecars.Sidecars, sidecar)
+	}
+	return sidecars, nil
+}
+
+func TestGetBlobSidecars(t *testing.T) {
+	blk := &types.BeaconBlockDeneb{
+		BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{Slot: 3},
+		Body: &types.BeaconBlockBodyDeneb{
+			BlobKzgCommitments: []eip4844.KZGCommitment{{0x01}, {0x02}},
+		},
+	}
+	blobs := blobStore{}
+	for i, commitment := range blk.Body.BlobKzgCommitments {
+		blobs[commitment] = &datypes.BlobSidecar{
+			Index:         uint64(i),
+			KzgCommitment: commitment,
+			BeaconBlockHeader: types.NewBeaconBlockHeader(
+				3, 4, common.Root{0x05}, common.Root{0x06}, common.Root{0x07},
+			),
+			InclusionProof: [][32]byte{{0x08}},
+		}
+	}
+	b := backend.New(
+		func(context.Context, string) backend.StateDB {
+			return &mocks.StateDB{}
+		},
+		eip4881.NewDepositTree(),
+		blockStore{"head": {RawBeaconBlock: blk}},
+		nil,
+		blobs,
+		nil,
+	)
+
+	sidecars, err := b.GetBlobSidecars(context.Background(), "head", nil)
+	require.NoError(t, err)
+	require.Len(t, sidecars, 2)
+	require.Equal(t, eip4844.KZGCommitment{0x02}, sidecars[1].KzgCommitment)
+	require.Equal(t, serverType.BlockHeaderData{
+		Slot:          3,
+		ProposerIndex: 4,
+		ParentRoot:    common.Root{0x05},
+		StateRoot:     common.Root{0x06},
+		BodyRoot:      common.Root{0x07},
+	}, sidecars[1].SignedBlockHeader.Message)
+	require.Equal(t,
+		[]common.Root{{0x08}}, sidecars[1].KzgCommitmentInclusionProof,
+	)
+
+	sidecars, err = b.GetBlobSidecars(
+		context.Background(), "head", []uint64{1, 5},
+	)
+	require.NoError(t, err)
+	require.Len(t, sidecars, 1)
+	require.Equal(t, uint64(1), sidecars[0].Index)
+
+	sidecars, err = b.GetBlobSidecars(context.Background(), "head", []uint64{5})
+	require.NoError(t, err)
+	require.Empty(t, sidecars)
+
+	sidecars, err = b.GetBlobSidecarsByVersionedHashes(
+		context.Background(),
+		[]common.ExecutionHash{
+			eip4844.KZGCommitment{0x02}.ToVersionedHash(),
+			eip4844.KZGCommitment{0x01}.ToVersionedHash(),
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, sidecars, 2)
+	require.Equal(t, uint64(1), sidecars[0].Index)
+	require.Equal(t, uint64(0), sidecars[1].Index)
+
+	delete(blobs, eip4844.KZGCommitment{0x01})
+	_, err = b.GetBlobSidecars(context.Background(), "head", nil)
+	require.ErrorIs(t, err, serverType.ErrBlobSidecarNotFound)
+	_, err = b.GetBlobSidecarsByVersionedHashes(
+		context.Background(),
+		[]common.ExecutionHash{eip4844.KZGCommitment{0x01}.ToVersionedHash()},
+	)
+	require.ErrorIs(t, err, serverType.ErrBlobSidecarNotFound)
+	_, err = b.GetBlobSidecars(context.Background(), "genesis", nil)
+	require.ErrorIs(t, err, serverType.ErrBlockNotFound)
+}
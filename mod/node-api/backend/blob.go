@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	serverType "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+)
+
+// GetBlobSidecars returns the blob sidecars of the block with the given
+// block ID, only those of the given indices if any.
+func (h Backend) GetBlobSidecars(
+	ctx context.Context,
+	blockID string,
+	indices []uint64,
+) ([]*serverType.BlobSidecarData, error) {
+	blk, err := h.blockStore.GetBlock(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+
+	all := blk.GetBody().GetBlobKzgCommitments()
+	commitments := make([]eip4844.KZGCommitment, 0, len(all))
+	for i, commitment := range all {
+		if len(indices) == 0 || slices.Contains(indices, uint64(i)) {
+			commitments = append(commitments, commitment)
+		}
+	}
+	if len(commitments) == 0 {
+		return []*serverType.BlobSidecarData{}, nil
+	}
+
+	sidecars, err := h.blobStore.GetBlobSidecars(
+		ctx, blk.GetSlot(), commitments,
+	)
+	if errors.Is(err, dastore.ErrSidecarNotFound) {
+		return nil, errors.Wrap(serverType.ErrBlobSidecarNotFound, err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+
+	return blobSidecarsData(sidecars), nil
+}
+
+// GetBlobSidecarsByVersionedHashes returns the blob sidecars carrying the
+// blobs of the given versioned hashes, in the same order.
+func (h Backend) GetBlobSidecarsByVersionedHashes(
+	ctx context.Context,
+	versionedHashes []common.ExecutionHash,
+) ([]*serverType.BlobSidecarData, error) {
+	sidecars, err := h.blobStore.GetBlobSidecarsByVersionedHashes(
+		ctx, versionedHashes,
+	)
+	if errors.Is(err, dastore.ErrSidecarNotFound) {
+		return nil, errors.Wrap(serverType.ErrBlobSidecarNotFound, err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+	return blobSidecarsData(sidecars), nil
+}
+
+// blobSidecarsData returns the API representation of the given sidecars.
+func blobSidecarsData(
+	sidecars *datypes.BlobSidecars,
+) []*serverType.BlobSidecarData {
+	data := make([]*serverType.BlobSidecarData, 0, sidecars.Len())
+	for _, sidecar := range sidecars.Sidecars {
+		data = append(data, blobSidecarData(sidecar))
+	}
+	return data
+}
+
+// blobSidecarData returns the API representation of the given sidecar.
+// Blocks are not signed at the consensus layer, so the signature of the
+// header is left empty.
+func blobSidecarData(
+	sidecar *datypes.BlobSidecar,
+) *serverType.BlobSidecarData {
+	header := sidecar.BeaconBlockHeader
+	if header == nil {
+		header = new(types.BeaconBlockHeader)
+	}
+	proof := make([]common.Root, len(sidecar.InclusionProof))
+	for i, node := range sidecar.InclusionProof {
+		proof[i] = node
+	}
+	return &serverType.BlobSidecarData{
+		Index:         sidecar.Index,
+		Blob:          sidecar.Blob,
+		KzgCommitment: sidecar.KzgCommitment,
+		KzgProof:      sidecar.KzgProof,
+		SignedBlockHeader: serverType.SignedBlockHeaderData{
+			Message: serverType.BlockHeaderData{
+				Slot:          header.GetSlot().Unwrap(),
+				ProposerIndex: header.GetProposerIndex().Unwrap(),
+				ParentRoot:    header.GetParentBlockRoot(),
+				StateRoot:     header.GetStateRoot(),
+				BodyRoot:      header.BodyRoot,
+			},
+		},
+		KzgCommitmentInclusionProof: proof,
+	}
+}
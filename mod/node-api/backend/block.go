@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	serverType "github.com/berachain/beacon-kit/mod/node-api/server/types"
+)
+
+// GetBlindedBlock returns the block with the given block ID in blinded
+// form. Blocks are not signed at the consensus layer, so the signature is
+// left empty.
+func (h Backend) GetBlindedBlock(
+	ctx context.Context,
+	blockID string,
+) (*types.SignedBlindedBeaconBlockDeneb, error) {
+	blk, err := h.blockStore.GetBlock(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	deneb, ok := blk.RawBeaconBlock.(*types.BeaconBlockDeneb)
+	if !ok {
+		return nil, serverType.ErrUnsupportedBlock
+	}
+	blinded, err := deneb.Blind()
+	if err != nil {
+		return nil, err
+	}
+	return &types.SignedBlindedBeaconBlockDeneb{Message: blinded}, nil
+}
+
+// PublishBlindedBlock hands the blinded block over to the publisher.
+func (h Backend) PublishBlindedBlock(
+	ctx context.Context,
+	blk *types.SignedBlindedBeaconBlockDeneb,
+) error {
+	if blk == nil || blk.Message == nil || blk.Message.Body == nil ||
+		blk.Message.Body.ExecutionPayloadHeader == nil {
+		return serverType.ErrInvalidBlindedBlock
+	}
+	return h.blockPublisher.PublishBlindedBlock(ctx, blk)
+}
@@ -22,11 +22,19 @@ package backend
 
 import (
 	"context"
+	"sync"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
+	serverType "github.com/berachain/beacon-kit/mod/node-api/server/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4881"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -34,11 +42,224 @@ func NewMockBackend() *Backend {
 	sdb := &mocks.StateDB{}
 	b := New(func(context.Context, string) StateDB {
 		return sdb
-	})
+	}, newMockDepositTree(), newMockBlockStore(), &mockBlockPublisher{},
+		newMockBlobStore(), newMockProposerConfig())
 	setReturnValues(sdb)
 	return b
 }
 
+// newMockDepositTree returns a deposit tree holding three deposits, the
+// first two of which are finalized.
+func newMockDepositTree() *eip4881.DepositTree {
+	tree := eip4881.NewDepositTree()
+	for i := range byte(3) {
+		if err := tree.PushLeaf(common.Root{i + 1}); err != nil {
+			panic(err)
+		}
+	}
+	if err := tree.Finalize(2, common.ExecutionHash{0x01}, 1); err != nil {
+		panic(err)
+	}
+	return tree
+}
+
+// mockBlockStore serves a single empty Deneb block as the head.
+type mockBlockStore struct {
+	blocks map[string]*types.BeaconBlock
+}
+
+func newMockBlockStore() *mockBlockStore {
+	return &mockBlockStore{
+		blocks: map[string]*types.BeaconBlock{
+			"head": {RawBeaconBlock: &types.BeaconBlockDeneb{
+				BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{Slot: 1},
+				Body: &types.BeaconBlockBodyDeneb{
+					ExecutionPayload: &types.ExecutableDataDeneb{
+						LogsBloom:    make([]byte, 256),
+						ExtraData:    []byte{},
+						Transactions: [][]byte{},
+						Withdrawals:  []*engineprimitives.Withdrawal{},
+					},
+					BlobKzgCommitments: []eip4844.KZGCommitment{{0x01}},
+				},
+			}},
+		},
+	}
+}
+
+func (s *mockBlockStore) GetBlock(
+	_ context.Context,
+	blockID string,
+) (*types.BeaconBlock, error) {
+	blk, ok := s.blocks[blockID]
+	if !ok {
+		return nil, serverType.ErrBlockNotFound
+	}
+	return blk, nil
+}
+
+// mockBlobStore serves the sidecar of a single blob of the head block.
+type mockBlobStore struct {
+	sidecars map[eip4844.KZGCommitment]*datypes.BlobSidecar
+}
+
+func newMockBlobStore() *mockBlobStore {
+	return &mockBlobStore{
+		sidecars: map[eip4844.KZGCommitment]*datypes.BlobSidecar{
+			{0x01}: {
+				KzgCommitment: eip4844.KZGCommitment{0x01},
+				BeaconBlockHeader: types.NewBeaconBlockHeader(
+					1, 0, common.Root{}, common.Root{}, common.Root{},
+				),
+				InclusionProof: make([][32]byte, 0),
+			},
+		},
+	}
+}
+
+func (s *mockBlobStore) GetBlobSidecars(
+	_ context.Context,
+	_ math.Slot,
+	commitments []eip4844.KZGCommitment,
+) (*datypes.BlobSidecars, error) {
+	sidecars := &datypes.BlobSidecars{}
+	for _, commitment := range commitments {
+		sidecar, ok := s.sidecars[commitment]
+		if !ok {
+			return nil, dastore.ErrSidecarNotFound
+		}
+		sidecars.Sidecars = append(sidecars.Sidecars, sidecar)
+	}
+	return sidecars, nil
+}
+
+func (s *mockBlobStore) GetBlobSidecarsByVersionedHashes(
+	_ context.Context,
+	versionedHashes []common.ExecutionHash,
+) (*datypes.BlobSidecars, error) {
+	sidecars := &datypes.BlobSidecars{}
+	for _, versionedHash := range versionedHashes {
+		var found bool
+		for commitment, sidecar := range s.sidecars {
+			if commitment.ToVersionedHash() == versionedHash {
+				sidecars.Sidecars = append(sidecars.Sidecars, sidecar)
+				found = true
+			}
+		}
+		if !found {
+			return nil, dastore.ErrSidecarNotFound
+		}
+	}
+	return sidecars, nil
+}
+
+// mockProposerConfig holds the settings of the validator keys in memory,
+// with a gas limit of 30M by default.
+type mockProposerConfig struct {
+	mu            sync.Mutex
+	feeRecipients map[crypto.BLSPubkey]common.ExecutionAddress
+	gasLimits     map[crypto.BLSPubkey]math.U64
+	graffitis     map[crypto.BLSPubkey]string
+}
+
+func newMockProposerConfig() *mockProposerConfig {
+	return &mockProposerConfig{
+		feeRecipients: make(map[crypto.BLSPubkey]common.ExecutionAddress),
+		gasLimits:     make(map[crypto.BLSPubkey]math.U64),
+		graffitis:     make(map[crypto.BLSPubkey]string),
+	}
+}
+
+func (p *mockProposerConfig) FeeRecipient(
+	pubkey crypto.BLSPubkey,
+) common.ExecutionAddress {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.feeRecipients[pubkey]
+}
+
+func (p *mockProposerConfig) SetFeeRecipient(
+	pubkey crypto.BLSPubkey,
+	feeRecipient common.ExecutionAddress,
+) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.feeRecipients[pubkey] = feeRecipient
+	return nil
+}
+
+func (p *mockProposerConfig) DeleteFeeRecipient(pubkey crypto.BLSPubkey) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.feeRecipients, pubkey)
+	return nil
+}
+
+func (p *mockProposerConfig) GasLimit(pubkey crypto.BLSPubkey) math.U64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if gasLimit, ok := p.gasLimits[pubkey]; ok {
+		return gasLimit
+	}
+	return 30_000_000 //nolint:mnd // default.
+}
+
+func (p *mockProposerConfig) SetGasLimit(
+	pubkey crypto.BLSPubkey,
+	gasLimit math.U64,
+) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.gasLimits[pubkey] = gasLimit
+	return nil
+}
+
+func (p *mockProposerConfig) DeleteGasLimit(pubkey crypto.BLSPubkey) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.gasLimits, pubkey)
+	return nil
+}
+
+func (p *mockProposerConfig) Graffiti(pubkey crypto.BLSPubkey) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.graffitis[pubkey]
+}
+
+func (p *mockProposerConfig) SetGraffiti(
+	pubkey crypto.BLSPubkey,
+	graffiti string,
+) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.graffitis[pubkey] = graffiti
+	return nil
+}
+
+func (p *mockProposerConfig) DeleteGraffiti(pubkey crypto.BLSPubkey) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.graffitis, pubkey)
+	return nil
+}
+
+// mockBlockPublisher records the blinded blocks it is handed.
+type mockBlockPublisher struct {
+	mu        sync.Mutex
+	published []*types.SignedBlindedBeaconBlockDeneb
+}
+
+func (p *mockBlockPublisher) PublishBlindedBlock(
+	_ context.Context,
+	blk *types.SignedBlindedBeaconBlockDeneb,
+) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.published = append(p.published, blk)
+	return nil
+}
+
 func setReturnValues(sdb *mocks.StateDB) {
 	sdb.EXPECT().GetGenesisValidatorsRoot().Return(common.Root{0x01}, nil)
 	sdb.EXPECT().GetSlot().Return(1, nil)
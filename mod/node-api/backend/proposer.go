@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// GetFeeRecipient returns the fee recipient of the given validator key.
+func (h Backend) GetFeeRecipient(
+	_ context.Context,
+	pubkey crypto.BLSPubkey,
+) (common.ExecutionAddress, error) {
+	return h.proposerConfig.FeeRecipient(pubkey), nil
+}
+
+// SetFeeRecipient sets the fee recipient of the given validator key.
+func (h Backend) SetFeeRecipient(
+	_ context.Context,
+	pubkey crypto.BLSPubkey,
+	feeRecipient common.ExecutionAddress,
+) error {
+	return h.proposerConfig.SetFeeRecipient(pubkey, feeRecipient)
+}
+
+// DeleteFeeRecipient reverts the fee recipient of the given validator key
+// to the default one.
+func (h Backend) DeleteFeeRecipient(
+	_ context.Context,
+	pubkey crypto.BLSPubkey,
+) error {
+	return h.proposerConfig.DeleteFeeRecipient(pubkey)
+}
+
+// GetGasLimit returns the gas limit of the given validator key.
+func (h Backend) GetGasLimit(
+	_ context.Context,
+	pubkey crypto.BLSPubkey,
+) (math.U64, error) {
+	return h.proposerConfig.GasLimit(pubkey), nil
+}
+
+// SetGasLimit sets the gas limit of the given validator key.
+func (h Backend) SetGasLimit(
+	_ context.Context,
+	pubkey crypto.BLSPubkey,
+	gasLimit math.U64,
+) error {
+	return h.proposerConfig.SetGasLimit(pubkey, gasLimit)
+}
+
+// DeleteGasLimit reverts the gas limit of the given validator key to the
+// default one.
+func (h Backend) DeleteGasLimit(
+	_ context.Context,
+	pubkey crypto.BLSPubkey,
+) error {
+	return h.proposerConfig.DeleteGasLimit(pubkey)
+}
+
+// GetGraffiti returns the graffiti of the given validator key.
+func (h Backend) GetGraffiti(
+	_ context.Context,
+	pubkey crypto.BLSPubkey,
+) (string, error) {
+	return h.proposerConfig.Graffiti(pubkey), nil
+}
+
+// SetGraffiti sets the graffiti of the given validator key.
+func (h Backend) SetGraffiti(
+	_ context.Context,
+	pubkey crypto.BLSPubkey,
+	graffiti string,
+) error {
+	return h.proposerConfig.SetGraffiti(pubkey, graffiti)
+}
+
+// DeleteGraffiti reverts the graffiti of the given validator key to the
+// default one.
+func (h Backend) DeleteGraffiti(
+	_ context.Context,
+	pubkey crypto.BLSPubkey,
+) error {
+	return h.proposerConfig.DeleteGraffiti(pubkey)
+}
@@ -22,9 +22,13 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"strconv"
 
+	consensustypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	types "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	echo "github.com/labstack/echo/v4"
 )
 
@@ -194,3 +198,113 @@ func (rh RouteHandlers) GetBlockRewards(c echo.Context) error {
 		Data:                rewards,
 	})
 }
+
+func (rh RouteHandlers) GetDepositSnapshot(c echo.Context) error {
+	snapshot, err := rh.Backend.GetDepositSnapshot(context.TODO())
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, WrapData(snapshot))
+}
+
+func (rh RouteHandlers) GetBlindedBlock(c echo.Context) error {
+	params, err := BindAndValidate[types.BlockIDRequest](c)
+	if err != nil {
+		return err
+	}
+	if params == nil {
+		return echo.ErrInternalServerError
+	}
+	blk, err := rh.Backend.GetBlindedBlock(context.TODO(), params.BlockID)
+	switch {
+	case errors.Is(err, types.ErrBlockNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case err != nil:
+		return err
+	}
+	return c.JSON(http.StatusOK, types.VersionedResponse{
+		Version:             consensusVersionDeneb,
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           false, // stubbed
+		Data:                blk,
+	})
+}
+
+func (rh RouteHandlers) PublishBlindedBlock(c echo.Context) error {
+	if v := c.Request().Header.Get(consensusVersionHeader); v != "" &&
+		v != consensusVersionDeneb {
+		return echo.NewHTTPError(
+			http.StatusBadRequest, "unsupported consensus version "+v,
+		)
+	}
+	blk, err := BindAndValidate[consensustypes.SignedBlindedBeaconBlockDeneb](
+		c,
+	)
+	if err != nil {
+		return err
+	}
+	err = rh.Backend.PublishBlindedBlock(context.TODO(), blk)
+	if errors.Is(err, types.ErrInvalidBlindedBlock) {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	} else if err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func (rh RouteHandlers) GetBlobSidecars(c echo.Context) error {
+	params, err := BindAndValidate[types.BlobSidecarRequest](c)
+	if err != nil {
+		return err
+	}
+	if params == nil {
+		return echo.ErrInternalServerError
+	}
+	indices := make([]uint64, 0, len(params.Indices))
+	for _, index := range params.Indices {
+		i, err := strconv.ParseUint(index, 10, 64)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		indices = append(indices, i)
+	}
+	sidecars, err := rh.Backend.GetBlobSidecars(
+		context.TODO(), params.BlockID, indices,
+	)
+	switch {
+	case errors.Is(err, types.ErrBlockNotFound),
+		errors.Is(err, types.ErrBlobSidecarNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case err != nil:
+		return err
+	}
+	return c.JSON(http.StatusOK, WrapData(sidecars))
+}
+
+func (rh RouteHandlers) GetBlobSidecarsByVersionedHash(c echo.Context) error {
+	params, err := BindAndValidate[types.BlobSidecarsByVersionedHashRequest](c)
+	if err != nil {
+		return err
+	}
+	if params == nil {
+		return echo.ErrInternalServerError
+	}
+	versionedHashes := make([]common.ExecutionHash, len(params.VersionedHashes))
+	for i, versionedHash := range params.VersionedHashes {
+		if err = versionedHashes[i].UnmarshalText(
+			[]byte(versionedHash),
+		); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+	}
+	sidecars, err := rh.Backend.GetBlobSidecarsByVersionedHashes(
+		context.TODO(), versionedHashes,
+	)
+	switch {
+	case errors.Is(err, types.ErrBlobSidecarNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case err != nil:
+		return err
+	}
+	return c.JSON(http.StatusOK, WrapData(sidecars))
+}
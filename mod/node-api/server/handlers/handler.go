@@ -25,6 +25,14 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+const (
+	// consensusVersionHeader is the header naming the fork of the
+	// consensus objects in a request.
+	consensusVersionHeader = "Eth-Consensus-Version"
+	// consensusVersionDeneb is the name of the Deneb fork in the API.
+	consensusVersionDeneb = "deneb"
+)
+
 type RouteHandlers struct {
 	Backend types.BackendHandlers
 }
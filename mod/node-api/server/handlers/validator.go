@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	types "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	echo "github.com/labstack/echo/v4"
+)
+
+func (rh RouteHandlers) GetFeeRecipient(c echo.Context) error {
+	pubkey, err := bindPubkey(c)
+	if err != nil {
+		return err
+	}
+	feeRecipient, err := rh.Backend.GetFeeRecipient(context.TODO(), pubkey)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, WrapData(types.FeeRecipientData{
+		Pubkey:     pubkey,
+		EthAddress: feeRecipient,
+	}))
+}
+
+func (rh RouteHandlers) SetFeeRecipient(c echo.Context) error {
+	params, err := BindAndValidate[types.SetFeeRecipientRequest](c)
+	if err != nil {
+		return err
+	}
+	pubkey, err := parsePubkey(params.Pubkey)
+	if err != nil {
+		return err
+	}
+	var feeRecipient common.ExecutionAddress
+	if err = feeRecipient.UnmarshalText(
+		[]byte(params.EthAddress),
+	); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err = rh.Backend.SetFeeRecipient(
+		context.TODO(), pubkey, feeRecipient,
+	); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusAccepted)
+}
+
+func (rh RouteHandlers) DeleteFeeRecipient(c echo.Context) error {
+	pubkey, err := bindPubkey(c)
+	if err != nil {
+		return err
+	}
+	if err = rh.Backend.DeleteFeeRecipient(context.TODO(), pubkey); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (rh RouteHandlers) GetGasLimit(c echo.Context) error {
+	pubkey, err := bindPubkey(c)
+	if err != nil {
+		return err
+	}
+	gasLimit, err := rh.Backend.GetGasLimit(context.TODO(), pubkey)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, WrapData(types.GasLimitData{
+		Pubkey:   pubkey,
+		GasLimit: gasLimit.Unwrap(),
+	}))
+}
+
+func (rh RouteHandlers) SetGasLimit(c echo.Context) error {
+	params, err := BindAndValidate[types.SetGasLimitRequest](c)
+	if err != nil {
+		return err
+	}
+	pubkey, err := parsePubkey(params.Pubkey)
+	if err != nil {
+		return err
+	}
+	gasLimit, err := strconv.ParseUint(params.GasLimit, 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err = rh.Backend.SetGasLimit(
+		context.TODO(), pubkey, math.U64(gasLimit),
+	); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusAccepted)
+}
+
+func (rh RouteHandlers) DeleteGasLimit(c echo.Context) error {
+	pubkey, err := bindPubkey(c)
+	if err != nil {
+		return err
+	}
+	if err = rh.Backend.DeleteGasLimit(context.TODO(), pubkey); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (rh RouteHandlers) GetGraffiti(c echo.Context) error {
+	pubkey, err := bindPubkey(c)
+	if err != nil {
+		return err
+	}
+	graffiti, err := rh.Backend.GetGraffiti(context.TODO(), pubkey)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, WrapData(types.GraffitiData{
+		Pubkey:   pubkey,
+		Graffiti: graffiti,
+	}))
+}
+
+func (rh RouteHandlers) SetGraffiti(c echo.Context) error {
+	params, err := BindAndValidate[types.SetGraffitiRequest](c)
+	if err != nil {
+		return err
+	}
+	pubkey, err := parsePubkey(params.Pubkey)
+	if err != nil {
+		return err
+	}
+	if err = rh.Backend.SetGraffiti(
+		context.TODO(), pubkey, params.Graffiti,
+	); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusAccepted)
+}
+
+func (rh RouteHandlers) DeleteGraffiti(c echo.Context) error {
+	pubkey, err := bindPubkey(c)
+	if err != nil {
+		return err
+	}
+	if err = rh.Backend.DeleteGraffiti(context.TODO(), pubkey); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// bindPubkey binds and validates the validator key in the path of a
+// request, ignoring its body, and returns the key.
+func bindPubkey(c echo.Context) (crypto.BLSPubkey, error) {
+	params := new(types.PubkeyRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, params); err != nil {
+		return crypto.BLSPubkey{}, echo.ErrBadRequest
+	}
+	if err := c.Validate(params); err != nil {
+		return crypto.BLSPubkey{}, echo.NewHTTPError(
+			http.StatusBadRequest, err.Error(),
+		)
+	}
+	return parsePubkey(params.Pubkey)
+}
+
+// parsePubkey decodes a validated hex-encoded validator key.
+func parsePubkey(s string) (crypto.BLSPubkey, error) {
+	var pubkey crypto.BLSPubkey
+	if err := pubkey.UnmarshalText([]byte(s)); err != nil {
+		return pubkey, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return pubkey, nil
+}
@@ -33,6 +33,20 @@ type Handlers interface {
 	GetStateValidatorBalances(c echo.Context) error
 	PostStateValidatorBalances(c echo.Context) error
 	GetBlockRewards(c echo.Context) error
+	GetDepositSnapshot(c echo.Context) error
+	GetBlindedBlock(c echo.Context) error
+	PublishBlindedBlock(c echo.Context) error
+	GetBlobSidecars(c echo.Context) error
+	GetBlobSidecarsByVersionedHash(c echo.Context) error
+	GetFeeRecipient(c echo.Context) error
+	SetFeeRecipient(c echo.Context) error
+	DeleteFeeRecipient(c echo.Context) error
+	GetGasLimit(c echo.Context) error
+	SetGasLimit(c echo.Context) error
+	DeleteGasLimit(c echo.Context) error
+	GetGraffiti(c echo.Context) error
+	SetGraffiti(c echo.Context) error
+	DeleteGraffiti(c echo.Context) error
 }
 
 func UseMiddlewares(e *echo.Echo, middlewares ...echo.MiddlewareFunc) {
@@ -50,6 +64,7 @@ func AssignRoutes(e *echo.Echo, handler Handlers) {
 	aasignNodeRoutes(e, handler)
 	assignValidatorRoutes(e, handler)
 	assignRewardsRoutes(e, handler)
+	assignKeymanagerRoutes(e, handler)
 }
 
 func assignBeaconRoutes(e *echo.Echo, h Handlers) {
@@ -82,9 +97,9 @@ func assignBeaconRoutes(e *echo.Echo, h Handlers) {
 	e.GET("/eth/v1/beacon/headers/:block_id",
 		h.NotImplemented)
 	e.POST("/eth/v1/beacon/blocks/blinded_blocks",
-		h.NotImplemented)
+		h.PublishBlindedBlock)
 	e.POST("/eth/v2/beacon/blocks/blinded_blocks",
-		h.NotImplemented)
+		h.PublishBlindedBlock)
 	e.POST("/eth/v1/beacon/blocks",
 		h.NotImplemented)
 	e.POST("/eth/v2/beacon/blocks",
@@ -96,15 +111,17 @@ func assignBeaconRoutes(e *echo.Echo, h Handlers) {
 	e.GET("/eth/v1/beacon/blocks/:block_id/attestations",
 		h.NotImplemented)
 	e.GET("/eth/v1/beacon/blob_sidecars/:block_id",
-		h.NotImplemented)
+		h.GetBlobSidecars)
+	e.GET("/eth/v1/beacon/blob_sidecars/by_versioned_hash",
+		h.GetBlobSidecarsByVersionedHash)
 	e.POST("/eth/v1/beacon/rewards/sync_committee/:block_id",
 		h.NotImplemented)
 	e.GET("/eth/v1/beacon/deposit_snapshot",
-		h.NotImplemented)
+		h.GetDepositSnapshot)
 	e.POST("/eth/v1/beacon/rewards/attestation/:epoch",
 		h.NotImplemented)
 	e.GET("/eth/v1/beacon/blinded_blocks/:block_id",
-		h.NotImplemented)
+		h.GetBlindedBlock)
 	e.GET("/eth/v1/beacon/light_client/bootstrap/:block_root",
 		h.NotImplemented)
 	e.GET("/eth/v1/beacon/light_client/updates",
@@ -225,3 +242,24 @@ func assignRewardsRoutes(e *echo.Echo, h Handlers) {
 	e.POST("/eth/v1/beacon/rewards/attestations/:epoch",
 		h.NotImplemented)
 }
+
+func assignKeymanagerRoutes(e *echo.Echo, h Handlers) {
+	e.GET("/eth/v1/validator/:pubkey/feerecipient",
+		h.GetFeeRecipient)
+	e.POST("/eth/v1/validator/:pubkey/feerecipient",
+		h.SetFeeRecipient)
+	e.DELETE("/eth/v1/validator/:pubkey/feerecipient",
+		h.DeleteFeeRecipient)
+	e.GET("/eth/v1/validator/:pubkey/gas_limit",
+		h.GetGasLimit)
+	e.POST("/eth/v1/validator/:pubkey/gas_limit",
+		h.SetGasLimit)
+	e.DELETE("/eth/v1/validator/:pubkey/gas_limit",
+		h.DeleteGasLimit)
+	e.GET("/eth/v1/validator/:pubkey/graffiti",
+		h.GetGraffiti)
+	e.POST("/eth/v1/validator/:pubkey/graffiti",
+		h.SetGraffiti)
+	e.DELETE("/eth/v1/validator/:pubkey/graffiti",
+		h.DeleteGraffiti)
+}
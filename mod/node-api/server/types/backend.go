@@ -23,7 +23,10 @@ package types
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 type BackendHandlers interface {
@@ -52,4 +55,49 @@ type BackendHandlers interface {
 		ctx context.Context,
 		blockID string,
 	) (*BlockRewardsData, error)
+	GetDepositSnapshot(ctx context.Context) (*DepositSnapshotData, error)
+	GetBlindedBlock(
+		ctx context.Context,
+		blockID string,
+	) (*types.SignedBlindedBeaconBlockDeneb, error)
+	PublishBlindedBlock(
+		ctx context.Context,
+		blk *types.SignedBlindedBeaconBlockDeneb,
+	) error
+	GetBlobSidecars(
+		ctx context.Context,
+		blockID string,
+		indices []uint64,
+	) ([]*BlobSidecarData, error)
+	GetBlobSidecarsByVersionedHashes(
+		ctx context.Context,
+		versionedHashes []common.ExecutionHash,
+	) ([]*BlobSidecarData, error)
+	GetFeeRecipient(
+		ctx context.Context,
+		pubkey crypto.BLSPubkey,
+	) (common.ExecutionAddress, error)
+	SetFeeRecipient(
+		ctx context.Context,
+		pubkey crypto.BLSPubkey,
+		feeRecipient common.ExecutionAddress,
+	) error
+	DeleteFeeRecipient(ctx context.Context, pubkey crypto.BLSPubkey) error
+	GetGasLimit(
+		ctx context.Context,
+		pubkey crypto.BLSPubkey,
+	) (math.U64, error)
+	SetGasLimit(
+		ctx context.Context,
+		pubkey crypto.BLSPubkey,
+		gasLimit math.U64,
+	) error
+	DeleteGasLimit(ctx context.Context, pubkey crypto.BLSPubkey) error
+	GetGraffiti(ctx context.Context, pubkey crypto.BLSPubkey) (string, error)
+	SetGraffiti(
+		ctx context.Context,
+		pubkey crypto.BLSPubkey,
+		graffiti string,
+	) error
+	DeleteGraffiti(ctx context.Context, pubkey crypto.BLSPubkey) error
 }
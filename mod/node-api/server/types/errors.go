@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import "errors"
+
+var (
+	// ErrBlockNotFound is returned when no block matches a block ID.
+	ErrBlockNotFound = errors.New("block not found")
+	// ErrUnsupportedBlock is returned when a block cannot be served in the
+	// requested form.
+	ErrUnsupportedBlock = errors.New("unsupported block version")
+	// ErrInvalidBlindedBlock is returned when a submitted blinded block is
+	// incomplete.
+	ErrInvalidBlindedBlock = errors.New("invalid blinded block")
+	// ErrBlobSidecarNotFound is returned when a blob sidecar of a block is
+	// neither stored nor archived.
+	ErrBlobSidecarNotFound = errors.New("blob sidecar not found")
+)
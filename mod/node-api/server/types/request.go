@@ -92,3 +92,26 @@ type BlobSidecarRequest struct {
 	BlockIDRequest
 	Indices []string `query:"indices" validate:"dive,uint64"`
 }
+
+type PubkeyRequest struct {
+	Pubkey string `param:"pubkey" validate:"required,pubkey"`
+}
+
+type SetFeeRecipientRequest struct {
+	PubkeyRequest
+	EthAddress string `json:"ethaddress" validate:"required,eth_address"`
+}
+
+type SetGasLimitRequest struct {
+	PubkeyRequest
+	GasLimit string `json:"gas_limit" validate:"required,gas_limit"`
+}
+
+type SetGraffitiRequest struct {
+	PubkeyRequest
+	Graffiti string `json:"graffiti" validate:"graffiti"`
+}
+
+type BlobSidecarsByVersionedHashRequest struct {
+	VersionedHashes []string `query:"versioned_hashes" validate:"required,dive,versioned_hash"`
+}
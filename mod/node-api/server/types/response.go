@@ -23,6 +23,8 @@ package types
 import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 )
 
 type ErrorResponse struct {
@@ -50,6 +52,13 @@ type ValidatorResponse struct {
 	Data                any  `json:"data"`
 }
 
+type VersionedResponse struct {
+	Version             string `json:"version"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+	Finalized           bool   `json:"finalized"`
+	Data                any    `json:"data"`
+}
+
 type ValidatorData struct {
 	Index     uint64           `json:"index,string"`
 	Balance   uint64           `json:"balance,string"`
@@ -77,3 +86,48 @@ type BlockRewardsData struct {
 	ProposerSlashings uint64 `json:"proposer_slashings,string"`
 	AttesterSlashings uint64 `json:"attester_slashings,string"`
 }
+
+type DepositSnapshotData struct {
+	Finalized            []common.Root        `json:"finalized"`
+	DepositRoot          common.Root          `json:"deposit_root"`
+	DepositCount         uint64               `json:"deposit_count,string"`
+	ExecutionBlockHash   common.ExecutionHash `json:"execution_block_hash"`
+	ExecutionBlockHeight uint64               `json:"execution_block_height,string"`
+}
+
+type BlobSidecarData struct {
+	Index                       uint64                `json:"index,string"`
+	Blob                        eip4844.Blob          `json:"blob"`
+	KzgCommitment               eip4844.KZGCommitment `json:"kzg_commitment"`
+	KzgProof                    eip4844.KZGProof      `json:"kzg_proof"`
+	SignedBlockHeader           SignedBlockHeaderData `json:"signed_block_header"`
+	KzgCommitmentInclusionProof []common.Root         `json:"kzg_commitment_inclusion_proof"`
+}
+
+type SignedBlockHeaderData struct {
+	Message   BlockHeaderData     `json:"message"`
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+type BlockHeaderData struct {
+	Slot          uint64      `json:"slot,string"`
+	ProposerIndex uint64      `json:"proposer_index,string"`
+	ParentRoot    common.Root `json:"parent_root"`
+	StateRoot     common.Root `json:"state_root"`
+	BodyRoot      common.Root `json:"body_root"`
+}
+
+type FeeRecipientData struct {
+	Pubkey     crypto.BLSPubkey        `json:"pubkey"`
+	EthAddress common.ExecutionAddress `json:"ethaddress"`
+}
+
+type GasLimitData struct {
+	Pubkey   crypto.BLSPubkey `json:"pubkey"`
+	GasLimit uint64           `json:"gas_limit,string"`
+}
+
+type GraffitiData struct {
+	Pubkey   crypto.BLSPubkey `json:"pubkey"`
+	Graffiti string           `json:"graffiti"`
+}
@@ -24,6 +24,7 @@ import (
 	"regexp"
 	"strconv"
 
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/go-playground/validator/v10"
 )
 
@@ -36,7 +37,13 @@ func ConstructValidator() *validator.Validate {
 		"epoch":            ValidateUint64,
 		"slot":             ValidateUint64,
 		"committee_index":  ValidateUint64,
+		"uint64":           ValidateUint64,
 		"hex":              ValidateHex,
+		"versioned_hash":   ValidateVersionedHash,
+		"pubkey":           ValidatePubkey,
+		"eth_address":      ValidateExecutionAddress,
+		"gas_limit":        ValidateGasLimit,
+		"graffiti":         ValidateGraffiti,
 	}
 	validate := validator.New()
 	for tag, fn := range validators {
@@ -103,6 +110,52 @@ func ValidateHex(fl validator.FieldLevel) bool {
 	return valid
 }
 
+// ValidateVersionedHash checks if the provided field is a hex-encoded
+// versioned hash of a KZG commitment.
+func ValidateVersionedHash(fl validator.FieldLevel) bool {
+	valid, err := validateRegex(fl, `^0x01[0-9a-fA-F]{62}$`)
+	if err != nil {
+		return false
+	}
+	return valid
+}
+
+// ValidatePubkey checks if the provided field is a hex-encoded BLS public
+// key.
+func ValidatePubkey(fl validator.FieldLevel) bool {
+	valid, err := validateRegex(fl, `^0x[0-9a-fA-F]{96}$`)
+	if err != nil {
+		return false
+	}
+	return valid
+}
+
+// ValidateExecutionAddress checks if the provided field is a hex-encoded
+// execution address.
+func ValidateExecutionAddress(fl validator.FieldLevel) bool {
+	valid, err := validateRegex(fl, `^0x[0-9a-fA-F]{40}$`)
+	if err != nil {
+		return false
+	}
+	return valid
+}
+
+// ValidateGasLimit checks if the provided field is a positive gas limit.
+func ValidateGasLimit(fl validator.FieldLevel) bool {
+	value := fl.Field().String()
+	if value == "" {
+		return true
+	}
+	gasLimit, err := strconv.ParseUint(value, 10, 64)
+	return err == nil && gasLimit > 0
+}
+
+// ValidateGraffiti checks if the provided field fits in the graffiti of a
+// block.
+func ValidateGraffiti(fl validator.FieldLevel) bool {
+	return len(fl.Field().String()) <= constants.RootLength
+}
+
 func ValidateValidatorStatus(fl validator.FieldLevel) bool {
 	// Eth Beacon Node API specs: https://hackmd.io/ofFJ5gOmQpu1jjHilHbdQQ
 	allowedStatuses := map[string]bool{
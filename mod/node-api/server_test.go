@@ -153,12 +153,14 @@ func getTestcases() []testcase {
 		{
 			method:         "POST",
 			endpoint:       "/eth/v1/beacon/blocks/blinded_blocks",
-			expectedStatus: http.StatusNotImplemented,
+			body:           `{"message":null}`,
+			expectedStatus: http.StatusBadRequest,
 		},
 		{
 			method:         "POST",
 			endpoint:       "/eth/v2/beacon/blocks/blinded_blocks",
-			expectedStatus: http.StatusNotImplemented,
+			body:           `{"message":null}`,
+			expectedStatus: http.StatusBadRequest,
 		},
 		{
 			method:         "POST",
@@ -188,7 +190,33 @@ func getTestcases() []testcase {
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/beacon/blob_sidecars/:block_id",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/beacon/blob_sidecars/:block_id?indices=1",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"data\":[]}\n",
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/beacon/blob_sidecars/:block_id?indices=a",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/beacon/blob_sidecars/by_versioned_hash?versioned_hashes=0x01f58d44a1f7be92f26abe823e9839a126656a63df1eb249ed6c2c9ccc75daf8",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/beacon/blob_sidecars/by_versioned_hash?versioned_hashes=0x01aa9e5b04b4e6d6b4d6e7d0e8a7a7bd7bfef6a3c8f8bdfd1bd4d8ba43fd3c5d",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/beacon/blob_sidecars/by_versioned_hash?versioned_hashes=0x02",
+			expectedStatus: http.StatusBadRequest,
 		},
 		{
 			method:         "POST",
@@ -198,7 +226,8 @@ func getTestcases() []testcase {
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/beacon/deposit_snapshot",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"data\":{\"finalized\":[\"0xff55c97976a840b4ced964ed49e3794594ba3f675238b5fd25d282b60f70a194\"],\"deposit_root\":\"0x4b950f99ab93deae8b17397196a4e2ac3b099b7db16dcecd41c14d6d37f1428b\",\"deposit_count\":\"2\",\"execution_block_hash\":\"0x0100000000000000000000000000000000000000000000000000000000000000\",\"execution_block_height\":\"1\"}}\n",
 		},
 		{
 			method:         "GET",
@@ -214,7 +243,7 @@ func getTestcases() []testcase {
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/beacon/blinded_blocks/:block_id",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusOK,
 		},
 		{
 			method:         "GET",
@@ -446,6 +475,110 @@ func getTestcases() []testcase {
 			endpoint:       "/eth/v1/validator/liveness/:epoch",
 			expectedStatus: http.StatusNotImplemented,
 		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/validator/:pubkey/feerecipient",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"data\":{\"pubkey\":\"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa\",\"ethaddress\":\"0x0000000000000000000000000000000000000000\"}}\n",
+		},
+		{
+			method:         "POST",
+			endpoint:       "/eth/v1/validator/:pubkey/feerecipient",
+			body:           `{"ethaddress":"0x0000000000000000000000000000000000000001"}`,
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/validator/:pubkey/feerecipient",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"data\":{\"pubkey\":\"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa\",\"ethaddress\":\"0x0000000000000000000000000000000000000001\"}}\n",
+		},
+		{
+			method:         "POST",
+			endpoint:       "/eth/v1/validator/:pubkey/feerecipient",
+			body:           `{"ethaddress":"0x01"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			method:         "DELETE",
+			endpoint:       "/eth/v1/validator/:pubkey/feerecipient",
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/validator/:pubkey/feerecipient",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"data\":{\"pubkey\":\"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa\",\"ethaddress\":\"0x0000000000000000000000000000000000000000\"}}\n",
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/validator/0x01/feerecipient",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/validator/:pubkey/gas_limit",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"data\":{\"pubkey\":\"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa\",\"gas_limit\":\"30000000\"}}\n",
+		},
+		{
+			method:         "POST",
+			endpoint:       "/eth/v1/validator/:pubkey/gas_limit",
+			body:           `{"gas_limit":"36000000"}`,
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/validator/:pubkey/gas_limit",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"data\":{\"pubkey\":\"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa\",\"gas_limit\":\"36000000\"}}\n",
+		},
+		{
+			method:         "POST",
+			endpoint:       "/eth/v1/validator/:pubkey/gas_limit",
+			body:           `{"gas_limit":"0"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			method:         "DELETE",
+			endpoint:       "/eth/v1/validator/:pubkey/gas_limit",
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/validator/:pubkey/gas_limit",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"data\":{\"pubkey\":\"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa\",\"gas_limit\":\"30000000\"}}\n",
+		},
+		{
+			method:         "POST",
+			endpoint:       "/eth/v1/validator/:pubkey/graffiti",
+			body:           `{"graffiti":"hello"}`,
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/validator/:pubkey/graffiti",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"data\":{\"pubkey\":\"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa\",\"graffiti\":\"hello\"}}\n",
+		},
+		{
+			method:         "POST",
+			endpoint:       "/eth/v1/validator/:pubkey/graffiti",
+			body:           `{"graffiti":"ggggggggggggggggggggggggggggggggg"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			method:         "DELETE",
+			endpoint:       "/eth/v1/validator/:pubkey/graffiti",
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/validator/:pubkey/graffiti",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"data\":{\"pubkey\":\"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa\",\"graffiti\":\"\"}}\n",
+		},
 	}
 }
 
@@ -459,5 +592,7 @@ func remapParams(url string) string {
 	url = strings.ReplaceAll(url, ":block_root",
 		"0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2")
 	url = strings.ReplaceAll(url, ":validator_id", "1")
+	url = strings.ReplaceAll(url, ":pubkey",
+		"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
 	return url
 }
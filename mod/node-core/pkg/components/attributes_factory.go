@@ -24,7 +24,9 @@ import (
 	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/attributes"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/proposer"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 )
 
 // ProvideAttributesFactory provides an AttributesFactory for the client.
@@ -41,12 +43,15 @@ func ProvideAttributesFactory[
 	chainSpec common.ChainSpec,
 	logger log.Logger[any],
 	cfg *config.Config,
+	proposerConfig *proposer.Store,
+	signer crypto.BLSSigner,
 ) (*attributes.Factory[BeaconStateT, PayloadAttributesT, WithdrawalT], error) {
 	return attributes.NewAttributesFactory[
 		BeaconStateT, PayloadAttributesT, WithdrawalT,
 	](
 		chainSpec,
 		logger,
-		cfg.PayloadBuilder.SuggestedFeeRecipient,
+		proposerConfig,
+		signer.PublicKey(),
 	), nil
 }
@@ -26,8 +26,11 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/async/pkg/event"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/archive"
 	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
@@ -41,9 +44,11 @@ import (
 // function for the depinject framework.
 type AvailabilityStoreInput struct {
 	depinject.In
-	AppOpts   servertypes.AppOptions
-	ChainSpec common.ChainSpec
-	Logger    log.Logger
+	AppOpts       servertypes.AppOptions
+	ChainSpec     common.ChainSpec
+	Cfg           *config.Config
+	Logger        log.Logger
+	TelemetrySink *metrics.TelemetrySink
 }
 
 // ProvideAvailibilityStore provides the availability store.
@@ -52,21 +57,45 @@ func ProvideAvailibilityStore[
 ](
 	in AvailabilityStoreInput,
 ) (*dastore.Store[BeaconBlockBodyT], error) {
+	homeDir := cast.ToString(in.AppOpts.Get(flags.FlagHome))
+	syncPolicy, err := filedb.ParseSyncPolicy(in.Cfg.BlobStore.SyncPolicy)
+	if err != nil {
+		return nil, err
+	}
+	var opts []dastore.Option[BeaconBlockBodyT]
+	if in.Cfg.BlobArchive.Enabled {
+		blobArchive, err := archive.NewFromConfig(
+			in.Cfg.BlobArchive, homeDir,
+		)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dastore.WithArchive[BeaconBlockBodyT](blobArchive))
+	}
+
 	return dastore.New[BeaconBlockBodyT](
 		filedb.NewRangeDB(
 			filedb.NewDB(
-				filedb.WithRootDirectory(
-					cast.ToString(
-						in.AppOpts.Get(flags.FlagHome),
-					)+"/data/blobs",
-				),
+				filedb.WithRootDirectory(homeDir+"/data/blobs"),
 				filedb.WithFileExtension("ssz"),
 				filedb.WithDirectoryPermissions(os.ModePerm),
 				filedb.WithLogger(in.Logger),
+				filedb.WithTelemetrySink(in.TelemetrySink),
+				filedb.WithSyncPolicy(syncPolicy),
+				filedb.WithLegacyValidator(dastore.ValidateSidecar),
 			),
 		),
+		filedb.NewDB(
+			filedb.WithRootDirectory(homeDir+"/data/blobs-index"),
+			filedb.WithFileExtension("bin"),
+			filedb.WithDirectoryPermissions(os.ModePerm),
+			filedb.WithLogger(in.Logger),
+			filedb.WithTelemetrySink(in.TelemetrySink),
+			filedb.WithSyncPolicy(syncPolicy),
+		),
 		in.Logger.With("service", "beacon-kit.da.store"),
 		in.ChainSpec,
+		opts...,
 	), nil
 }
 
@@ -81,25 +110,49 @@ type AvailabilityPrunerInput struct {
 }
 
 // ProvideAvailabilityPruner provides a availability pruner for the depinject
-// framework.
+// framework. The store is pruned as a whole, so that sidecars are archived
+// before they are removed.
 func ProvideAvailabilityPruner(
 	in AvailabilityPrunerInput,
-) pruner.Pruner[*filedb.RangeDB] {
-	rangeDB, _ := in.AvailabilityStore.IndexDB.(*filedb.RangeDB)
-	// build the availability pruner if IndexDB is available.
+) pruner.Pruner[*AvailabilityStore] {
 	return pruner.NewPruner[
 		*BeaconBlock,
 		*BlockEvent,
-		*filedb.RangeDB,
+		*AvailabilityStore,
 		event.Subscription,
 	](
 		in.Logger.With("service", manager.AvailabilityPrunerName),
-		rangeDB,
+		in.AvailabilityStore,
 		manager.AvailabilityPrunerName,
 		in.BlockFeed,
 		dastore.BuildPruneRangeFn[
 			*BeaconBlock,
 			*BlockEvent,
 		](in.ChainSpec),
+		dastore.BuildRewindIndexFn[pruner.BeaconState](),
+	)
+}
+
+// BlobScrubServiceInput is the input for the ProvideBlobScrubService
+// function for the depinject framework.
+type BlobScrubServiceInput struct {
+	depinject.In
+	AvailabilityStore *AvailabilityStore
+	Logger            log.Logger
+}
+
+// ProvideBlobScrubService provides a service scrubbing the blob sidecars of
+// the availability store on start.
+func ProvideBlobScrubService(
+	in BlobScrubServiceInput,
+) *filedb.ScrubService {
+	var dbs []*filedb.DB
+	if rangeDB, ok := in.AvailabilityStore.IndexDB.(*filedb.RangeDB); ok {
+		if db, isFileDB := rangeDB.DB.(*filedb.DB); isFileDB {
+			dbs = append(dbs, db)
+		}
+	}
+	return filedb.NewScrubService(
+		in.Logger.With("service", "blob-scrub"), dbs...,
 	)
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/config"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/clock"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 )
@@ -39,11 +40,13 @@ type ChainServiceInput struct {
 	ChainSpec       common.ChainSpec
 	Cfg             *config.Config
 	DepositService  *DepositService
+	DepositStore    *DepositStore
 	EngineClient    *EngineClient
 	ExecutionEngine *ExecutionEngine
 	LocalBuilder    *LocalBuilder
 	Logger          log.Logger
 	Signer          crypto.BLSSigner
+	SlotClock       *clock.SlotClock
 	StateProcessor  StateProcessor
 	StorageBackend  StorageBackend
 	TelemetrySink   *metrics.TelemetrySink
@@ -68,12 +71,14 @@ func ProvideChainService(
 		*Withdrawal,
 	](
 		in.StorageBackend,
+		in.DepositStore,
 		in.Logger.With("service", "blockchain"),
 		in.ChainSpec,
 		in.ExecutionEngine,
 		in.LocalBuilder,
 		in.BlobProcessor,
 		in.StateProcessor,
+		in.SlotClock,
 		in.TelemetrySink,
 		in.BlockFeed,
 		// If optimistic is enabled, we want to skip post finalization FCUs.
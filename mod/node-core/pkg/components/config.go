@@ -22,7 +22,9 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/errors"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
 
@@ -30,10 +32,32 @@ import (
 type ConfigInput struct {
 	depinject.In
 	AppOpts servertypes.AppOptions
+	Logger  log.Logger `optional:"true"`
 }
 
 // ProvideConfig is a function that provides the BeaconConfig to the
-// application.
+// application. The configuration is validated against the CometBFT one:
+// fatal problems abort the startup while the others are logged as warnings.
 func ProvideConfig(in ConfigInput) (*config.Config, error) {
-	return config.ReadConfigFromAppOpts(in.AppOpts)
+	cfg, err := config.ReadConfigFromAppOpts(in.AppOpts)
+	if err != nil {
+		return nil, err
+	}
+	cmtCfg, err := config.ReadCometBFTConfigFromAppOpts(in.AppOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	fatal, warnings := config.Problems(cfg.Validate(cmtCfg))
+	if in.Logger != nil {
+		for _, warning := range warnings {
+			in.Logger.Warn("Invalid configuration", "reason", warning)
+		}
+	}
+	if len(fatal) > 0 {
+		return nil, errors.Wrap(
+			errors.Join(fatal...), "invalid beacon-kit configuration",
+		)
+	}
+	return cfg, nil
 }
@@ -24,7 +24,6 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/async/pkg/event"
-	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
@@ -32,7 +31,7 @@ import (
 // DBManagerInput is the input for the dep inject framework.
 type DBManagerInput struct {
 	depinject.In
-	AvailabilityPruner pruner.Pruner[*filedb.RangeDB]
+	AvailabilityPruner pruner.Pruner[*AvailabilityStore]
 	DepositPruner      pruner.Pruner[*DepositStore]
 	Logger             log.Logger
 }
@@ -44,6 +43,7 @@ func ProvideDBManager(
 	return manager.NewDBManager[
 		*BeaconBlock,
 		*BlockEvent,
+		BeaconState,
 		event.Subscription,
 	](
 		in.Logger.With("service", "db-manager"),
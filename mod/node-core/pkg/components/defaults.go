@@ -25,7 +25,18 @@ import (
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 )
 
+// DefaultComponentsWithStandardTypes returns the components of a beacon
+// node run by CometBFT.
 func DefaultComponentsWithStandardTypes() []any {
+	return append(
+		CoreComponentsWithStandardTypes(),
+		ProvideServiceRegistry,
+	)
+}
+
+// CoreComponentsWithStandardTypes returns the components of a beacon node
+// but for its service registry, which depends on what drives the node.
+func CoreComponentsWithStandardTypes() []any {
 	return []any{
 		ProvideABCIMiddleware,
 		ProvideAttributesFactory[
@@ -37,6 +48,8 @@ func DefaultComponentsWithStandardTypes() []any {
 		ProvideAvailibilityStore[*BeaconBlockBody],
 		ProvideBlsSigner,
 		ProvideBlobFeed,
+		ProvideBlobGossiper,
+		ProvideBlobScrubService,
 		ProvideBlockFeed,
 		ProvideBlobProcessor[*BeaconBlockBody],
 		ProvideBlobProofVerifier,
@@ -64,12 +77,17 @@ func DefaultComponentsWithStandardTypes() []any {
 		],
 		ProvideJWTSecret,
 		ProvideLocalBuilder,
-		ProvideServiceRegistry,
+		ProvideProposerConfig,
+		ProvideRelayBuilder,
+		ProvideSlashingProtection,
 		ProvideStateProcessor,
+		ProvideSlotClock,
 		ProvideSlotFeed,
 		ProvideStatusFeed,
 		ProvideStorageBackend,
+		ProvideTelemetryRegistry,
 		ProvideTelemetrySink,
+		ProvideTracerProvider,
 		ProvideTrustedSetup,
 		ProvideValidatorService,
 	}
@@ -29,6 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/interfaces"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
@@ -50,6 +51,8 @@ func ProvideDepositStore[
 		interfaces.SSZMarshallable
 		GetIndex() uint64
 		HashTreeRoot() ([32]byte, error)
+		DataRoot() (common.Root, error)
+		SetProof([constants.DepositProofLength]common.Root)
 	},
 ](
 	in DepositStoreInput,
@@ -65,7 +68,7 @@ func ProvideDepositStore[
 		&depositstore.KVStoreProvider{
 			KVStoreWithBatch: kvp,
 		},
-	), nil
+	)
 }
 
 // DepositPrunerInput is the input for the deposit pruner.
@@ -99,5 +102,6 @@ func ProvideDepositPruner(
 			*ExecutionPayload,
 			types.WithdrawalCredentials,
 		](in.ChainSpec),
+		deposit.BuildRewindIndexFn[pruner.BeaconState](),
 	)
 }
@@ -34,6 +34,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/tracing"
 )
 
 // EngineClientInputs is the input for the EngineClient.
@@ -42,9 +43,10 @@ type EngineClientInputs struct {
 	ChainSpec common.ChainSpec
 	Config    *config.Config
 	// TODO: this feels like a hood way to handle it.
-	JWTSecret     *jwt.Secret `optional:"true"`
-	Logger        log.Logger
-	TelemetrySink *metrics.TelemetrySink
+	JWTSecret      *jwt.Secret `optional:"true"`
+	Logger         log.Logger
+	TelemetrySink  *metrics.TelemetrySink
+	TracerProvider *tracing.Provider
 }
 
 // ProvideEngineClient creates a new EngineClient.
@@ -74,6 +76,7 @@ func ProvideEngineClient[
 		in.Logger.With("service", "engine.client"),
 		in.JWTSecret,
 		in.TelemetrySink,
+		in.TracerProvider.Tracer("beacon-kit/engine-client"),
 		new(big.Int).SetUint64(in.ChainSpec.DepositEth1ChainID()),
 	)
 }
@@ -22,23 +22,31 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/clock"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/middleware"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/p2p"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/tracing"
 )
 
 // ABCIMiddlewareInput is the input for the validator middleware provider.
 type ABCIMiddlewareInput struct {
 	depinject.In
 	BeaconBlockFeed *BlockFeed
+	BlobGossiper    *BlobGossiper
 	ChainService    *ChainService
 	DAService       *DAService
 	ChainSpec       common.ChainSpec
 	Logger          log.Logger[any]
 	SidecarsFeed    *BlobFeed
+	SlotClock       *clock.SlotClock
 	SlotFeed        *SlotFeed
 	TelemetrySink   *metrics.TelemetrySink
+	TracerProvider  *tracing.Provider
 }
 
 // ProvideABCIMiddleware is a depinject provider for the validator
@@ -54,10 +62,29 @@ func ProvideABCIMiddleware(
 		in.ChainSpec,
 		in.ChainService,
 		in.DAService,
+		in.BlobGossiper,
+		in.SlotClock,
 		in.Logger,
 		in.TelemetrySink,
+		in.TracerProvider.Tracer("beacon-kit/abci"),
 		in.BeaconBlockFeed,
 		in.SidecarsFeed,
 		in.SlotFeed,
 	)
 }
+
+// BlobGossiperInput is the input for the blob gossiper provider.
+type BlobGossiperInput struct {
+	depinject.In
+	ChainSpec common.ChainSpec
+	Config    *config.Config
+	Logger    log.Logger[any]
+}
+
+// ProvideBlobGossiper is a depinject provider for the handler gossiping blob
+// sidecars outside of the proposals.
+func ProvideBlobGossiper(in BlobGossiperInput) (*BlobGossiper, error) {
+	return p2p.NewBlobGossipHandler[*BlobSidecars, encoding.ABCIRequest](
+		in.Config.BlobGossip, in.ChainSpec, in.Logger,
+	)
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/proposer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// ProposerConfigInput is the input for the dep inject framework.
+type ProposerConfigInput struct {
+	depinject.In
+	Cfg    *config.Config
+	Logger log.Logger
+}
+
+// ProvideProposerConfig provides the proposer config of the node, which
+// falls back to the fee recipient, gas limit and graffiti of the node
+// configuration.
+func ProvideProposerConfig(in ProposerConfigInput) (*proposer.Store, error) {
+	return proposer.NewStore(
+		in.Cfg.PayloadBuilder.ProposerConfig,
+		proposer.Settings{
+			FeeRecipient: in.Cfg.PayloadBuilder.SuggestedFeeRecipient,
+			GasLimit:     math.U64(in.Cfg.Relay.GasLimit),
+			Graffiti:     in.Cfg.Validator.Graffiti,
+		},
+		in.Logger.With("service", "proposer-config"),
+	)
+}
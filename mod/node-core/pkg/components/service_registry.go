@@ -23,24 +23,35 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/version"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/proposer"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/telemetry"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/tracing"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	sdkversion "github.com/cosmos/cosmos-sdk/version"
 )
 
 // ServiceRegistryInput is the input for the service registry provider.
 type ServiceRegistryInput struct {
 	depinject.In
-	ABCIService      *ABCIMiddleware
-	ChainService     *ChainService
-	DBManager        *DBManager
-	DAService        *DAService
-	DepositService   *DepositService
-	EngineClient     *EngineClient
-	Logger           log.Logger
-	TelemetrySink    *metrics.TelemetrySink
-	ValidatorService *ValidatorService
+	ABCIService       *ABCIMiddleware
+	BlobGossiper      *BlobGossiper
+	BlobScrubService  *filedb.ScrubService
+	ChainService      *ChainService
+	Config            *config.Config
+	DBManager         *DBManager
+	DAService         *DAService
+	DepositService    *DepositService
+	EngineClient      *EngineClient
+	Logger            log.Logger
+	ProposerConfig    *proposer.Store
+	TelemetryRegistry *telemetry.Registry
+	TelemetrySink     *metrics.TelemetrySink
+	TracerProvider    *tracing.Provider
+	ValidatorService  *ValidatorService
 }
 
 // ProvideServiceRegistry is the depinject provider for the service registry.
@@ -53,6 +64,7 @@ func ProvideServiceRegistry(
 		service.WithService(in.ChainService),
 		service.WithService(in.DepositService),
 		service.WithService(in.ABCIService),
+		service.WithService(in.BlobGossiper),
 		service.WithService(in.EngineClient),
 		service.WithService(version.NewReportingService(
 			in.Logger.With("service", "reporting"),
@@ -60,5 +72,13 @@ func ProvideServiceRegistry(
 			sdkversion.Version,
 		)),
 		service.WithService(in.DBManager),
+		service.WithService(in.BlobScrubService),
+		service.WithService(in.ProposerConfig),
+		service.WithService(telemetry.NewServer(
+			in.Config.Metrics,
+			in.Logger.With("service", "metrics"),
+			in.TelemetryRegistry,
+		)),
+		service.WithService(in.TracerProvider),
 	)
 }
@@ -24,9 +24,12 @@ import (
 	"path/filepath"
 
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/remotesigner"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/slashingprotection"
 	clientFlags "github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/spf13/cast"
@@ -36,12 +39,32 @@ import (
 type BlsSignerInput struct {
 	depinject.In
 	AppOpts servertypes.AppOptions
-	PrivKey LegacyKey `optional:"true"`
+	Cfg     *config.Config `optional:"true"`
+	PrivKey LegacyKey      `optional:"true"`
+	// SlashingProtection is the slashing protection store consulted by the
+	// signer. It is left out by the commands that never sign blocks.
+	SlashingProtection *slashingprotection.Store `optional:"true"`
 }
 
 // ProvideBlsSigner is a function that provides the module to the application.
+// An explicitly provided private key takes precedence over the remote signer,
+// which in turn takes precedence over the privval signer. The signer is
+// wrapped with the slashing protection store if one is provided.
 func ProvideBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
+	blsSigner, err := provideBlsSigner(in)
+	if err != nil || in.SlashingProtection == nil {
+		return blsSigner, err
+	}
+	return signer.NewProtectedSigner(blsSigner, in.SlashingProtection), nil
+}
+
+// provideBlsSigner returns the signer holding the validator key.
+func provideBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
 	if in.PrivKey == [constants.BLSSecretKeyLength]byte{} {
+		if in.Cfg != nil && in.Cfg.RemoteSigner.Enabled {
+			return remotesigner.New(in.Cfg.RemoteSigner)
+		}
+
 		// if no private key is provided, use privval signer
 		homeDir := cast.ToString(in.AppOpts.Get(clientFlags.FlagHome))
 		privValKeyFile := cast.ToString(
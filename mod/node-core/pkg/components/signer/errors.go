@@ -38,4 +38,14 @@ var (
 	ErrInvalidValidatorPrivateKeyLength = errors.New(
 		"invalid validator private key length",
 	)
+
+	// ErrUntypedSigningRequest is returned when a slashing protected signer
+	// is asked to sign a bare message, which it cannot check without
+	// knowing what the message is.
+	ErrUntypedSigningRequest = errors.New(
+		"slashing protected signer only signs typed requests",
+	)
+	// ErrMissingBlock is returned when a block signing request lacks the
+	// header of the block.
+	ErrMissingBlock = errors.New("block signing request is missing its block")
 )
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// SlashingProtectionDB is the history of the blocks signed by validators.
+type SlashingProtectionDB interface {
+	// CheckGenesisValidatorsRoot checks that the given genesis validators
+	// root is the one of the history.
+	CheckGenesisValidatorsRoot(root common.Root) error
+	// CheckAndRecordBlock checks that the validator of the given pubkey
+	// may sign the given block and records it if so.
+	CheckAndRecordBlock(
+		pubkey crypto.BLSPubkey,
+		slot math.Slot,
+		signingRoot common.Root,
+	) error
+}
+
+// ProtectedSigner is a BLS signer that consults a slashing protection
+// database before signing, so that it never signs two different blocks at
+// the same slot.
+type ProtectedSigner struct {
+	crypto.BLSSigner
+	db SlashingProtectionDB
+}
+
+// NewProtectedSigner wraps the given signer with the given slashing
+// protection database.
+func NewProtectedSigner(
+	signer crypto.BLSSigner,
+	db SlashingProtectionDB,
+) *ProtectedSigner {
+	return &ProtectedSigner{
+		BLSSigner: signer,
+		db:        db,
+	}
+}
+
+// Sign refuses to sign the bare message, see SignRequest.
+func (s *ProtectedSigner) Sign([]byte) (crypto.BLSSignature, error) {
+	return crypto.BLSSignature{}, ErrUntypedSigningRequest
+}
+
+// SignRequest signs the request with the underlying signer. Block requests
+// are checked against and recorded in the slashing protection database
+// before being signed, other requests are not slashable and are signed
+// as is.
+func (s *ProtectedSigner) SignRequest(
+	req *crypto.SigningRequest,
+) (crypto.BLSSignature, error) {
+	if req.Type == crypto.SigningTypeBlock {
+		if req.Block == nil {
+			return crypto.BLSSignature{}, ErrMissingBlock
+		}
+		if req.ForkInfo != nil {
+			if err := s.db.CheckGenesisValidatorsRoot(
+				req.ForkInfo.GenesisValidatorsRoot,
+			); err != nil {
+				return crypto.BLSSignature{}, err
+			}
+		}
+		if err := s.db.CheckAndRecordBlock(
+			s.PublicKey(), math.Slot(req.Block.Slot), req.SigningRoot,
+		); err != nil {
+			return crypto.BLSSignature{}, err
+		}
+	}
+	return crypto.SignRequest(s.BLSSigner, req)
+}
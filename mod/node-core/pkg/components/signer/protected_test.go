@@ -0,0 +1,234 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	corestore "cosmossdk.io/core/store"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/slashingprotection"
+	"github.com/stretchr/testify/require"
+)
+
+var genesisValidatorsRoot = common.Root{0x0a}
+
+// newStore returns a slashing protection store backed by a fresh database.
+func newStore(t *testing.T) *slashingprotection.Store {
+	t.Helper()
+	db, err := storev2.NewDB(
+		storev2.DBTypePebbleDB, "slashing_protection", t.TempDir(), nil,
+	)
+	require.NoError(t, err)
+	store := slashingprotection.NewStore(db)
+	t.Cleanup(func() { require.NoError(t, store.Close()) })
+	return store
+}
+
+// syncSpyDB is a database that counts the batches written synchronously and
+// fails the test on any unsynced write.
+type syncSpyDB struct {
+	corestore.KVStoreWithBatch
+	t      *testing.T
+	synced int
+}
+
+func (db *syncSpyDB) Set([]byte, []byte) error {
+	db.t.Fatal("unsynced write")
+	return nil
+}
+
+func (db *syncSpyDB) NewBatch() corestore.Batch {
+	return &syncSpyBatch{Batch: db.KVStoreWithBatch.NewBatch(), db: db}
+}
+
+type syncSpyBatch struct {
+	corestore.Batch
+	db *syncSpyDB
+}
+
+func (b *syncSpyBatch) Write() error {
+	b.db.t.Fatal("unsynced batch write")
+	return nil
+}
+
+func (b *syncSpyBatch) WriteSync() error {
+	b.db.synced++
+	return b.Batch.WriteSync()
+}
+
+// newProtectedSigner returns a protected signer of the given secret
+// scalar.
+func newProtectedSigner(
+	t *testing.T,
+	scalar byte,
+	store *slashingprotection.Store,
+) *signer.ProtectedSigner {
+	t.Helper()
+	s, err := signer.NewLegacySigner(signer.LegacyKey{31: scalar})
+	require.NoError(t, err)
+	return signer.NewProtectedSigner(s, store)
+}
+
+// blockRequest returns the signing request of a block at the given slot.
+func blockRequest(slot uint64, root byte) *crypto.SigningRequest {
+	return &crypto.SigningRequest{
+		Type:        crypto.SigningTypeBlock,
+		SigningRoot: bytes.B32{root},
+		ForkInfo: crypto.NewForkInfo(
+			bytes.B4{0x04}, 0, genesisValidatorsRoot,
+		),
+		Block: &crypto.BlockHeaderData{Slot: slot},
+	}
+}
+
+func TestProtectedSigner(t *testing.T) {
+	s := newProtectedSigner(t, 1, newStore(t))
+
+	_, err := crypto.SignRequest(s, blockRequest(10, 0x01))
+	require.NoError(t, err)
+
+	// Signing the same block again is allowed.
+	_, err = crypto.SignRequest(s, blockRequest(10, 0x01))
+	require.NoError(t, err)
+
+	// Signing another block at the same slot is not.
+	_, err = crypto.SignRequest(s, blockRequest(10, 0x02))
+	require.ErrorIs(t, err, slashingprotection.ErrDoubleProposal)
+
+	// Neither is signing below the lowest signed slot.
+	_, err = crypto.SignRequest(s, blockRequest(9, 0x03))
+	require.ErrorIs(t, err, slashingprotection.ErrSlotBelowWatermark)
+
+	_, err = crypto.SignRequest(s, blockRequest(11, 0x03))
+	require.NoError(t, err)
+
+	// Blocks of another chain are refused.
+	req := blockRequest(12, 0x04)
+	req.ForkInfo.GenesisValidatorsRoot = common.Root{0x0b}
+	_, err = crypto.SignRequest(s, req)
+	require.ErrorIs(t, err, slashingprotection.ErrGenesisValidatorsRootMismatch)
+
+	// Requests that are not slashable go through, bare messages do not.
+	_, err = crypto.SignRequest(s, &crypto.SigningRequest{
+		Type:         crypto.SigningTypeRandaoReveal,
+		RandaoReveal: &crypto.RandaoRevealData{Epoch: 1},
+	})
+	require.NoError(t, err)
+	_, err = s.Sign([]byte{0x01})
+	require.ErrorIs(t, err, signer.ErrUntypedSigningRequest)
+}
+
+func TestSlashingProtectionInterchange(t *testing.T) {
+	source := newStore(t)
+	s := newProtectedSigner(t, 1, source)
+	_, err := crypto.SignRequest(s, blockRequest(10, 0x01))
+	require.NoError(t, err)
+	_, err = crypto.SignRequest(s, blockRequest(12, 0x02))
+	require.NoError(t, err)
+
+	interchange, err := source.Export()
+	require.NoError(t, err)
+	require.Equal(
+		t, genesisValidatorsRoot,
+		interchange.Metadata.GenesisValidatorsRoot,
+	)
+	require.Len(t, interchange.Data, 1)
+	require.Equal(t, s.PublicKey(), interchange.Data[0].Pubkey)
+	require.Len(t, interchange.Data[0].SignedBlocks, 2)
+
+	// The history survives a JSON round trip into another node.
+	bz, err := json.Marshal(interchange)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"slot":"12"`)
+	imported := new(slashingprotection.Interchange)
+	require.NoError(t, json.Unmarshal(bz, imported))
+
+	target := newStore(t)
+	require.NoError(t, target.Import(imported))
+	restored := newProtectedSigner(t, 1, target)
+	_, err = crypto.SignRequest(restored, blockRequest(12, 0x03))
+	require.ErrorIs(t, err, slashingprotection.ErrDoubleProposal)
+	_, err = crypto.SignRequest(restored, blockRequest(12, 0x02))
+	require.NoError(t, err)
+
+	// Blocks imported without a signing root block their slot entirely.
+	imported.Data[0].SignedBlocks = []*slashingprotection.SignedBlock{
+		{Slot: 13},
+	}
+	require.NoError(t, target.Import(imported))
+	_, err = crypto.SignRequest(restored, blockRequest(13, 0x04))
+	require.ErrorIs(t, err, slashingprotection.ErrDoubleProposal)
+
+	imported.Metadata.InterchangeFormatVersion = "4"
+	require.ErrorIs(
+		t, target.Import(imported),
+		slashingprotection.ErrUnsupportedInterchangeVersion,
+	)
+	imported.Metadata.InterchangeFormatVersion = "5"
+	imported.Metadata.GenesisValidatorsRoot = common.Root{0x0b}
+	require.ErrorIs(
+		t, target.Import(imported),
+		slashingprotection.ErrGenesisValidatorsRootMismatch,
+	)
+}
+
+func TestProtectedSigner_SyncsRecords(t *testing.T) {
+	db := &syncSpyDB{KVStoreWithBatch: storev2.NewMemDB(), t: t}
+	s := newProtectedSigner(t, 1, slashingprotection.NewStore(db))
+
+	// The genesis validators root and the block are both flushed before the
+	// signature is returned.
+	_, err := crypto.SignRequest(s, blockRequest(10, 0x01))
+	require.NoError(t, err)
+	require.Equal(t, 2, db.synced)
+}
+
+func TestProtectedSigner_Reopen(t *testing.T) {
+	dir := t.TempDir()
+	open := func() *slashingprotection.Store {
+		db, err := storev2.NewDB(
+			storev2.DBTypePebbleDB, "slashing_protection", dir, nil,
+		)
+		require.NoError(t, err)
+		return slashingprotection.NewStore(db)
+	}
+
+	store := open()
+	_, err := crypto.SignRequest(
+		newProtectedSigner(t, 1, store), blockRequest(10, 0x01),
+	)
+	require.NoError(t, err)
+	require.NoError(t, store.Close())
+
+	// The record survives the restart of the node.
+	store = open()
+	t.Cleanup(func() { require.NoError(t, store.Close()) })
+	_, err = crypto.SignRequest(
+		newProtectedSigner(t, 1, store), blockRequest(10, 0x02),
+	)
+	require.ErrorIs(t, err, slashingprotection.ErrDoubleProposal)
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/slashingprotection"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// slashingProtectionDBName is the name of the slashing protection database
+// in the data directory of the node.
+const slashingProtectionDBName = "slashing_protection"
+
+// SlashingProtectionInput is the input for the dep inject framework.
+type SlashingProtectionInput struct {
+	depinject.In
+	AppOpts servertypes.AppOptions
+}
+
+// ProvideSlashingProtection provides the slashing protection store of the
+// node.
+func ProvideSlashingProtection(
+	in SlashingProtectionInput,
+) (*slashingprotection.Store, error) {
+	return OpenSlashingProtection(
+		cast.ToString(in.AppOpts.Get(flags.FlagHome)),
+	)
+}
+
+// OpenSlashingProtection opens the slashing protection store of the node
+// with the given home directory.
+func OpenSlashingProtection(
+	homeDir string,
+) (*slashingprotection.Store, error) {
+	db, err := storev2.NewDB(
+		storev2.DBTypePebbleDB, slashingProtectionDBName,
+		filepath.Join(homeDir, "data"), nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return slashingprotection.NewStore(db), nil
+}
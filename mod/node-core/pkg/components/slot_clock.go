@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"time"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/clock"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// SlotClockInput is the input for the slot clock provider.
+type SlotClockInput struct {
+	depinject.In
+	ChainSpec common.ChainSpec
+}
+
+// ProvideSlotClock is a depinject provider for the slot clock.
+func ProvideSlotClock(in SlotClockInput) (*clock.SlotClock, error) {
+	//#nosec:G701 // chain spec durations will never overflow an int64.
+	return clock.NewSlotClock(
+		time.Duration(in.ChainSpec.TargetSecondsPerEth1Block())*time.Second,
+		time.Duration(in.ChainSpec.MaxPayloadTimestampDrift())*time.Second,
+	)
+}
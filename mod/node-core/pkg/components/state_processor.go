@@ -23,9 +23,11 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/clock"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/tracing"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 )
 
@@ -34,8 +36,11 @@ import (
 type StateProcessorInput struct {
 	depinject.In
 	ChainSpec       common.ChainSpec
+	DepositStore    *DepositStore
 	ExecutionEngine *ExecutionEngine
 	Signer          crypto.BLSSigner
+	SlotClock       *clock.SlotClock
+	TracerProvider  *tracing.Provider
 }
 
 // ProvideStateProcessor provides the state processor to the depinject
@@ -63,5 +68,8 @@ func ProvideStateProcessor(
 		in.ChainSpec,
 		in.ExecutionEngine,
 		in.Signer,
+		in.DepositStore,
+		in.SlotClock,
+		in.TracerProvider.Tracer("beacon-kit/state-transition"),
 	)
 }
@@ -20,9 +20,48 @@
 
 package components
 
-import "github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+import (
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/telemetry"
+)
+
+// TelemetryRegistryInput is the input for the telemetry registry provider.
+type TelemetryRegistryInput struct {
+	depinject.In
+	Config *config.Config
+	Logger log.Logger
+}
+
+// ProvideTelemetryRegistry is a function that provides the Prometheus
+// registry with the metrics of every subsystem declared. Dropped metric
+// updates are logged, since they are caused by undeclared metrics.
+func ProvideTelemetryRegistry(
+	in TelemetryRegistryInput,
+) (*telemetry.Registry, error) {
+	registry, err := telemetry.NewRegistry(
+		in.Config.Metrics.GlobalLabels,
+		telemetry.DefaultDescriptors()...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	logger := in.Logger.With("service", "telemetry")
+	registry.OnError(func(err error) {
+		logger.Error("Dropped metric update", "error", err)
+	})
+	return registry, nil
+}
+
+// TelemetrySinkInput is the input for the telemetry sink provider.
+type TelemetrySinkInput struct {
+	depinject.In
+	Registry *telemetry.Registry
+}
 
 // ProvideTelemetrySink is a function that provides a TelemetrySink.
-func ProvideTelemetrySink() *metrics.TelemetrySink {
-	return &metrics.TelemetrySink{}
+func ProvideTelemetrySink(in TelemetrySinkInput) *metrics.TelemetrySink {
+	return metrics.NewTelemetrySink(in.Registry)
 }
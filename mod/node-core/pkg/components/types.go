@@ -43,7 +43,9 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/service"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/middleware"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/p2p"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
 	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
@@ -93,6 +95,9 @@ type (
 	// BlobSidecars is a type alias for the blob sidecars.
 	BlobSidecars = datypes.BlobSidecars
 
+	// BlobGossiper is a type alias for the blob sidecar gossip handler.
+	BlobGossiper = p2p.BlobGossipHandler[*BlobSidecars, encoding.ABCIRequest]
+
 	// BlobProcessor is a type alias for the blob processor.
 	BlobProcessor = dablob.Processor[
 		*AvailabilityStore,
@@ -136,6 +141,7 @@ type (
 	DBManager = manager.DBManager[
 		*BeaconBlock,
 		*BlockEvent,
+		BeaconState,
 		event.Subscription,
 	]
 
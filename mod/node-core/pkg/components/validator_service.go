@@ -30,9 +30,13 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/proposer"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/clock"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/tracing"
 )
 
 // ValidatorServiceInput is the input for the validator service provider.
@@ -44,12 +48,16 @@ type ValidatorServiceInput struct {
 	ChainSpec       common.ChainSpec
 	LocalBuilder    *LocalBuilder
 	Logger          log.Logger
+	ProposerConfig  *proposer.Store
+	RelayBuilder    *relay.Builder
 	StateProcessor  StateProcessor
 	StorageBackend  StorageBackend
 	Signer          crypto.BLSSigner
 	SidecarsFeed    *BlobFeed
+	SlotClock       *clock.SlotClock
 	SlotFeed        *event.FeedOf[asynctypes.EventID, *asynctypes.Event[math.Slot]]
 	TelemetrySink   *metrics.TelemetrySink
+	TracerProvider  *tracing.Provider
 }
 
 // ProvideValidatorService is a depinject provider for the validator service.
@@ -75,16 +83,17 @@ func ProvideValidatorService(
 		in.StorageBackend,
 		in.StateProcessor,
 		in.Signer,
+		in.ProposerConfig,
 		dablob.NewSidecarFactory[*BeaconBlock, *BeaconBlockBody](
 			in.ChainSpec,
 			types.KZGPositionDeneb,
 			in.TelemetrySink,
 		),
 		in.LocalBuilder,
-		[]validator.PayloadBuilder[BeaconState, *ExecutionPayload]{
-			in.LocalBuilder,
-		},
+		in.RelayBuilder,
+		in.SlotClock,
 		in.TelemetrySink,
+		in.TracerProvider.Tracer("beacon-kit/validator"),
 		in.BeaconBlockFeed,
 		in.SidecarsFeed,
 		in.SlotFeed,
@@ -60,3 +60,9 @@ func (n *node) RegisterApp(a servertypes.Application) {
 func (n *node) SetServiceRegistry(registry *service.Registry) {
 	n.registry = registry
 }
+
+// FetchService sets the given pointer to the registered service of its
+// type.
+func (n *node) FetchService(service any) error {
+	return n.registry.FetchService(service)
+}
@@ -39,4 +39,7 @@ type Node interface {
 	RegisterApp(app servertypes.Application)
 	// SetServiceRegistry sets the node's service registry.
 	SetServiceRegistry(registry *service.Registry)
+	// FetchService sets the given pointer to the registered service of its
+	// type.
+	FetchService(service any) error
 }
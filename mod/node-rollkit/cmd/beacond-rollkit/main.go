@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package main
+
+import (
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	clibuilder "github.com/berachain/beacon-kit/mod/cli/pkg/builder"
+	clicomponents "github.com/berachain/beacon-kit/mod/cli/pkg/components"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
+	nodebuilder "github.com/berachain/beacon-kit/mod/node-core/pkg/builder"
+	nodecomponents "github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	beacon "github.com/berachain/beacon-kit/mod/node-core/pkg/components/module"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-rollkit/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/node-rollkit/pkg/components"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+	"go.uber.org/automaxprocs/maxprocs"
+)
+
+// run runs the rollkit node.
+func run() error {
+	// Set the uber max procs
+	if _, err := maxprocs.Set(); err != nil {
+		return err
+	}
+
+	// Build the rollkit node from the node-core components.
+	rb := builder.New(
+		builder.WithDepInjectConfig(nodebuilder.DefaultDepInjectConfig()),
+		builder.WithComponents(components.DefaultComponents()),
+	)
+
+	// The commands other than start act on the stores of the node, as laid
+	// out by a beacon node.
+	nb := nodebuilder.New(
+		nodebuilder.WithDepInjectConfig[types.Node](
+			nodebuilder.DefaultDepInjectConfig()),
+		nodebuilder.WithComponents[types.Node](
+			nodecomponents.DefaultComponentsWithStandardTypes(),
+		),
+	)
+
+	// Build the root command using the builder
+	cb := clibuilder.New(
+		clibuilder.WithName[types.Node](nodebuilder.DefaultAppName),
+		clibuilder.WithDescription[types.Node](
+			"A beacon node sequencing its own blocks on a DA layer.",
+		),
+		clibuilder.WithDepInjectConfig[types.Node](
+			nodebuilder.DefaultDepInjectConfig(),
+		),
+		clibuilder.WithComponents[types.Node](
+			append(
+				clicomponents.DefaultClientComponents(),
+				nodecomponents.ProvideNoopTxConfig,
+				nodecomponents.ProvideConfig,
+				nodecomponents.ProvideChainSpec,
+			),
+		),
+		clibuilder.SupplyModuleDeps[types.Node](
+			beacon.SupplyModuleDependencies(),
+		),
+		clibuilder.WithRunHandler[types.Node](
+			server.InterceptConfigsPreRunHandler,
+		),
+		clibuilder.WithNodeBuilderFunc[types.Node](nb.Build),
+	)
+
+	cmd, err := cb.Build()
+	if err != nil {
+		return err
+	}
+	if err = cmd.Enhance(func(root *cobra.Command) error {
+		replaceStartCmd(root, newStartCmd(rb))
+		return nil
+	}); err != nil {
+		return err
+	}
+	return cmd.Run(clicomponents.DefaultNodeHome)
+}
+
+// replaceStartCmd replaces the command starting a CometBFT node with the
+// given one.
+func replaceStartCmd(root *cobra.Command, startCmd *cobra.Command) {
+	for _, c := range root.Commands() {
+		if c.Name() == startCmd.Name() {
+			root.RemoveCommand(c)
+		}
+	}
+	root.AddCommand(startCmd)
+}
+
+// newStartCmd returns the command running the rollkit node built by the
+// given builder until interrupted.
+func newStartCmd(rb *builder.NodeBuilder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Run the rollkit node",
+		Long: `Runs the node, producing a block every slot and publishing it
+to the DA layer. The chain is initialized from the genesis file of the node
+on first start.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			db, err := server.OpenDB(
+				serverCtx.Config.RootDir,
+				server.GetAppDBBackend(serverCtx.Viper),
+			)
+			if err != nil {
+				return err
+			}
+			n, err := rb.Build(serverCtx.Logger, db, nil, serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err = n.Close(); err != nil {
+					serverCtx.Logger.Error("failed to close node", "err", err)
+				}
+			}()
+
+			ctx, stop := signal.NotifyContext(
+				cmd.Context(), syscall.SIGINT, syscall.SIGTERM,
+			)
+			defer stop()
+			if err = n.Start(ctx); err != nil {
+				return err
+			}
+			<-ctx.Done()
+			return nil
+		},
+	}
+	flags.AddBeaconKitFlags(cmd)
+	return cmd
+}
+
+// main is the entry point.
+func main() {
+	if err := run(); err != nil {
+		//nolint:sloglint // todo fix.
+		slog.Error("startup failure", "error", err)
+		os.Exit(1)
+	}
+}
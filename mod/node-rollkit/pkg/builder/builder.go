@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"io"
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/app"
+	"github.com/berachain/beacon-kit/mod/node-rollkit/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-rollkit/pkg/node"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// NodeBuilder builds rollkit nodes.
+type NodeBuilder struct {
+	// depInjectCfg is an extendable config container used by the
+	// depinject framework.
+	depInjectCfg depinject.Config
+	// components is a list of components to provide.
+	components []any
+}
+
+// New returns a new NodeBuilder.
+func New(opts ...Opt) *NodeBuilder {
+	nb := &NodeBuilder{}
+	for _, opt := range opts {
+		opt(nb)
+	}
+	return nb
+}
+
+// Build builds a rollkit node over the given database. Its sequencer and
+// its application share the commit multistore, which the sequencer commits
+// in place of CometBFT.
+func (nb *NodeBuilder) Build(
+	logger log.Logger,
+	db dbm.DB,
+	traceStore io.Writer,
+	appOpts servertypes.AppOptions,
+) (*node.Node, error) {
+	cms := store.NewCommitMultiStore(db, logger, metrics.NewNoOpMetrics())
+
+	// variables to hold the components needed to set up the node
+	var (
+		appBuilder      *runtime.AppBuilder
+		serviceRegistry *service.Registry
+		sequencer       *components.Sequencer
+	)
+
+	// build all node components using depinject
+	if err := depinject.Inject(
+		depinject.Configs(
+			nb.depInjectCfg,
+			depinject.Provide(
+				nb.components...,
+			),
+			depinject.Supply(
+				appOpts,
+				logger,
+				cms,
+			),
+		),
+		&appBuilder,
+		&serviceRegistry,
+		&sequencer,
+	); err != nil {
+		return nil, err
+	}
+
+	// The commit multistore must be set first, for the other options to
+	// apply to it.
+	beaconApp := app.NewBeaconKitApp(
+		db, traceStore, true, appBuilder,
+		append(
+			[]func(*baseapp.BaseApp){
+				func(bApp *baseapp.BaseApp) { bApp.SetCMS(cms) },
+			},
+			server.DefaultBaseappOptions(appOpts)...,
+		)...,
+	)
+	return node.New(
+		beaconApp,
+		serviceRegistry,
+		sequencer,
+		filepath.Join(
+			cast.ToString(appOpts.Get(flags.FlagHome)),
+			"config", "genesis.json",
+		),
+	), nil
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+	"time"
+
+	"cosmossdk.io/depinject"
+	sdklog "cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-rollkit/pkg/da"
+	"github.com/berachain/beacon-kit/mod/node-rollkit/pkg/sequencer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/clock"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	// pendingDBName is the name of the database of the blocks not yet
+	// published to the DA layer, in the data directory of the node.
+	pendingDBName = "sequencer_pending"
+	// maxPendingBlocks is the number of blocks not yet published to the DA
+	// layer past which the sequencer stops producing blocks.
+	maxPendingBlocks = 64
+)
+
+// SequencerInput is the input for the sequencer provider.
+type SequencerInput struct {
+	depinject.In
+	BeaconBlockFeed *components.BlockFeed
+	ChainService    *components.ChainService
+	ChainSpec       common.ChainSpec
+	DA              da.DA
+	DAService       *components.DAService
+	Logger          log.Logger[any]
+	PendingStore    sequencer.PendingStore
+	SidecarsFeed    *components.BlobFeed
+	SlotClock       *clock.SlotClock
+	SlotFeed        *components.SlotFeed
+	Store           sequencer.Store
+}
+
+// ProvideSequencer is a depinject provider for the sequencer. It produces a
+// block every target Eth1 block time.
+func ProvideSequencer(in SequencerInput) *Sequencer {
+	return sequencer.New[
+		*components.BeaconBlock,
+		*components.BlobSidecars,
+		*components.Genesis,
+	](
+		in.ChainService,
+		in.DAService,
+		in.DA,
+		in.Store,
+		in.PendingStore,
+		maxPendingBlocks,
+		in.SlotClock,
+		//#nosec:G701 // chain spec durations will never overflow an int64.
+		time.Duration(in.ChainSpec.TargetSecondsPerEth1Block())*time.Second,
+		in.Logger,
+		in.BeaconBlockFeed,
+		in.SidecarsFeed,
+		in.SlotFeed,
+	)
+}
+
+// CommitStoreInput is the input for the commit store provider.
+type CommitStoreInput struct {
+	depinject.In
+	CommitMultiStore storetypes.CommitMultiStore
+	Logger           sdklog.Logger
+}
+
+// ProvideCommitStore is a depinject provider for the store the sequencer
+// executes blocks against.
+func ProvideCommitStore(in CommitStoreInput) sequencer.Store {
+	return sequencer.NewCommitStore(in.CommitMultiStore, in.Logger)
+}
+
+// PendingStoreInput is the input for the pending store provider.
+type PendingStoreInput struct {
+	depinject.In
+	AppOpts servertypes.AppOptions
+}
+
+// ProvidePendingStore is a depinject provider for the store of the blocks
+// the sequencer has not yet published to the DA layer.
+func ProvidePendingStore(
+	in PendingStoreInput,
+) (sequencer.PendingStore, error) {
+	db, err := storev2.NewDB(
+		storev2.DBTypePebbleDB, pendingDBName,
+		filepath.Join(cast.ToString(in.AppOpts.Get(flags.FlagHome)), "data"),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return sequencer.NewKVPendingStore(db), nil
+}
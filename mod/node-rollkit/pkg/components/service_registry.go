@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/version"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/proposer"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/telemetry"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/tracing"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	sdkversion "github.com/cosmos/cosmos-sdk/version"
+)
+
+// ServiceRegistryInput is the input for the service registry provider.
+type ServiceRegistryInput struct {
+	depinject.In
+	BlobScrubService  *filedb.ScrubService
+	ChainService      *components.ChainService
+	Config            *config.Config
+	DBManager         *components.DBManager
+	DepositService    *components.DepositService
+	EngineClient      *components.EngineClient
+	Logger            log.Logger
+	ProposerConfig    *proposer.Store
+	Sequencer         *Sequencer
+	TelemetryRegistry *telemetry.Registry
+	TelemetrySink     *metrics.TelemetrySink
+	TracerProvider    *tracing.Provider
+	ValidatorService  *components.ValidatorService
+}
+
+// ProvideServiceRegistry is the depinject provider for the service registry
+// of a rollkit node. The sequencer takes the place of the ABCI middleware
+// and is registered last, so that it only produces blocks once the services
+// processing them are running.
+func ProvideServiceRegistry(
+	in ServiceRegistryInput,
+) *service.Registry {
+	return service.NewRegistry(
+		service.WithLogger(in.Logger),
+		service.WithService(in.ValidatorService),
+		service.WithService(in.ChainService),
+		service.WithService(in.DepositService),
+		service.WithService(in.EngineClient),
+		service.WithService(version.NewReportingService(
+			in.Logger.With("service", "reporting"),
+			in.TelemetrySink,
+			sdkversion.Version,
+		)),
+		service.WithService(in.DBManager),
+		service.WithService(in.BlobScrubService),
+		service.WithService(in.ProposerConfig),
+		service.WithService(telemetry.NewServer(
+			in.Config.Metrics,
+			in.Logger.With("service", "metrics"),
+			in.TelemetryRegistry,
+		)),
+		service.WithService(in.TracerProvider),
+		service.WithService(in.Sequencer),
+	)
+}
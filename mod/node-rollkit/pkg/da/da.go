@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package da
+
+import "context"
+
+// Blob is an opaque payload published to the DA layer.
+type Blob = []byte
+
+// ID identifies a blob published to the DA layer.
+type ID = []byte
+
+// DA is the data availability layer the sequencer publishes its blocks to.
+// Implementations are expected to be safe for concurrent use.
+type DA interface {
+	// MaxBlobSize returns the maximum size in bytes of a single blob.
+	MaxBlobSize(ctx context.Context) (uint64, error)
+	// Submit publishes the given blobs and returns their IDs, in order.
+	Submit(ctx context.Context, blobs []Blob) ([]ID, error)
+	// GetIDs returns the IDs of the blobs published at the given DA height.
+	GetIDs(ctx context.Context, height uint64) ([]ID, error)
+	// Get returns the blobs with the given IDs, in order.
+	Get(ctx context.Context, ids []ID) ([]Blob, error)
+}
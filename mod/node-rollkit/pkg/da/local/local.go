@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package local
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-rollkit/pkg/da"
+)
+
+const (
+	// DefaultMaxBlobSize is the default maximum size of a single blob.
+	DefaultMaxBlobSize uint64 = 2 << 20
+	// idLength is the length of an ID, the DA height followed by the index
+	// of the blob at that height.
+	idLength = 16
+)
+
+// Compile time check that DA implements da.DA.
+var _ da.DA = (*DA)(nil)
+
+// DA is an in-memory DA layer. Every submission is published at a new DA
+// height. It is meant as a stand-in for a real DA layer in tests and local
+// deployments.
+type DA struct {
+	mu sync.RWMutex
+	// maxBlobSize is the maximum size of a single blob.
+	maxBlobSize uint64
+	// height is the latest DA height.
+	height uint64
+	// blobs holds the blobs published at each DA height.
+	blobs map[uint64][]da.Blob
+}
+
+// New creates a new in-memory DA layer.
+func New(opts ...Option) (*DA, error) {
+	d := &DA{
+		maxBlobSize: DefaultMaxBlobSize,
+		blobs:       make(map[uint64][]da.Blob),
+	}
+	for _, opt := range opts {
+		if err := opt(d); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// MaxBlobSize returns the maximum size in bytes of a single blob.
+func (d *DA) MaxBlobSize(context.Context) (uint64, error) {
+	return d.maxBlobSize, nil
+}
+
+// Height returns the latest DA height.
+func (d *DA) Height() uint64 {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.height
+}
+
+// Submit publishes the given blobs at a new DA height and returns their IDs.
+func (d *DA) Submit(_ context.Context, blobs []da.Blob) ([]da.ID, error) {
+	for i, blob := range blobs {
+		if uint64(len(blob)) > d.maxBlobSize {
+			return nil, errors.Wrapf(
+				da.ErrBlobTooLarge, "blob %d is %d bytes", i, len(blob),
+			)
+		}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.height++
+	stored := make([]da.Blob, len(blobs))
+	ids := make([]da.ID, len(blobs))
+	for i, blob := range blobs {
+		stored[i] = append(da.Blob(nil), blob...)
+		ids[i] = newID(d.height, uint64(i))
+	}
+	d.blobs[d.height] = stored
+	return ids, nil
+}
+
+// GetIDs returns the IDs of the blobs published at the given DA height.
+func (d *DA) GetIDs(_ context.Context, height uint64) ([]da.ID, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if height > d.height {
+		return nil, errors.Wrapf(
+			da.ErrFutureHeight, "height %d, latest %d", height, d.height,
+		)
+	}
+
+	blobs := d.blobs[height]
+	ids := make([]da.ID, len(blobs))
+	for i := range blobs {
+		ids[i] = newID(height, uint64(i))
+	}
+	return ids, nil
+}
+
+// Get returns the blobs with the given IDs.
+func (d *DA) Get(_ context.Context, ids []da.ID) ([]da.Blob, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	blobs := make([]da.Blob, len(ids))
+	for i, id := range ids {
+		height, index, err := parseID(id)
+		if err != nil {
+			return nil, err
+		}
+		if index >= uint64(len(d.blobs[height])) {
+			return nil, errors.Wrapf(
+				da.ErrBlobNotFound, "height %d, index %d", height, index,
+			)
+		}
+		blobs[i] = append(da.Blob(nil), d.blobs[height][index]...)
+	}
+	return blobs, nil
+}
+
+// newID returns the ID of the blob at the given DA height and index.
+func newID(height, index uint64) da.ID {
+	id := make(da.ID, idLength)
+	binary.BigEndian.PutUint64(id, height)
+	binary.BigEndian.PutUint64(id[idLength/2:], index)
+	return id
+}
+
+// parseID returns the DA height and index encoded in the given ID.
+func parseID(id da.ID) (uint64, uint64, error) {
+	if len(id) != idLength {
+		return 0, 0, errors.Wrapf(
+			da.ErrInvalidID, "expected %d bytes, got %d", idLength, len(id),
+		)
+	}
+	return binary.BigEndian.Uint64(id),
+		binary.BigEndian.Uint64(id[idLength/2:]), nil
+}
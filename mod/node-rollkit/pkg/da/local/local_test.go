@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package local_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-rollkit/pkg/da"
+	"github.com/berachain/beacon-kit/mod/node-rollkit/pkg/da/local"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDA_SubmitAndGet(t *testing.T) {
+	ctx := context.Background()
+	d, err := local.New()
+	require.NoError(t, err)
+
+	ids, err := d.Submit(ctx, []da.Blob{[]byte("block"), []byte("blobs")})
+	require.NoError(t, err)
+	require.Len(t, ids, 2)
+	require.Equal(t, uint64(1), d.Height())
+
+	blobs, err := d.Get(ctx, ids)
+	require.NoError(t, err)
+	require.Equal(t, []da.Blob{[]byte("block"), []byte("blobs")}, blobs)
+
+	_, err = d.Submit(ctx, []da.Blob{[]byte("next")})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), d.Height())
+
+	heightIDs, err := d.GetIDs(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, ids, heightIDs)
+
+	_, err = d.GetIDs(ctx, 3)
+	require.ErrorIs(t, err, da.ErrFutureHeight)
+}
+
+func TestDA_Errors(t *testing.T) {
+	ctx := context.Background()
+	_, err := local.New(local.WithMaxBlobSize(0))
+	require.ErrorIs(t, err, local.ErrZeroMaxBlobSize)
+
+	d, err := local.New(local.WithMaxBlobSize(4))
+	require.NoError(t, err)
+
+	_, err = d.Submit(ctx, []da.Blob{[]byte("too large")})
+	require.ErrorIs(t, err, da.ErrBlobTooLarge)
+	require.Zero(t, d.Height())
+
+	ids, err := d.Submit(ctx, []da.Blob{[]byte("ok")})
+	require.NoError(t, err)
+
+	_, err = d.Get(ctx, []da.ID{ids[0][:8]})
+	require.ErrorIs(t, err, da.ErrInvalidID)
+
+	missing := append(da.ID(nil), ids[0]...)
+	missing[len(missing)-1]++
+	_, err = d.Get(ctx, []da.ID{missing})
+	require.ErrorIs(t, err, da.ErrBlobNotFound)
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/app"
+	corecomponents "github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	beacon "github.com/berachain/beacon-kit/mod/node-core/pkg/components/module"
+	"github.com/berachain/beacon-kit/mod/node-rollkit/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-rollkit/pkg/sequencer"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// Node is a beacon node whose blocks are produced by its sequencer and
+// published to a DA layer, rather than agreed on through CometBFT.
+type Node struct {
+	// app holds the stores the sequencer commits the state to.
+	app *app.BeaconApp
+	// registry is the node's service registry.
+	registry *service.Registry
+	// sequencer produces the blocks of the node.
+	sequencer *components.Sequencer
+	// genesisFile is the path to the genesis file the chain is initialized
+	// from on first start.
+	genesisFile string
+}
+
+// New returns a new node.
+func New(
+	app *app.BeaconApp,
+	registry *service.Registry,
+	sequencer *components.Sequencer,
+	genesisFile string,
+) *Node {
+	return &Node{
+		app:         app,
+		registry:    registry,
+		sequencer:   sequencer,
+		genesisFile: genesisFile,
+	}
+}
+
+// Start initializes the chain from the genesis file if no block was
+// committed yet, then starts the services of the node. The sequencer is
+// started last, once the chain service runs the post block tasks of the
+// blocks it produces.
+func (n *Node) Start(ctx context.Context) error {
+	if err := n.initChain(ctx); err != nil {
+		return err
+	}
+	return n.registry.StartAll(ctx)
+}
+
+// Close closes the stores of the node. The context the node was started
+// with must be done, it waits for the chain service to stop using them.
+func (n *Node) Close() error {
+	var chainService *corecomponents.ChainService
+	if err := n.registry.FetchService(&chainService); err == nil {
+		chainService.Wait()
+	}
+	return n.app.Close()
+}
+
+// FetchService sets the given pointer to the registered service of its
+// type.
+func (n *Node) FetchService(service any) error {
+	return n.registry.FetchService(service)
+}
+
+// initChain initializes the chain from the beacon genesis held by the
+// genesis file, unless it already was.
+func (n *Node) initChain(ctx context.Context) error {
+	appGenesis, err := genutiltypes.AppGenesisFromFile(n.genesisFile)
+	if err != nil {
+		return err
+	}
+	var appState map[string]json.RawMessage
+	if err = json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+		return err
+	}
+	bz, ok := appState[beacon.ModuleName]
+	if !ok {
+		return errors.Wrapf(
+			ErrMissingGenesis, "no %s state in %s",
+			beacon.ModuleName, n.genesisFile,
+		)
+	}
+
+	err = n.sequencer.InitChain(ctx, bz, appGenesis.GenesisTime)
+	if errors.Is(err, sequencer.ErrAlreadyInitialized) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sequencer
+
+import (
+	"context"
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-rollkit/pkg/da"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// produceBlock builds, verifies and finalizes the block at the given height,
+// then queues the block for the DA layer and commits the state. Each step
+// runs against its own branch of the state, as PrepareProposal,
+// ProcessProposal and FinalizeBlock would under CometBFT.
+func (s *Sequencer[_, _, _]) produceBlock(
+	ctx context.Context,
+	height int64,
+	blockTime time.Time,
+) error {
+	//#nosec:G701 // heights are never negative.
+	slot := math.Slot(height)
+	s.slotClock.OnBlock(slot, blockTime)
+
+	// Have the validator service build the block and sidecars.
+	buildCtx, _ := s.store.Branch(ctx, height, blockTime)
+	s.slotFeed.Send(asynctypes.NewEvent(buildCtx, events.NewSlot, slot))
+	blk, sidecars, err := s.waitForBlockAndSidecars(buildCtx)
+	if err != nil {
+		return err
+	} else if blk.IsNil() {
+		return ErrNilBlock
+	}
+
+	blkBz, err := blk.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	sidecarsBz, err := sidecars.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+
+	// Verify them as any other node would.
+	verifyCtx, _ := s.store.Branch(ctx, height, blockTime)
+	if err = s.chainService.ReceiveBlock(verifyCtx, blk); err != nil {
+		return err
+	}
+	if err = s.daService.ReceiveSidecars(
+		verifyCtx, slot, sidecars,
+	); err != nil {
+		return err
+	}
+
+	// Finalize them and commit the resulting state.
+	finalizeCtx, write := s.store.Branch(ctx, height, blockTime)
+	if err = s.daService.ProcessSidecars(
+		finalizeCtx, slot, sidecars,
+	); err != nil {
+		return err
+	}
+	if _, err = s.chainService.ProcessBeaconBlock(
+		finalizeCtx, blk,
+	); err != nil {
+		return err
+	}
+	// Record the blobs before the commit, so that no committed block is
+	// left unpublished by a crash.
+	block := PendingBlock{
+		Height: height,
+		Blobs:  []da.Blob{blkBz, sidecarsBz},
+	}
+	if err = s.pendingStore.Set(block.Height, block.Blobs); err != nil {
+		return err
+	}
+	write()
+	if err = s.store.Commit(); err != nil {
+		return err
+	}
+
+	s.pending = append(s.pending, block)
+	s.logger.Info("produced block", "height", height)
+	return nil
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sequencer
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrAlreadyInitialized is returned when the chain is initialized from
+	// genesis after blocks have been committed.
+	ErrAlreadyInitialized = errors.New("chain already initialized")
+	// ErrNotInitialized is returned when the sequencer is started before the
+	// chain has a genesis state.
+	ErrNotInitialized = errors.New("chain not initialized")
+	// ErrNilBlock is returned when no beacon block was built for a slot.
+	ErrNilBlock = errors.New("nil beacon block")
+	// ErrCorruptedPendingBlock is returned when the stored blobs of a block
+	// not yet published to the DA layer cannot be decoded.
+	ErrCorruptedPendingBlock = errors.New("corrupted pending block")
+)
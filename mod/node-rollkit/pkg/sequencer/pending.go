@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sequencer
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-rollkit/pkg/da"
+)
+
+// Compile time check that KVPendingStore implements PendingStore.
+var _ PendingStore = (*KVPendingStore)(nil)
+
+// KVPendingStore is a PendingStore backed by a KV store. The blobs of each
+// block are stored under its big-endian height, each prefixed with its
+// big-endian length, and every write is flushed to disk before it returns.
+type KVPendingStore struct {
+	// db is the KV store holding the blobs.
+	db store.KVStoreWithBatch
+}
+
+// NewKVPendingStore creates a new KVPendingStore over the given KV store.
+func NewKVPendingStore(db store.KVStoreWithBatch) *KVPendingStore {
+	return &KVPendingStore{db: db}
+}
+
+// Set records the blobs of the block at the given height.
+func (s *KVPendingStore) Set(height int64, blobs []da.Blob) error {
+	var value []byte
+	for _, blob := range blobs {
+		//#nosec:G701 // blobs are far smaller than 4GiB.
+		value = binary.BigEndian.AppendUint32(value, uint32(len(blob)))
+		value = append(value, blob...)
+	}
+	return s.writeSync(func(batch store.Batch) error {
+		return batch.Set(heightKey(height), value)
+	})
+}
+
+// Blocks returns the recorded blocks in order of height.
+func (s *KVPendingStore) Blocks() ([]PendingBlock, error) {
+	it, err := s.db.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	// The value is only valid until the iterator moves, so it is copied.
+	var blocks []PendingBlock
+	for ; it.Valid(); it.Next() {
+		block, decodeErr := decodePendingBlock(
+			it.Key(), bytes.Clone(it.Value()),
+		)
+		if decodeErr != nil {
+			return nil, decodeErr
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, it.Error()
+}
+
+// Delete drops the blobs of the blocks at the given heights.
+func (s *KVPendingStore) Delete(heights ...int64) error {
+	return s.writeSync(func(batch store.Batch) error {
+		for _, height := range heights {
+			if err := batch.Delete(heightKey(height)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// writeSync applies the given write to a batch and writes it synchronously.
+func (s *KVPendingStore) writeSync(write func(store.Batch) error) error {
+	batch := s.db.NewBatch()
+	if err := write(batch); err != nil {
+		return errors.Join(err, batch.Close())
+	}
+	return errors.Join(batch.WriteSync(), batch.Close())
+}
+
+// heightKey returns the key of the block at the given height.
+func heightKey(height int64) []byte {
+	//#nosec:G701 // heights are never negative.
+	return binary.BigEndian.AppendUint64(nil, uint64(height))
+}
+
+// decodePendingBlock decodes the block stored under the given key.
+func decodePendingBlock(key, value []byte) (PendingBlock, error) {
+	if len(key) != 8 {
+		return PendingBlock{}, errors.Wrapf(
+			ErrCorruptedPendingBlock, "key of %d bytes", len(key),
+		)
+	}
+	//#nosec:G701 // heights are never negative.
+	block := PendingBlock{Height: int64(binary.BigEndian.Uint64(key))}
+	for len(value) > 0 {
+		if len(value) < 4 {
+			return PendingBlock{}, errors.Wrapf(
+				ErrCorruptedPendingBlock, "height %d", block.Height,
+			)
+		}
+		size := binary.BigEndian.Uint32(value)
+		value = value[4:]
+		if uint64(len(value)) < uint64(size) {
+			return PendingBlock{}, errors.Wrapf(
+				ErrCorruptedPendingBlock, "height %d", block.Height,
+			)
+		}
+		block.Blobs = append(block.Blobs, da.Blob(value[:size]))
+		value = value[size:]
+	}
+	return block, nil
+}
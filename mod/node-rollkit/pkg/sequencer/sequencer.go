@@ -0,0 +1,334 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sequencer
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/event"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-rollkit/pkg/da"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+)
+
+// Sequencer drives the beacon chain as a single sequencer, in place of
+// CometBFT and the ABCI middleware. Every block time it has the validator
+// service build a block, verifies and finalizes it through the blockchain
+// and DA services, commits the state and publishes the block to the DA layer.
+type Sequencer[
+	BeaconBlockT BeaconBlock,
+	BlobSidecarsT ssz.Marshallable,
+	GenesisT Genesis,
+] struct {
+	// chainService represents the blockchain service.
+	chainService BlockchainService[BeaconBlockT, GenesisT]
+	// daService is the service verifying and storing blob sidecars.
+	daService DAService[BlobSidecarsT]
+	// da is the DA layer blocks are published to.
+	da da.DA
+	// store is the state store blocks are executed against.
+	store Store
+	// pendingStore persists the blobs of the blocks not yet published to
+	// the DA layer.
+	pendingStore PendingStore
+	// maxPending is the number of unpublished blocks past which no block is
+	// produced until the DA layer catches up.
+	maxPending int
+	// slotClock is anchored to the time of every block produced.
+	slotClock SlotClock
+	// blockTime is the interval at which blocks are produced.
+	blockTime time.Duration
+	// logger is the logger for the sequencer.
+	logger log.Logger[any]
+
+	// initialized is set once the chain has a genesis state.
+	initialized bool
+	// pending holds the committed blocks not yet published to the DA
+	// layer, in order. It mirrors pendingStore.
+	pending []PendingBlock
+
+	// Feeds
+	//
+	// blkFeed is a feed for blocks.
+	blkFeed *event.FeedOf[
+		asynctypes.EventID, *asynctypes.Event[BeaconBlockT]]
+	// sidecarsFeed is a feed for sidecars.
+	sidecarsFeed *event.FeedOf[
+		asynctypes.EventID, *asynctypes.Event[BlobSidecarsT]]
+	// slotFeed is a feed for slots.
+	slotFeed *event.FeedOf[
+		asynctypes.EventID, *asynctypes.Event[math.Slot]]
+
+	// Channels
+	//
+	// blkCh receives the blocks built by the validator service.
+	blkCh chan *asynctypes.Event[BeaconBlockT]
+	// sidecarsCh receives the sidecars built by the validator service.
+	sidecarsCh chan *asynctypes.Event[BlobSidecarsT]
+}
+
+// New creates a new sequencer.
+func New[
+	BeaconBlockT BeaconBlock,
+	BlobSidecarsT ssz.Marshallable,
+	GenesisT Genesis,
+](
+	chainService BlockchainService[BeaconBlockT, GenesisT],
+	daService DAService[BlobSidecarsT],
+	daLayer da.DA,
+	store Store,
+	pendingStore PendingStore,
+	maxPending int,
+	slotClock SlotClock,
+	blockTime time.Duration,
+	logger log.Logger[any],
+	blkFeed *event.FeedOf[
+		asynctypes.EventID, *asynctypes.Event[BeaconBlockT]],
+	sidecarsFeed *event.FeedOf[
+		asynctypes.EventID, *asynctypes.Event[BlobSidecarsT]],
+	slotFeed *event.FeedOf[
+		asynctypes.EventID, *asynctypes.Event[math.Slot]],
+) *Sequencer[BeaconBlockT, BlobSidecarsT, GenesisT] {
+	return &Sequencer[BeaconBlockT, BlobSidecarsT, GenesisT]{
+		chainService: chainService,
+		daService:    daService,
+		da:           daLayer,
+		store:        store,
+		pendingStore: pendingStore,
+		maxPending:   maxPending,
+		slotClock:    slotClock,
+		blockTime:    blockTime,
+		logger:       logger,
+		initialized:  store.LastHeight() > 0,
+		blkFeed:      blkFeed,
+		sidecarsFeed: sidecarsFeed,
+		slotFeed:     slotFeed,
+		blkCh:        make(chan *asynctypes.Event[BeaconBlockT], 1),
+		sidecarsCh:   make(chan *asynctypes.Event[BlobSidecarsT], 1),
+	}
+}
+
+// Name returns the name of the sequencer.
+func (s *Sequencer[_, _, _]) Name() string {
+	return "sequencer"
+}
+
+// InitChain initializes the beacon state from the given genesis data. The
+// genesis state is committed along with the first block.
+func (s *Sequencer[_, _, GenesisT]) InitChain(
+	ctx context.Context,
+	bz []byte,
+	genesisTime time.Time,
+) error {
+	if s.initialized {
+		return ErrAlreadyInitialized
+	}
+
+	data := new(GenesisT)
+	if err := json.Unmarshal(bz, data); err != nil {
+		return err
+	}
+
+	s.slotClock.SetGenesisTime(genesisTime)
+	ctx, write := s.store.Branch(ctx, 0, genesisTime)
+	if _, err := s.chainService.ProcessGenesisData(ctx, *data); err != nil {
+		return err
+	}
+	write()
+	s.initialized = true
+	return nil
+}
+
+// Start starts producing blocks, publishing first the blocks left
+// unpublished by the previous run. The chain must have been initialized.
+func (s *Sequencer[BeaconBlockT, BlobSidecarsT, _]) Start(
+	ctx context.Context,
+) error {
+	if !s.initialized {
+		return ErrNotInitialized
+	}
+	if err := s.loadPending(); err != nil {
+		return err
+	}
+
+	// Subscribe before the first slot is sent, so no block is missed.
+	subBlkCh := make(chan *asynctypes.Event[BeaconBlockT], 1)
+	subSidecarsCh := make(chan *asynctypes.Event[BlobSidecarsT], 1)
+	blkSub := s.blkFeed.Subscribe(subBlkCh)
+	sidecarsSub := s.sidecarsFeed.Subscribe(subSidecarsCh)
+	go func() {
+		defer blkSub.Unsubscribe()
+		defer sidecarsSub.Unsubscribe()
+		s.forwardEvents(ctx, subBlkCh, subSidecarsCh)
+	}()
+	go s.start(ctx)
+	return nil
+}
+
+// start produces a block every block time until the context is done.
+func (s *Sequencer[_, _, _]) start(ctx context.Context) {
+	ticker := time.NewTicker(s.blockTime)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case t := <-ticker.C:
+			if len(s.pending) >= s.maxPending {
+				s.logger.Warn(
+					"too many blocks not published to DA, waiting",
+					"blocks", len(s.pending),
+				)
+				s.submitPending(ctx)
+				continue
+			}
+			height := s.store.LastHeight() + 1
+			if err := s.produceBlock(ctx, height, t); err != nil {
+				s.logger.Error(
+					"failed to produce block", "height", height, "error", err,
+				)
+				continue
+			}
+			s.submitPending(ctx)
+		}
+	}
+}
+
+// forwardEvents forwards the blocks and sidecars built by the validator
+// service to the sequencer.
+func (s *Sequencer[BeaconBlockT, BlobSidecarsT, _]) forwardEvents(
+	ctx context.Context,
+	subBlkCh <-chan *asynctypes.Event[BeaconBlockT],
+	subSidecarsCh <-chan *asynctypes.Event[BlobSidecarsT],
+) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-subBlkCh:
+			if msg.Is(events.BeaconBlockBuilt) {
+				s.blkCh <- msg
+			}
+		case msg := <-subSidecarsCh:
+			if msg.Is(events.BlobSidecarsBuilt) {
+				s.sidecarsCh <- msg
+			}
+		}
+	}
+}
+
+// loadPending loads the blocks not yet published to the DA layer. Blocks
+// above the last committed height were recorded by a run that stopped
+// before committing them, so they are dropped to be produced again.
+func (s *Sequencer[_, _, _]) loadPending() error {
+	blocks, err := s.pendingStore.Blocks()
+	if err != nil {
+		return err
+	}
+
+	lastHeight := s.store.LastHeight()
+	var uncommitted []int64
+	for len(blocks) > 0 && blocks[len(blocks)-1].Height > lastHeight {
+		uncommitted = append(uncommitted, blocks[len(blocks)-1].Height)
+		blocks = blocks[:len(blocks)-1]
+	}
+	if err = s.pendingStore.Delete(uncommitted...); err != nil {
+		return err
+	}
+
+	if len(blocks) > 0 {
+		s.logger.Info(
+			"loaded blocks not published to DA", "blocks", len(blocks),
+		)
+	}
+	s.pending = blocks
+	return nil
+}
+
+// submitPending publishes the pending blocks to the DA layer. They are kept
+// for the next attempt on failure.
+func (s *Sequencer[_, _, _]) submitPending(ctx context.Context) {
+	if len(s.pending) == 0 {
+		return
+	}
+
+	var (
+		blobs   []da.Blob
+		heights = make([]int64, 0, len(s.pending))
+	)
+	for _, block := range s.pending {
+		blobs = append(blobs, block.Blobs...)
+		heights = append(heights, block.Height)
+	}
+
+	ids, err := s.da.Submit(ctx, blobs)
+	if err != nil {
+		s.logger.Error(
+			"failed to submit blobs to DA", "blobs", len(blobs),
+			"error", err,
+		)
+		return
+	}
+	s.logger.Info("submitted blobs to DA", "blobs", len(ids))
+	s.pending = nil
+
+	// Failing to drop them only publishes them again after a restart.
+	if err = s.pendingStore.Delete(heights...); err != nil {
+		s.logger.Error(
+			"failed to drop blocks published to DA", "error", err,
+		)
+	}
+}
+
+// waitForBlockAndSidecars waits for the validator service to build the block
+// and sidecars of the current slot.
+func (s *Sequencer[BeaconBlockT, BlobSidecarsT, _]) waitForBlockAndSidecars(
+	ctx context.Context,
+) (BeaconBlockT, BlobSidecarsT, error) {
+	var (
+		blk                 BeaconBlockT
+		sidecars            BlobSidecarsT
+		blkErr, sidecarsErr error
+	)
+
+	// Both events are always sent, so drain both before returning.
+	select {
+	case <-ctx.Done():
+		return blk, sidecars, ctx.Err()
+	case msg := <-s.blkCh:
+		blk = msg.Data()
+		blkErr = msg.Error()
+	}
+	select {
+	case <-ctx.Done():
+		return blk, sidecars, ctx.Err()
+	case msg := <-s.sidecarsCh:
+		sidecars = msg.Data()
+		sidecarsErr = msg.Error()
+	}
+	return blk, sidecars, errors.Join(blkErr, sidecarsErr)
+}
@@ -0,0 +1,368 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sequencer_test
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/async/pkg/event"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-rollkit/pkg/da"
+	"github.com/berachain/beacon-kit/mod/node-rollkit/pkg/da/local"
+	"github.com/berachain/beacon-kit/mod/node-rollkit/pkg/sequencer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/stretchr/testify/require"
+)
+
+// testBlock is a beacon block only carrying its slot.
+type testBlock struct{ slot math.Slot }
+
+func (b *testBlock) MarshalSSZ() ([]byte, error) {
+	return b.MarshalSSZTo(nil)
+}
+
+func (b *testBlock) MarshalSSZTo(buf []byte) ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(buf, b.slot.Unwrap()), nil
+}
+
+func (b *testBlock) UnmarshalSSZ(buf []byte) error {
+	b.slot = math.Slot(binary.LittleEndian.Uint64(buf))
+	return nil
+}
+
+func (b *testBlock) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	binary.LittleEndian.PutUint64(root[:], b.slot.Unwrap())
+	return root, nil
+}
+
+func (b *testBlock) SizeSSZ() int            { return 8 }
+func (b *testBlock) IsNil() bool             { return b == nil }
+func (b *testBlock) GetSlot() math.Slot      { return b.slot }
+func (b *testBlock) String() string          { return "test-block" }
+func (b *testBlock) Equal(o *testBlock) bool { return b.slot == o.slot }
+
+// testSidecars are empty blob sidecars.
+type testSidecars struct{}
+
+func (testSidecars) MarshalSSZ() ([]byte, error) { return []byte{}, nil }
+
+func (testSidecars) MarshalSSZTo(buf []byte) ([]byte, error) {
+	return buf, nil
+}
+
+func (*testSidecars) UnmarshalSSZ([]byte) error { return nil }
+
+func (testSidecars) HashTreeRoot() ([32]byte, error) {
+	return [32]byte{}, nil
+}
+
+func (testSidecars) SizeSSZ() int { return 0 }
+
+// testGenesis is the genesis data.
+type testGenesis struct{ ForkVersion string }
+
+func (g *testGenesis) UnmarshalJSON(bz []byte) error {
+	type alias testGenesis
+	return json.Unmarshal(bz, (*alias)(g))
+}
+
+// testChain records the blocks verified and processed.
+type testChain struct {
+	mu        sync.Mutex
+	genesis   *testGenesis
+	received  []math.Slot
+	processed []math.Slot
+}
+
+func (c *testChain) ProcessGenesisData(
+	_ context.Context, g *testGenesis,
+) (transition.ValidatorUpdates, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.genesis = g
+	return nil, nil
+}
+
+func (c *testChain) ReceiveBlock(_ context.Context, blk *testBlock) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.received = append(c.received, blk.GetSlot())
+	return nil
+}
+
+func (c *testChain) ProcessBeaconBlock(
+	_ context.Context, blk *testBlock,
+) (transition.ValidatorUpdates, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.processed = append(c.processed, blk.GetSlot())
+	return nil, nil
+}
+
+func (c *testChain) Processed() []math.Slot {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]math.Slot(nil), c.processed...)
+}
+
+// testDAService accepts all sidecars.
+type testDAService struct{}
+
+func (testDAService) ReceiveSidecars(
+	context.Context, math.Slot, *testSidecars,
+) error {
+	return nil
+}
+
+func (testDAService) ProcessSidecars(
+	context.Context, math.Slot, *testSidecars,
+) error {
+	return nil
+}
+
+// testClock ignores the block times.
+type testClock struct{}
+
+func (testClock) SetGenesisTime(time.Time)     {}
+func (testClock) OnBlock(math.Slot, time.Time) {}
+
+// testStore counts the branches written and the commits.
+type testStore struct {
+	height atomic.Int64
+	writes atomic.Int64
+}
+
+func (s *testStore) LastHeight() int64 { return s.height.Load() }
+
+func (s *testStore) Branch(
+	ctx context.Context, _ int64, _ time.Time,
+) (context.Context, func()) {
+	return ctx, func() { s.writes.Add(1) }
+}
+
+func (s *testStore) Commit() error {
+	s.height.Add(1)
+	return nil
+}
+
+// flakyDA is a local DA layer that can be taken down.
+type flakyDA struct {
+	*local.DA
+	down atomic.Bool
+}
+
+func (d *flakyDA) Submit(
+	ctx context.Context, blobs []da.Blob,
+) ([]da.ID, error) {
+	if d.down.Load() {
+		return nil, errors.New("DA layer down")
+	}
+	return d.DA.Submit(ctx, blobs)
+}
+
+type (
+	blockFeed = event.FeedOf[
+		asynctypes.EventID, *asynctypes.Event[*testBlock]]
+	sidecarsFeed = event.FeedOf[
+		asynctypes.EventID, *asynctypes.Event[*testSidecars]]
+	slotFeed = event.FeedOf[
+		asynctypes.EventID, *asynctypes.Event[math.Slot]]
+)
+
+// runValidator builds a block for every new slot, like the validator
+// service.
+func runValidator(
+	ctx context.Context,
+	blkFeed *blockFeed,
+	scFeed *sidecarsFeed,
+	slots *slotFeed,
+) {
+	ch := make(chan *asynctypes.Event[math.Slot], 1)
+	sub := slots.Subscribe(ch)
+	go func() {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case req := <-ch:
+				blkFeed.Send(asynctypes.NewEvent(
+					req.Context(), events.BeaconBlockBuilt,
+					&testBlock{slot: req.Data()},
+				))
+				scFeed.Send(asynctypes.NewEvent(
+					req.Context(), events.BlobSidecarsBuilt,
+					&testSidecars{},
+				))
+			}
+		}
+	}()
+}
+
+func TestSequencer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		chain    = &testChain{}
+		store    = &testStore{}
+		blkFeed  = &blockFeed{}
+		scFeed   = &sidecarsFeed{}
+		slots    = &slotFeed{}
+		daLayer  *local.DA
+		err      error
+		blocksDA = 2
+	)
+	daLayer, err = local.New()
+	require.NoError(t, err)
+	s := sequencer.New[*testBlock, *testSidecars, *testGenesis](
+		chain, testDAService{}, daLayer, store,
+		sequencer.NewKVPendingStore(storev2.NewMemDB()), 8, testClock{},
+		10*time.Millisecond, noop.NewLogger(), blkFeed, scFeed, slots,
+	)
+	require.Equal(t, "sequencer", s.Name())
+	runValidator(ctx, blkFeed, scFeed, slots)
+
+	require.ErrorIs(t, s.Start(ctx), sequencer.ErrNotInitialized)
+	require.NoError(t, s.InitChain(
+		ctx, []byte(`{"ForkVersion":"0x04000000"}`), time.Now(),
+	))
+	require.Equal(t, "0x04000000", chain.genesis.ForkVersion)
+	require.ErrorIs(
+		t, s.InitChain(ctx, []byte(`{}`), time.Now()),
+		sequencer.ErrAlreadyInitialized,
+	)
+	require.Zero(t, store.LastHeight())
+
+	require.NoError(t, s.Start(ctx))
+	require.Eventually(t, func() bool {
+		return daLayer.Height() >= uint64(blocksDA)
+	}, time.Second, time.Millisecond)
+	cancel()
+
+	// Blocks are finalized in order from the first slot.
+	processed := chain.Processed()
+	require.GreaterOrEqual(t, len(processed), blocksDA)
+	for i, slot := range processed[:blocksDA] {
+		require.Equal(t, math.Slot(i+1), slot)
+	}
+
+	// Every DA height holds a block followed by its sidecars.
+	for height := range uint64(blocksDA) {
+		ids, getErr := daLayer.GetIDs(ctx, height+1)
+		require.NoError(t, getErr)
+		blobs, getErr := daLayer.Get(ctx, ids)
+		require.NoError(t, getErr)
+		require.Len(t, blobs, 2)
+
+		blk := &testBlock{}
+		require.NoError(t, blk.UnmarshalSSZ(blobs[0]))
+		require.Equal(t, math.Slot(height+1), blk.GetSlot())
+	}
+}
+
+func TestSequencer_PendingBlocks(t *testing.T) {
+	dir := t.TempDir()
+	openPending := func() (*sequencer.KVPendingStore, func()) {
+		db, err := storev2.NewPebbleDB("pending", dir)
+		require.NoError(t, err)
+		return sequencer.NewKVPendingStore(db), func() {
+			require.NoError(t, db.Close())
+		}
+	}
+	localDA, err := local.New()
+	require.NoError(t, err)
+	daLayer := &flakyDA{DA: localDA}
+	daLayer.down.Store(true)
+	store := &testStore{}
+	run := func(
+		ctx context.Context, pending sequencer.PendingStore,
+	) *sequencer.Sequencer[*testBlock, *testSidecars, *testGenesis] {
+		blkFeed, scFeed, slots := &blockFeed{}, &sidecarsFeed{}, &slotFeed{}
+		runValidator(ctx, blkFeed, scFeed, slots)
+		return sequencer.New[*testBlock, *testSidecars, *testGenesis](
+			&testChain{}, testDAService{}, daLayer, store, pending, 2,
+			testClock{}, 10*time.Millisecond, noop.NewLogger(),
+			blkFeed, scFeed, slots,
+		)
+	}
+
+	// While the DA layer is down, blocks are produced up to the limit.
+	ctx, cancel := context.WithCancel(context.Background())
+	pending, closePending := openPending()
+	s := run(ctx, pending)
+	require.NoError(t, s.InitChain(ctx, []byte(`{}`), time.Now()))
+	require.NoError(t, s.Start(ctx))
+	require.Eventually(t, func() bool {
+		return store.LastHeight() == 2
+	}, time.Second, time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, int64(2), store.LastHeight())
+	cancel()
+	time.Sleep(20 * time.Millisecond)
+
+	// A block recorded but never committed before the restart.
+	require.NoError(t, pending.Set(3, []da.Blob{[]byte("stale")}))
+	closePending()
+
+	// After the restart, the committed blocks are published first, then the
+	// uncommitted block is produced again.
+	ctx, cancel = context.WithCancel(context.Background())
+	pending, closePending = openPending()
+	daLayer.down.Store(false)
+	require.NoError(t, run(ctx, pending).Start(ctx))
+	require.Eventually(t, func() bool {
+		return daLayer.Height() >= 2
+	}, time.Second, time.Millisecond)
+
+	for height, slots := range [][]math.Slot{{1, 2}, {3}} {
+		ids, getErr := daLayer.GetIDs(ctx, uint64(height+1))
+		require.NoError(t, getErr)
+		blobs, getErr := daLayer.Get(ctx, ids)
+		require.NoError(t, getErr)
+		require.Len(t, blobs, 2*len(slots))
+		for i, slot := range slots {
+			blk := &testBlock{}
+			require.NoError(t, blk.UnmarshalSSZ(blobs[2*i]))
+			require.Equal(t, slot, blk.GetSlot())
+		}
+	}
+	require.Eventually(t, func() bool {
+		blocks, blocksErr := pending.Blocks()
+		require.NoError(t, blocksErr)
+		return len(blocks) == 0
+	}, time.Second, time.Millisecond)
+	cancel()
+	time.Sleep(20 * time.Millisecond)
+	closePending()
+}
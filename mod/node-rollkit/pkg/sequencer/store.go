@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sequencer
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Compile time check that CommitStore implements Store.
+var _ Store = (*CommitStore)(nil)
+
+// CommitStore is a Store backed by a commit multistore, as used by the
+// application under CometBFT.
+type CommitStore struct {
+	// cms is the commit multistore holding the state.
+	cms storetypes.CommitMultiStore
+	// logger is the logger of the contexts handed out.
+	logger log.Logger
+}
+
+// NewCommitStore creates a new CommitStore over the given commit multistore,
+// which must have its latest version loaded.
+func NewCommitStore(
+	cms storetypes.CommitMultiStore,
+	logger log.Logger,
+) *CommitStore {
+	return &CommitStore{
+		cms:    cms,
+		logger: logger,
+	}
+}
+
+// LastHeight returns the height of the last committed block.
+func (s *CommitStore) LastHeight() int64 {
+	return s.cms.LastCommitID().Version
+}
+
+// Branch returns an SDK context bound to a cache of the latest state.
+func (s *CommitStore) Branch(
+	ctx context.Context,
+	height int64,
+	blockTime time.Time,
+) (context.Context, func()) {
+	ms := s.cms.CacheMultiStore()
+	return sdk.NewContext(ms, false, s.logger).
+		WithContext(ctx).
+		WithBlockHeight(height).
+		WithHeaderInfo(header.Info{
+			Height: height,
+			Time:   blockTime,
+		}), ms.Write
+}
+
+// Commit commits the commit multistore.
+func (s *CommitStore) Commit() error {
+	s.cms.Commit()
+	return nil
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sequencer
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/node-rollkit/pkg/da"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// BeaconBlock is an interface for accessing the beacon block.
+type BeaconBlock interface {
+	ssz.Marshallable
+	IsNil() bool
+	GetSlot() math.Slot
+}
+
+// BlockchainService defines the interface for interacting with the blockchain
+// state and processing blocks.
+type BlockchainService[BeaconBlockT, GenesisT any] interface {
+	// ProcessGenesisData processes the genesis data and initializes the beacon
+	// state.
+	ProcessGenesisData(
+		context.Context,
+		GenesisT,
+	) (transition.ValidatorUpdates, error)
+	// ReceiveBlock verifies the given beacon block against the state.
+	ReceiveBlock(ctx context.Context, blk BeaconBlockT) error
+	// ProcessBeaconBlock processes the given beacon block.
+	ProcessBeaconBlock(
+		context.Context,
+		BeaconBlockT,
+	) (transition.ValidatorUpdates, error)
+}
+
+// DAService is the service verifying and storing blob sidecars.
+type DAService[BlobSidecarsT any] interface {
+	// ReceiveSidecars verifies the given sidecars.
+	ReceiveSidecars(context.Context, math.Slot, BlobSidecarsT) error
+	// ProcessSidecars stores the given sidecars.
+	ProcessSidecars(context.Context, math.Slot, BlobSidecarsT) error
+}
+
+// Genesis is the interface for the genesis data.
+type Genesis interface {
+	json.Unmarshaler
+}
+
+// PendingBlock holds the blobs of a committed block not yet published to the
+// DA layer.
+type PendingBlock struct {
+	// Height is the height of the block.
+	Height int64
+	// Blobs are the blobs of the block, in order.
+	Blobs []da.Blob
+}
+
+// PendingStore persists the blobs of the committed blocks not yet published
+// to the DA layer, so they survive a restart.
+type PendingStore interface {
+	// Set records the blobs of the block at the given height.
+	Set(height int64, blobs []da.Blob) error
+	// Blocks returns the recorded blocks in order of height.
+	Blocks() ([]PendingBlock, error)
+	// Delete drops the blobs of the blocks at the given heights.
+	Delete(heights ...int64) error
+}
+
+// SlotClock is the clock that maps slots to block times.
+type SlotClock interface {
+	// SetGenesisTime sets the genesis time of the chain.
+	SetGenesisTime(genesisTime time.Time)
+	// OnBlock anchors the clock to the time of the block at the given slot.
+	OnBlock(slot math.Slot, blockTime time.Time)
+}
+
+// Store is the state store the sequencer executes blocks against.
+type Store interface {
+	// LastHeight returns the height of the last committed block.
+	LastHeight() int64
+	// Branch returns a context bound to a branch of the latest state, for the
+	// block at the given height and time, along with a function writing the
+	// branch back to the latest state.
+	Branch(
+		ctx context.Context, height int64, blockTime time.Time,
+	) (context.Context, func())
+	// Commit persists everything written since the last commit as the next
+	// height.
+	Commit() error
+}
@@ -23,6 +23,7 @@ package attributes
 import (
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -45,9 +46,11 @@ type Factory[
 	chainSpec common.ChainSpec
 	// logger is the logger for the attributes factory.
 	logger log.Logger[any]
-	// suggestedFeeRecipient is the suggested fee recipient sent to
-	// the execution client for the payload build.
-	suggestedFeeRecipient common.ExecutionAddress
+	// proposerConfig provides the fee recipient sent to the execution
+	// client for the payload build.
+	proposerConfig ProposerConfig
+	// pubkey is the public key of the proposer of this node.
+	pubkey crypto.BLSPubkey
 }
 
 // NewAttributesFactory creates a new instance of AttributesFactory.
@@ -67,15 +70,25 @@ func NewAttributesFactory[
 ](
 	chainSpec common.ChainSpec,
 	logger log.Logger[any],
-	suggestedFeeRecipient common.ExecutionAddress,
+	proposerConfig ProposerConfig,
+	pubkey crypto.BLSPubkey,
 ) *Factory[BeaconStateT, PayloadAttributesT, WithdrawalT] {
 	return &Factory[BeaconStateT, PayloadAttributesT, WithdrawalT]{
-		chainSpec:             chainSpec,
-		logger:                logger,
-		suggestedFeeRecipient: suggestedFeeRecipient,
+		chainSpec:      chainSpec,
+		logger:         logger,
+		proposerConfig: proposerConfig,
+		pubkey:         pubkey,
 	}
 }
 
+// SuggestedFeeRecipient returns the fee recipient currently configured for
+// the proposer of this node.
+func (f *Factory[
+	BeaconStateT, PayloadAttributesT, WithdrawalT,
+]) SuggestedFeeRecipient() common.ExecutionAddress {
+	return f.proposerConfig.FeeRecipient(f.pubkey)
+}
+
 // CreateAttributes creates a new instance of PayloadAttributes.
 func (f *Factory[
 	BeaconStateT, PayloadAttributesT, WithdrawalT,
@@ -113,7 +126,7 @@ func (f *Factory[
 		f.chainSpec.ActiveForkVersionForEpoch(epoch),
 		timestamp,
 		prevRandao,
-		f.suggestedFeeRecipient,
+		f.SuggestedFeeRecipient(),
 		withdrawals,
 		prevHeadRoot,
 	)
@@ -20,7 +20,10 @@
 
 package attributes
 
-import "github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
 
 // BeaconState is an interface for accessing the beacon state.
 type BeaconState[WithdrawalT any] interface {
@@ -29,3 +32,10 @@ type BeaconState[WithdrawalT any] interface {
 	// GetRandaoMixAtIndex returns the randao mix at the given index.
 	GetRandaoMixAtIndex(index uint64) (common.Root, error)
 }
+
+// ProposerConfig provides the settings of the proposers of this node.
+type ProposerConfig interface {
+	// FeeRecipient returns the fee recipient of the proposer with the given
+	// public key.
+	FeeRecipient(pubkey crypto.BLSPubkey) common.ExecutionAddress
+}
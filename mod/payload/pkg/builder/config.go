@@ -23,6 +23,8 @@ package builder
 import (
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/proposer"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 )
 
@@ -41,6 +43,10 @@ type Config struct {
 	// SuggestedFeeRecipient is the address that will receive the transaction
 	// fees produced by any blocks from this node.
 	SuggestedFeeRecipient common.ExecutionAddress `mapstructure:"suggested-fee-recipient"`
+	// ProposerConfig is the path of the proposer config file, setting the
+	// fee recipient, gas limit and graffiti of each validator key. It is
+	// reloaded whenever it changes.
+	ProposerConfig string `mapstructure:"proposer-config"`
 	// PayloadTimeout is the timeout parameter for local build
 	// payload. This should match, or be slightly less than the configured
 	// timeout on your execution client. It also must be less than
@@ -53,6 +59,37 @@ func DefaultConfig() Config {
 	return Config{
 		Enabled:               true,
 		SuggestedFeeRecipient: common.ZeroAddress,
+		ProposerConfig:        "",
 		PayloadTimeout:        defaultPayloadTimeout,
 	}
 }
+
+// Validate checks that the payload timeout is usable and that the proposer
+// config file, if any, can be loaded. A zero fee recipient on an enabled
+// builder is reported as a non-fatal error, since fees would be burnt
+// unless the proposer config sets a recipient.
+func (c Config) Validate() error {
+	var errs []error
+	if c.PayloadTimeout <= 0 {
+		errs = append(errs, errors.Wrap(ErrNonPositiveTimeout, "payload-timeout"))
+	}
+
+	file := &proposer.File{}
+	if c.ProposerConfig != "" {
+		var err error
+		if file, err = proposer.LoadFile(c.ProposerConfig); err != nil {
+			errs = append(errs, errors.Wrapf(
+				err, "proposer-config %q", c.ProposerConfig,
+			))
+			file = &proposer.File{}
+		}
+	}
+
+	if c.Enabled && c.SuggestedFeeRecipient == common.ZeroAddress &&
+		(file.DefaultConfig == nil || file.DefaultConfig.FeeRecipient == nil) {
+		errs = append(errs, errors.WrapNonFatal(
+			errors.Wrap(ErrZeroFeeRecipient, "suggested-fee-recipient"),
+		))
+	}
+	return errors.Join(errs...)
+}
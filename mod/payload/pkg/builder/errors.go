@@ -50,4 +50,14 @@ var (
 	// ErrNilPayload is returned when a nil payload envelope is
 	// received.
 	ErrNilPayload = errors.New("received nil payload envelope")
+
+	// ErrNonPositiveTimeout is returned when the payload timeout is not
+	// strictly positive.
+	ErrNonPositiveTimeout = errors.New("must be greater than zero")
+
+	// ErrZeroFeeRecipient is returned when the payload builder would send
+	// the fees of its payloads to the zero address.
+	ErrZeroFeeRecipient = errors.New(
+		"fee recipient is the zero address, fees will be burnt",
+	)
 )
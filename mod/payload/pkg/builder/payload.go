@@ -221,12 +221,13 @@ func (pb *PayloadBuilder[
 
 	// If the payload was built by a different builder, something is
 	// wrong the EL<>CL setup.
-	if payload.GetFeeRecipient() != pb.cfg.SuggestedFeeRecipient {
+	suggestedFeeRecipient := pb.attributesFactory.SuggestedFeeRecipient()
+	if payload.GetFeeRecipient() != suggestedFeeRecipient {
 		pb.logger.Warn(
 			"Payload fee recipient does not match suggested fee recipient - "+
 				"please check both your CL and EL configuration",
 			"payload_fee_recipient", payload.GetFeeRecipient(),
-			"suggested_fee_recipient", pb.cfg.SuggestedFeeRecipient,
+			"suggested_fee_recipient", suggestedFeeRecipient,
 		)
 	}
 	return envelope, err
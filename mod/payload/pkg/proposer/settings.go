@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proposer
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Settings are the preferences of a proposer for the blocks it proposes.
+type Settings struct {
+	// FeeRecipient is the address receiving the fees of the payloads.
+	FeeRecipient common.ExecutionAddress
+	// GasLimit is the gas limit the payloads are built towards.
+	GasLimit math.U64
+	// Graffiti is the graffiti included in the blocks.
+	Graffiti string
+}
+
+// Entry is an entry of the proposer config file. The fields it leaves
+// unset fall back to the default entry of the file, then to the settings
+// of the node.
+type Entry struct {
+	// FeeRecipient is the address receiving the fees of the payloads.
+	FeeRecipient *common.ExecutionAddress `json:"fee_recipient,omitempty"`
+	// GasLimit is the gas limit the payloads are built towards.
+	GasLimit *uint64 `json:"gas_limit,string,omitempty"`
+	// Graffiti is the graffiti included in the blocks.
+	Graffiti *string `json:"graffiti,omitempty"`
+}
+
+// IsEmpty returns true if the entry sets none of the settings.
+func (e *Entry) IsEmpty() bool {
+	return e == nil ||
+		(e.FeeRecipient == nil && e.GasLimit == nil && e.Graffiti == nil)
+}
+
+// Validate checks that the settings of the entry are usable.
+func (e *Entry) Validate() error {
+	if e == nil {
+		return nil
+	}
+	if e.GasLimit != nil && *e.GasLimit == 0 {
+		return ErrInvalidGasLimit
+	}
+	if e.Graffiti != nil && len(*e.Graffiti) > constants.RootLength {
+		return ErrGraffitiTooLong
+	}
+	return nil
+}
+
+// apply overrides the given settings with the ones the entry sets.
+func (e *Entry) apply(s Settings) Settings {
+	if e == nil {
+		return s
+	}
+	if e.FeeRecipient != nil {
+		s.FeeRecipient = *e.FeeRecipient
+	}
+	if e.GasLimit != nil {
+		s.GasLimit = math.U64(*e.GasLimit)
+	}
+	if e.Graffiti != nil {
+		s.Graffiti = *e.Graffiti
+	}
+	return s
+}
+
+// File is the content of a proposer config file.
+type File struct {
+	// ProposerConfig holds the entries of the proposers by public key.
+	ProposerConfig map[crypto.BLSPubkey]*Entry `json:"proposer_config"`
+	// DefaultConfig is the entry of the proposers without one of their own.
+	DefaultConfig *Entry `json:"default_config,omitempty"`
+}
+
+// Validate checks that the entries of the file are usable.
+func (f *File) Validate() error {
+	for pubkey, entry := range f.ProposerConfig {
+		if err := entry.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid entry for %s", pubkey)
+		}
+	}
+	if err := f.DefaultConfig.Validate(); err != nil {
+		return errors.Wrap(err, "invalid default entry")
+	}
+	return nil
+}
+
+// LoadFile reads and validates the proposer config file at the given path.
+// A missing file yields an empty config.
+func LoadFile(path string) (*File, error) {
+	file := &File{}
+	bz, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		return file, nil
+	case err != nil:
+		return nil, err
+	}
+	if err = json.Unmarshal(bz, file); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %s", path)
+	}
+	if err = file.Validate(); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
@@ -0,0 +1,309 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// reloadInterval is the interval at which the file is checked for
+	// changes.
+	reloadInterval = 2 * time.Second
+	// filePermissions are the permissions of a file written by the store.
+	filePermissions = 0o600
+)
+
+// Store holds the settings of the proposers of this node, read from a
+// proposer config file. The file is reloaded whenever it changes on disk,
+// and the changes made through the store are written back to it.
+//
+// Without a file, the changes are only kept in memory.
+type Store struct {
+	// path is the path of the proposer config file, if any.
+	path string
+	// defaults are the settings of the node, used for whatever the file
+	// does not set.
+	defaults Settings
+	// logger is used to report the reloads of the file.
+	logger log.Logger[any]
+
+	mu sync.RWMutex
+	// file is the content of the file last read or written.
+	file *File
+	// modTime and size identify the version of the file last read or
+	// written.
+	modTime time.Time
+	size    int64
+}
+
+// NewStore creates a new store backed by the proposer config file at the
+// given path, which may be empty, falling back to the given settings.
+func NewStore(
+	path string,
+	defaults Settings,
+	logger log.Logger[any],
+) (*Store, error) {
+	s := &Store{
+		path:     path,
+		defaults: defaults,
+		logger:   logger,
+		file:     &File{},
+	}
+	if _, err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Name returns the name of the service.
+func (*Store) Name() string {
+	return "proposer-config"
+}
+
+// Start reloads the file in the background whenever it changes.
+func (s *Store) Start(ctx context.Context) error {
+	if s.path == "" {
+		return nil
+	}
+	go func() {
+		ticker := time.NewTicker(reloadInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				changed, err := s.Reload()
+				if err != nil {
+					s.logger.Error(
+						"Failed to reload proposer config",
+						"path", s.path, "error", err,
+					)
+				} else if changed {
+					s.logger.Info(
+						"Reloaded proposer config 📝", "path", s.path,
+					)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Reload reads the file again if it changed since it was last read or
+// written, and reports whether it did. A missing file holds no entries.
+// The current settings are kept if the file is invalid.
+func (s *Store) Reload() (bool, error) {
+	if s.path == "" {
+		return false, nil
+	}
+
+	var modTime time.Time
+	var size int64
+	info, err := os.Stat(s.path)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return false, err
+	default:
+		modTime, size = info.ModTime(), info.Size()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if modTime.Equal(s.modTime) && size == s.size {
+		return false, nil
+	}
+
+	file, err := LoadFile(s.path)
+	if err != nil {
+		return false, err
+	}
+	s.file, s.modTime, s.size = file, modTime, size
+	return true, nil
+}
+
+// Settings returns the settings of the proposer with the given public key.
+func (s *Store) Settings(pubkey crypto.BLSPubkey) Settings {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.file.ProposerConfig[pubkey].apply(
+		s.file.DefaultConfig.apply(s.defaults),
+	)
+}
+
+// FeeRecipient returns the fee recipient of the proposer with the given
+// public key.
+func (s *Store) FeeRecipient(
+	pubkey crypto.BLSPubkey,
+) common.ExecutionAddress {
+	return s.Settings(pubkey).FeeRecipient
+}
+
+// GasLimit returns the gas limit of the proposer with the given public key.
+func (s *Store) GasLimit(pubkey crypto.BLSPubkey) math.U64 {
+	return s.Settings(pubkey).GasLimit
+}
+
+// Graffiti returns the graffiti of the proposer with the given public key.
+func (s *Store) Graffiti(pubkey crypto.BLSPubkey) string {
+	return s.Settings(pubkey).Graffiti
+}
+
+// SetFeeRecipient sets the fee recipient of the proposer with the given
+// public key.
+func (s *Store) SetFeeRecipient(
+	pubkey crypto.BLSPubkey,
+	feeRecipient common.ExecutionAddress,
+) error {
+	return s.update(pubkey, func(e *Entry) {
+		e.FeeRecipient = &feeRecipient
+	})
+}
+
+// DeleteFeeRecipient removes the fee recipient of the proposer with the
+// given public key, which falls back to the default one.
+func (s *Store) DeleteFeeRecipient(pubkey crypto.BLSPubkey) error {
+	return s.update(pubkey, func(e *Entry) {
+		e.FeeRecipient = nil
+	})
+}
+
+// SetGasLimit sets the gas limit of the proposer with the given public key.
+func (s *Store) SetGasLimit(pubkey crypto.BLSPubkey, gasLimit math.U64) error {
+	return s.update(pubkey, func(e *Entry) {
+		e.GasLimit = gasLimit.UnwrapPtr()
+	})
+}
+
+// DeleteGasLimit removes the gas limit of the proposer with the given
+// public key, which falls back to the default one.
+func (s *Store) DeleteGasLimit(pubkey crypto.BLSPubkey) error {
+	return s.update(pubkey, func(e *Entry) {
+		e.GasLimit = nil
+	})
+}
+
+// SetGraffiti sets the graffiti of the proposer with the given public key.
+func (s *Store) SetGraffiti(pubkey crypto.BLSPubkey, graffiti string) error {
+	return s.update(pubkey, func(e *Entry) {
+		e.Graffiti = &graffiti
+	})
+}
+
+// DeleteGraffiti removes the graffiti of the proposer with the given
+// public key, which falls back to the default one.
+func (s *Store) DeleteGraffiti(pubkey crypto.BLSPubkey) error {
+	return s.update(pubkey, func(e *Entry) {
+		e.Graffiti = nil
+	})
+}
+
+// update applies the given change to the entry of the proposer with the
+// given public key and writes the result to the file. Entries left empty
+// are removed.
+func (s *Store) update(pubkey crypto.BLSPubkey, change func(*Entry)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file := &File{
+		ProposerConfig: make(
+			map[crypto.BLSPubkey]*Entry, len(s.file.ProposerConfig)+1,
+		),
+		DefaultConfig: s.file.DefaultConfig,
+	}
+	for k, v := range s.file.ProposerConfig {
+		file.ProposerConfig[k] = v
+	}
+
+	entry := &Entry{}
+	if current, ok := file.ProposerConfig[pubkey]; ok {
+		*entry = *current
+	}
+	change(entry)
+	if err := entry.Validate(); err != nil {
+		return err
+	}
+	if entry.IsEmpty() {
+		delete(file.ProposerConfig, pubkey)
+	} else {
+		file.ProposerConfig[pubkey] = entry
+	}
+
+	if err := s.write(file); err != nil {
+		return err
+	}
+	s.file = file
+	return nil
+}
+
+// write atomically replaces the file with the given content, recording the
+// version written so that it is not reloaded.
+func (s *Store) write(file *File) error {
+	if s.path == "" {
+		return nil
+	}
+
+	bz, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(
+		filepath.Dir(s.path), filepath.Base(s.path)+".tmp-*",
+	)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(bz); err != nil {
+		return errors.Join(err, tmp.Close())
+	}
+	if err = tmp.Chmod(filePermissions); err != nil {
+		return errors.Join(err, tmp.Close())
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp.Name(), s.path); err != nil {
+		return err
+	}
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return err
+	}
+	s.modTime, s.size = info.ModTime(), info.Size()
+	return nil
+}
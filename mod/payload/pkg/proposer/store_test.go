@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proposer_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/proposer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+var defaults = proposer.Settings{
+	FeeRecipient: common.ExecutionAddress{0xde},
+	GasLimit:     30_000_000,
+	Graffiti:     "node",
+}
+
+func TestStore_Settings(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "proposer-config.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+  "proposer_config": {
+    "0x`+strings.Repeat("aa", 48)+`": {
+      "fee_recipient": "0x`+strings.Repeat("11", 20)+`",
+      "gas_limit": "36000000"
+    }
+  },
+  "default_config": {"graffiti": "pool"}
+}`), 0o600))
+
+	store, err := proposer.NewStore(path, defaults, noop.NewLogger())
+	require.NoError(t, err)
+
+	require.Equal(t, proposer.Settings{
+		FeeRecipient: common.ExecutionAddress{
+			0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+			0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+		},
+		GasLimit: 36_000_000,
+		Graffiti: "pool",
+	}, store.Settings(pubkey(0xaa)))
+	require.Equal(t, proposer.Settings{
+		FeeRecipient: defaults.FeeRecipient,
+		GasLimit:     defaults.GasLimit,
+		Graffiti:     "pool",
+	}, store.Settings(pubkey(0xbb)))
+}
+
+func TestStore_Update(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "proposer-config.json")
+	store, err := proposer.NewStore(path, defaults, noop.NewLogger())
+	require.NoError(t, err)
+	require.Equal(t, defaults, store.Settings(pubkey(0xaa)))
+
+	require.NoError(t, store.SetFeeRecipient(
+		pubkey(0xaa), common.ExecutionAddress{0x01},
+	))
+	require.NoError(t, store.SetGasLimit(pubkey(0xaa), 40_000_000))
+	require.NoError(t, store.SetGraffiti(pubkey(0xaa), "hello"))
+	require.ErrorIs(
+		t, store.SetGasLimit(pubkey(0xaa), 0), proposer.ErrInvalidGasLimit,
+	)
+	require.ErrorIs(
+		t, store.SetGraffiti(pubkey(0xaa), strings.Repeat("g", 33)),
+		proposer.ErrGraffitiTooLong,
+	)
+
+	// The changes are written to the file and not reloaded.
+	changed, err := store.Reload()
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	reopened, err := proposer.NewStore(path, defaults, noop.NewLogger())
+	require.NoError(t, err)
+	require.Equal(t, proposer.Settings{
+		FeeRecipient: common.ExecutionAddress{0x01},
+		GasLimit:     40_000_000,
+		Graffiti:     "hello",
+	}, reopened.Settings(pubkey(0xaa)))
+
+	require.NoError(t, store.DeleteFeeRecipient(pubkey(0xaa)))
+	require.NoError(t, store.DeleteGasLimit(pubkey(0xaa)))
+	require.NoError(t, store.DeleteGraffiti(pubkey(0xaa)))
+	require.Equal(t, defaults, store.Settings(pubkey(0xaa)))
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"proposer_config": {}}`, string(bz))
+}
+
+func TestStore_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "proposer-config.json")
+	store, err := proposer.NewStore(path, defaults, noop.NewLogger())
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(
+		path, []byte(`{"default_config": {"gas_limit": "50000000"}}`), 0o600,
+	))
+	changed, err := store.Reload()
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.EqualValues(t, 50_000_000, store.GasLimit(pubkey(0xaa)))
+
+	// An invalid file leaves the settings untouched.
+	require.NoError(t, os.WriteFile(
+		path, []byte(`{"default_config": {"gas_limit": "0"}}`), 0o600,
+	))
+	require.NoError(t, os.Chtimes(
+		path, time.Now(), time.Now().Add(time.Second),
+	))
+	_, err = store.Reload()
+	require.ErrorIs(t, err, proposer.ErrInvalidGasLimit)
+	require.EqualValues(t, 50_000_000, store.GasLimit(pubkey(0xaa)))
+
+	// A removed file holds no entries.
+	require.NoError(t, os.Remove(path))
+	changed, err = store.Reload()
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, defaults, store.Settings(pubkey(0xaa)))
+}
+
+func pubkey(b byte) crypto.BLSPubkey {
+	var pk crypto.BLSPubkey
+	for i := range pk {
+		pk[i] = b
+	}
+	return pk
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// CircuitBreaker cuts the relay off after too many consecutive faults, so
+// that proposals fall back to local payloads instead of waiting on a relay
+// that misbehaves. Once cut off, the relay is left alone for a number of
+// slots, after which it is given one more chance: a success closes the
+// breaker again, while a fault cuts it off for another round.
+type CircuitBreaker struct {
+	mu sync.Mutex
+	// maxFaults is the number of consecutive faults that trip the breaker.
+	maxFaults uint64
+	// fallbackSlots is the number of slots the breaker stays open for.
+	fallbackSlots math.Slot
+	// faults is the number of consecutive faults.
+	faults uint64
+	// open is true while the relay is cut off.
+	open bool
+	// reopenSlot is the slot at which an open breaker lets the next
+	// request through.
+	reopenSlot math.Slot
+}
+
+// NewCircuitBreaker creates a new circuit breaker that trips after
+// maxFaults consecutive faults and stays open for fallbackSlots slots.
+func NewCircuitBreaker(
+	maxFaults uint64,
+	fallbackSlots uint64,
+) *CircuitBreaker {
+	return &CircuitBreaker{
+		maxFaults:     max(maxFaults, 1),
+		fallbackSlots: math.Slot(fallbackSlots),
+	}
+}
+
+// Allow returns true if the relay may be asked for a payload at the given
+// slot.
+func (b *CircuitBreaker) Allow(slot math.Slot) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return !b.open || slot >= b.reopenSlot
+}
+
+// RecordSuccess records a successful exchange with the relay, which closes
+// the breaker.
+func (b *CircuitBreaker) RecordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.faults = 0
+	b.open = false
+}
+
+// RecordFault records a fault of the relay at the given slot, and trips
+// the breaker if it is one too many.
+func (b *CircuitBreaker) RecordFault(slot math.Slot) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.faults++
+	// A fault while half-open sends the relay straight back to the bench.
+	if b.open || b.faults >= b.maxFaults {
+		b.open = true
+		b.reopenSlot = slot + b.fallbackSlots
+	}
+}
+
+// IsOpen returns true if the relay is currently cut off.
+func (b *CircuitBreaker) IsOpen() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.open
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	b := relay.NewCircuitBreaker(2, 10)
+	require.True(t, b.Allow(1))
+
+	// A single fault is tolerated, and a success resets the count.
+	b.RecordFault(1)
+	require.False(t, b.IsOpen())
+	b.RecordSuccess()
+	b.RecordFault(2)
+	require.False(t, b.IsOpen())
+
+	// The second consecutive fault cuts the relay off for 10 slots.
+	b.RecordFault(3)
+	require.True(t, b.IsOpen())
+	require.False(t, b.Allow(4))
+	require.False(t, b.Allow(12))
+	require.True(t, b.Allow(13))
+
+	// A fault on the trial request cuts it off for another round.
+	b.RecordFault(13)
+	require.True(t, b.IsOpen())
+	require.False(t, b.Allow(22))
+	require.True(t, b.Allow(23))
+
+	// A success on the trial request closes the breaker.
+	b.RecordSuccess()
+	require.False(t, b.IsOpen())
+	require.True(t, b.Allow(23))
+}
@@ -0,0 +1,414 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// Builder outsources the execution payloads of the blocks proposed by this
+// node to a relay, following the builder API flow: the validator registers
+// with the relay, asks it for the header of its best payload, and signs a
+// blinded block built on that header in exchange for the payload itself.
+//
+// A payload from the relay is only used if it pays more than the local
+// one. Relay faults trip a circuit breaker that falls back to local
+// payloads for a while.
+type Builder struct {
+	// cfg holds the configuration of the relay.
+	cfg *Config
+	// chainSpec holds the chain specifications.
+	chainSpec common.ChainSpec
+	// logger is used for logging within the Builder.
+	logger log.Logger[any]
+	// client is the builder API client of the relay.
+	client *Client
+	// signer signs the registrations and blinded blocks of the validator.
+	signer crypto.BLSSigner
+	// breaker cuts the relay off when it misbehaves.
+	breaker *CircuitBreaker
+	// proposerConfig provides the fee recipient and gas limit registered
+	// with the relay.
+	proposerConfig ProposerConfig
+
+	// mu protects the registration state below.
+	mu sync.Mutex
+	// registered is true once the validator has registered with the relay.
+	registered bool
+	// registeredEpoch is the epoch of the last registration.
+	registeredEpoch math.Epoch
+	// registeredFeeRecipient is the fee recipient of the last registration.
+	registeredFeeRecipient common.ExecutionAddress
+	// registeredGasLimit is the gas limit of the last registration.
+	registeredGasLimit math.U64
+}
+
+// NewBuilder creates a new relay builder.
+func NewBuilder(
+	cfg *Config,
+	chainSpec common.ChainSpec,
+	logger log.Logger[any],
+	signer crypto.BLSSigner,
+	proposerConfig ProposerConfig,
+	opts ...Option,
+) (*Builder, error) {
+	client, err := NewClient(
+		cfg.URL, append([]Option{WithTimeout(cfg.Timeout)}, opts...)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Builder{
+		cfg:       cfg,
+		chainSpec: chainSpec,
+		logger:    logger,
+		client:    client,
+		signer:    signer,
+		breaker: NewCircuitBreaker(
+			cfg.MaxConsecutiveFaults, cfg.FallbackSlots,
+		),
+		proposerConfig: proposerConfig,
+	}, nil
+}
+
+// Enabled returns true if the relay is enabled.
+func (b *Builder) Enabled() bool {
+	return b.cfg.Enabled
+}
+
+// Breaker returns the circuit breaker of the relay.
+func (b *Builder) Breaker() *CircuitBreaker {
+	return b.breaker
+}
+
+// RetrievePayload asks the relay for a payload for the given block, which
+// must already carry its local payload, and returns it if it is worth more
+// than minValue. The block itself is left untouched.
+func (b *Builder) RetrievePayload(
+	ctx context.Context,
+	blk *types.BeaconBlock,
+	parentBlockHash common.ExecutionHash,
+	genesisValidatorsRoot common.Root,
+	minValue math.Wei,
+) (engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload], error) {
+	if !b.Enabled() {
+		return nil, ErrRelayDisabled
+	}
+
+	slot := blk.GetSlot()
+	if !b.breaker.Allow(slot) {
+		return nil, ErrCircuitOpen
+	}
+
+	envelope, err := b.retrievePayload(
+		ctx, blk, parentBlockHash, genesisValidatorsRoot, minValue,
+	)
+	switch {
+	case err == nil, errors.Is(err, ErrNoBid), errors.Is(err, ErrBidTooLow):
+		b.breaker.RecordSuccess()
+	case errors.Is(err, ErrUnsupportedBlock):
+		// Not the fault of the relay.
+	default:
+		b.breaker.RecordFault(slot)
+		if b.breaker.IsOpen() {
+			b.logger.Warn(
+				"Relay cut off, falling back to local payloads 🚧",
+				"slot", slot.Base10(),
+				"until", (slot + math.Slot(b.cfg.FallbackSlots)).Base10(),
+				"error", err,
+			)
+		}
+	}
+	return envelope, err
+}
+
+// retrievePayload runs the builder API flow for the given block.
+func (b *Builder) retrievePayload(
+	ctx context.Context,
+	blk *types.BeaconBlock,
+	parentBlockHash common.ExecutionHash,
+	genesisValidatorsRoot common.Root,
+	minValue math.Wei,
+) (engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload], error) {
+	deneb, ok := blk.RawBeaconBlock.(*types.BeaconBlockDeneb)
+	if !ok || deneb.Body == nil || deneb.Body.ExecutionPayload == nil {
+		return nil, ErrUnsupportedBlock
+	}
+
+	if err := b.registerValidator(ctx, deneb.GetSlot()); err != nil {
+		return nil, err
+	}
+
+	bid, err := b.client.GetHeader(
+		ctx, deneb.GetSlot(), parentBlockHash, b.signer.PublicKey(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = b.verifyBid(
+		bid, deneb.Body.ExecutionPayload, parentBlockHash,
+	); err != nil {
+		return nil, err
+	}
+
+	value := bid.Message.Value.UnwrapBig()
+	if value.Cmp(minValue.UnwrapBig()) <= 0 {
+		return nil, errors.Wrapf(
+			ErrBidTooLow, "bid %s, local %s", value, minValue.UnwrapBig(),
+		)
+	}
+
+	signedBlk, err := b.signBlindedBlock(
+		types.NewBlindedBeaconBlockDeneb(
+			deneb, bid.Message.Header, bid.Message.BlobKzgCommitments,
+		),
+		genesisValidatorsRoot,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	revealed, err := b.client.GetPayload(ctx, signedBlk)
+	if err != nil {
+		return nil, err
+	}
+	if err = verifyPayload(revealed, signedBlk.Message); err != nil {
+		return nil, err
+	}
+
+	b.logger.Info(
+		"Received payload from relay 🏗️",
+		"slot", deneb.GetSlot().Base10(),
+		"block_hash", revealed.ExecutionPayload.GetBlockHash(),
+		"value", value,
+	)
+	return &engineprimitives.ExecutionPayloadEnvelope[
+		*types.ExecutionPayload,
+		*engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		],
+	]{
+		ExecutionPayload: &types.ExecutionPayload{
+			InnerExecutionPayload: revealed.ExecutionPayload,
+		},
+		BlockValue:  bid.Message.Value,
+		BlobsBundle: revealed.BlobsBundle,
+	}, nil
+}
+
+// registerValidator registers the validator with the relay, once per
+// epoch and whenever its fee recipient or gas limit changes.
+func (b *Builder) registerValidator(
+	ctx context.Context,
+	slot math.Slot,
+) error {
+	var (
+		epoch        = b.chainSpec.SlotToEpoch(slot)
+		pubkey       = b.signer.PublicKey()
+		feeRecipient = b.proposerConfig.FeeRecipient(pubkey)
+		gasLimit     = b.proposerConfig.GasLimit(pubkey)
+	)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.registered && b.registeredEpoch == epoch &&
+		b.registeredFeeRecipient == feeRecipient &&
+		b.registeredGasLimit == gasLimit {
+		return nil
+	}
+
+	registration, err := types.CreateAndSignValidatorRegistration(
+		b.builderForkData(),
+		b.chainSpec.DomainTypeApplicationMask(),
+		b.signer,
+		feeRecipient,
+		gasLimit,
+		//#nosec:G701 // the unix time is positive.
+		math.U64(time.Now().Unix()),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err = b.client.RegisterValidator(ctx, registration); err != nil {
+		return err
+	}
+	b.registered = true
+	b.registeredEpoch = epoch
+	b.registeredFeeRecipient = feeRecipient
+	b.registeredGasLimit = gasLimit
+	return nil
+}
+
+// verifyBid checks that the bid is signed by its builder and fits the
+// block being built, i.e. that it extends the same execution block with the
+// same randao and withdrawals as the local payload.
+func (b *Builder) verifyBid(
+	bid *types.SignedBuilderBid,
+	local *types.ExecutableDataDeneb,
+	parentBlockHash common.ExecutionHash,
+) error {
+	if err := bid.VerifySignature(
+		b.builderForkData(),
+		b.chainSpec.DomainTypeApplicationMask(),
+		b.signer.VerifySignature,
+	); err != nil {
+		return errors.Join(err, ErrInvalidBid)
+	}
+
+	header := bid.Message.Header
+	withdrawalsRoot, err := engineprimitives.Withdrawals(
+		local.GetWithdrawals(),
+	).HashTreeRoot()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case header.GetParentHash() != parentBlockHash:
+		return errors.Wrapf(
+			ErrInvalidBid, "parent hash %s, expected %s",
+			header.GetParentHash(), parentBlockHash,
+		)
+	case header.GetPrevRandao() != local.GetPrevRandao():
+		return errors.Wrap(ErrInvalidBid, "prev randao mismatch")
+	case header.GetWithdrawalsRoot() != withdrawalsRoot:
+		return errors.Wrap(ErrInvalidBid, "withdrawals mismatch")
+	case uint64(len(bid.Message.BlobKzgCommitments)) >
+		b.chainSpec.MaxBlobCommitmentsPerBlock():
+		return errors.Wrap(ErrInvalidBid, "too many blob commitments")
+	}
+	return nil
+}
+
+// signBlindedBlock signs the blinded block as its proposer.
+//
+// NOTE: the state root of the blinded block is the one of the local block,
+// since it can only be computed once the payload is known. Proposals are
+// not signed at the consensus layer, so the signature only commits the
+// proposer to the header of the relay for the slot.
+func (b *Builder) signBlindedBlock(
+	blk *types.BlindedBeaconBlockDeneb,
+	genesisValidatorsRoot common.Root,
+) (*types.SignedBlindedBeaconBlockDeneb, error) {
+	forkVersion := version.FromUint32[common.Version](
+		b.chainSpec.ActiveForkVersionForSlot(blk.GetSlot()),
+	)
+	domain, err := types.NewForkData(
+		forkVersion, genesisValidatorsRoot,
+	).ComputeDomain(b.chainSpec.DomainTypeProposer())
+	if err != nil {
+		return nil, err
+	}
+
+	signingRoot, err := ssz.ComputeSigningRoot(blk, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRoot, err := blk.Body.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := crypto.SignRequest(b.signer, &crypto.SigningRequest{
+		Type:        crypto.SigningTypeBlock,
+		SigningRoot: signingRoot,
+		ForkInfo: crypto.NewForkInfo(
+			forkVersion,
+			b.chainSpec.SlotToEpoch(blk.GetSlot()).Unwrap(),
+			genesisValidatorsRoot,
+		),
+		Block: &crypto.BlockHeaderData{
+			Slot:          blk.Slot,
+			ProposerIndex: blk.ProposerIndex,
+			ParentRoot:    blk.ParentBlockRoot,
+			StateRoot:     blk.StateRoot,
+			BodyRoot:      bodyRoot,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.SignedBlindedBeaconBlockDeneb{
+		Message:   blk,
+		Signature: signature,
+	}, nil
+}
+
+// builderForkData returns the fork data of the builder domain, which is
+// independent of the chain the relay serves.
+func (b *Builder) builderForkData() *types.ForkData {
+	return types.NewForkData(
+		version.FromUint32[common.Version](
+			b.chainSpec.ActiveForkVersionForSlot(0),
+		), common.Root{},
+	)
+}
+
+// verifyPayload checks that the payload and blobs revealed by the relay
+// match the header and commitments of the blinded block.
+func verifyPayload(
+	revealed *types.ExecutionPayloadAndBlobsBundle,
+	blk *types.BlindedBeaconBlockDeneb,
+) error {
+	if _, err := blk.Unblind(revealed.ExecutionPayload); err != nil {
+		return errors.Join(err, ErrInvalidPayload)
+	}
+
+	commitments := blk.Body.GetBlobKzgCommitments()
+	bundle := revealed.BlobsBundle
+	if bundle == nil {
+		if len(commitments) != 0 {
+			return errors.Wrap(ErrInvalidPayload, "missing blobs bundle")
+		}
+		revealed.BlobsBundle = &engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		]{}
+		return nil
+	}
+
+	if len(bundle.GetCommitments()) != len(commitments) ||
+		len(bundle.GetProofs()) != len(commitments) ||
+		len(bundle.GetBlobs()) != len(commitments) {
+		return errors.Wrap(ErrInvalidPayload, "blobs bundle length mismatch")
+	}
+	for i, commitment := range bundle.GetCommitments() {
+		if commitment != commitments[i] {
+			return errors.Wrap(ErrInvalidPayload, "blob commitment mismatch")
+		}
+	}
+	return nil
+}
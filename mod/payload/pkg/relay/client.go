@@ -0,0 +1,238 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// StatusPath is the path of the status endpoint of the builder API.
+	StatusPath = "/eth/v1/builder/status"
+	// RegisterValidatorPath is the path of the validator registration
+	// endpoint of the builder API.
+	RegisterValidatorPath = "/eth/v1/builder/validators"
+	// GetHeaderPath is the path of the header endpoint of the builder API,
+	// followed by the slot, parent hash and proposer pubkey.
+	GetHeaderPath = "/eth/v1/builder/header"
+	// GetPayloadPath is the path of the blinded block endpoint of the
+	// builder API.
+	GetPayloadPath = "/eth/v1/builder/blinded_blocks"
+
+	// denebVersion is the version string of the Deneb fork in builder API
+	// responses.
+	denebVersion = "deneb"
+	// maxErrorBodySize bounds the part of an error response that is read
+	// into the returned error.
+	maxErrorBodySize = 1024
+)
+
+// VersionedResponse is the fork-versioned envelope of builder API
+// responses.
+type VersionedResponse[T any] struct {
+	// Version is the name of the fork of the data.
+	Version string `json:"version"`
+	// Data is the content of the response.
+	Data T `json:"data"`
+}
+
+// Client is a client of the builder API of a relay, as defined in
+// https://github.com/ethereum/builder-specs.
+type Client struct {
+	// url is the base URL of the relay.
+	url string
+	// httpClient is the HTTP client requests are sent with.
+	httpClient *http.Client
+	// timeout bounds each request to the relay.
+	timeout time.Duration
+}
+
+// NewClient creates a new client of the relay at the given URL.
+func NewClient(rawURL string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Client{
+		url:        strings.TrimSuffix(u.String(), "/"),
+		httpClient: http.DefaultClient,
+		timeout:    defaultTimeout,
+	}
+	for _, opt := range opts {
+		if err = opt(c); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// Status checks that the relay is up.
+func (c *Client) Status(ctx context.Context) error {
+	_, err := c.do(ctx, http.MethodGet, StatusPath, nil, http.StatusOK)
+	return err
+}
+
+// RegisterValidator registers the given validators with the relay.
+func (c *Client) RegisterValidator(
+	ctx context.Context,
+	registrations ...*types.SignedValidatorRegistration,
+) error {
+	_, err := c.do(
+		ctx, http.MethodPost, RegisterValidatorPath,
+		registrations, http.StatusOK,
+	)
+	return err
+}
+
+// GetHeader asks the relay for its best bid for the given slot, on top of
+// the given parent execution block, for the given proposer. It returns
+// ErrNoBid if the relay has none.
+func (c *Client) GetHeader(
+	ctx context.Context,
+	slot math.Slot,
+	parentHash common.ExecutionHash,
+	pubkey crypto.BLSPubkey,
+) (*types.SignedBuilderBid, error) {
+	path := strings.Join([]string{
+		GetHeaderPath, slot.Base10(), parentHash.Hex(), pubkey.String(),
+	}, "/")
+
+	body, err := c.do(
+		ctx, http.MethodGet, path, nil,
+		http.StatusOK, http.StatusNoContent,
+	)
+	if err != nil {
+		return nil, err
+	} else if len(body) == 0 {
+		return nil, ErrNoBid
+	}
+
+	var resp VersionedResponse[*types.SignedBuilderBid]
+	if err = decode(body, &resp); err != nil {
+		return nil, err
+	} else if resp.Data == nil || resp.Data.Message == nil {
+		return nil, ErrNoBid
+	}
+	return resp.Data, nil
+}
+
+// GetPayload hands the signed blinded block over to the relay, which
+// reveals the execution payload and blobs of its bid in exchange.
+func (c *Client) GetPayload(
+	ctx context.Context,
+	blk *types.SignedBlindedBeaconBlockDeneb,
+) (*types.ExecutionPayloadAndBlobsBundle, error) {
+	body, err := c.do(
+		ctx, http.MethodPost, GetPayloadPath, blk, http.StatusOK,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp VersionedResponse[*types.ExecutionPayloadAndBlobsBundle]
+	if err = decode(body, &resp); err != nil {
+		return nil, err
+	} else if resp.Data == nil || resp.Data.ExecutionPayload == nil {
+		return nil, ErrInvalidPayload
+	}
+	return resp.Data, nil
+}
+
+// do sends a request to the relay and returns the body of the response if
+// its status is one of the expected ones.
+func (c *Client) do(
+	ctx context.Context,
+	method string,
+	path string,
+	payload any,
+	expected ...int,
+) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var reqBody io.Reader
+	if payload != nil {
+		bz, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewReader(bz)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	for _, status := range expected {
+		if resp.StatusCode == status {
+			return body, nil
+		}
+	}
+
+	if len(body) > maxErrorBodySize {
+		body = body[:maxErrorBodySize]
+	}
+	return nil, errors.Wrapf(
+		ErrUnexpectedStatus, "%s %s: %d %s",
+		method, path, resp.StatusCode, strings.TrimSpace(string(body)),
+	)
+}
+
+// decode decodes a versioned builder API response, rejecting forks other
+// than Deneb.
+func decode[T any](body []byte, resp *VersionedResponse[T]) error {
+	if err := json.Unmarshal(body, resp); err != nil {
+		return err
+	}
+	if resp.Version != denebVersion {
+		return errors.Wrapf(ErrUnsupportedVersion, "%q", resp.Version)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(
+	t *testing.T,
+	handler http.HandlerFunc,
+	opts ...relay.Option,
+) *relay.Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	c, err := relay.NewClient(srv.URL, opts...)
+	require.NoError(t, err)
+	return c
+}
+
+func TestClient_GetHeader(t *testing.T) {
+	bid := &types.SignedBuilderBid{
+		Message: &types.BuilderBid{
+			Header: &types.ExecutionPayloadHeaderDeneb{
+				ParentHash: common.ExecutionHash{0x01},
+				LogsBloom:  make([]byte, 256),
+				ExtraData:  []byte{},
+			},
+			Pubkey: crypto.BLSPubkey{0x02},
+		},
+	}
+
+	var path string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		require.NoError(t, json.NewEncoder(w).Encode(
+			relay.VersionedResponse[*types.SignedBuilderBid]{
+				Version: "deneb",
+				Data:    bid,
+			},
+		))
+	})
+
+	got, err := c.GetHeader(
+		context.Background(), 5, common.ExecutionHash{0x01},
+		crypto.BLSPubkey{0x03},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, bid.Message.Header.ParentHash, got.Message.Header.ParentHash,
+	)
+	require.Equal(t, bid.Message.Pubkey, got.Message.Pubkey)
+	require.Equal(
+		t,
+		relay.GetHeaderPath+"/5/"+common.ExecutionHash{0x01}.Hex()+"/"+
+			crypto.BLSPubkey{0x03}.String(),
+		path,
+	)
+}
+
+func TestClient_GetHeaderNoBid(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	_, err := c.GetHeader(
+		context.Background(), 1, common.ExecutionHash{}, crypto.BLSPubkey{},
+	)
+	require.ErrorIs(t, err, relay.ErrNoBid)
+}
+
+func TestClient_Errors(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case relay.StatusPath:
+			http.Error(w, "down", http.StatusServiceUnavailable)
+		default:
+			_, _ = w.Write([]byte(`{"version":"capella","data":{}}`))
+		}
+	})
+
+	require.ErrorIs(
+		t, c.Status(context.Background()), relay.ErrUnexpectedStatus,
+	)
+	_, err := c.GetPayload(
+		context.Background(), &types.SignedBlindedBeaconBlockDeneb{},
+	)
+	require.ErrorIs(t, err, relay.ErrUnsupportedVersion)
+}
+
+func TestClient_Timeout(t *testing.T) {
+	c := newTestClient(t, func(_ http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}, relay.WithTimeout(10*time.Millisecond))
+	require.ErrorIs(
+		t, c.Status(context.Background()), context.DeadlineExceeded,
+	)
+}
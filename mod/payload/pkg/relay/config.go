@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "time"
+
+const (
+	// defaultURL is the default URL of the relay, which is the default
+	// address of mev-boost.
+	defaultURL = "http://localhost:18550"
+	// defaultTimeout is the default timeout of requests to the relay.
+	defaultTimeout = 1 * time.Second
+	// defaultGasLimit is the default gas limit registered with the relay.
+	defaultGasLimit = 30_000_000
+	// defaultMaxConsecutiveFaults is the default number of consecutive
+	// faults after which the relay is no longer asked for payloads.
+	defaultMaxConsecutiveFaults = 3
+	// defaultFallbackSlots is the default number of slots the relay is
+	// left alone for once it has been cut off.
+	defaultFallbackSlots = 32
+)
+
+// Config is the configuration for the external block builder.
+//
+//nolint:lll // struct tags.
+type Config struct {
+	// Enabled determines if payloads are requested from the relay.
+	Enabled bool `mapstructure:"enabled"`
+	// URL is the URL of the builder API of the relay.
+	URL string `mapstructure:"url"`
+	// Timeout is the timeout of requests to the relay. It must leave enough
+	// time to fall back to the local payload within timeout_propose.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// GasLimit is the gas limit the relay is asked to build blocks with,
+	// unless the proposer config sets one.
+	GasLimit uint64 `mapstructure:"gas-limit"`
+	// MaxConsecutiveFaults is the number of consecutive faults of the relay
+	// after which it is no longer asked for payloads.
+	MaxConsecutiveFaults uint64 `mapstructure:"max-consecutive-faults"`
+	// FallbackSlots is the number of slots the relay is left alone for,
+	// and local payloads are used, once it has been cut off.
+	FallbackSlots uint64 `mapstructure:"fallback-slots"`
+}
+
+// DefaultConfig returns the default relay configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:              false,
+		URL:                  defaultURL,
+		Timeout:              defaultTimeout,
+		GasLimit:             defaultGasLimit,
+		MaxConsecutiveFaults: defaultMaxConsecutiveFaults,
+		FallbackSlots:        defaultFallbackSlots,
+	}
+}
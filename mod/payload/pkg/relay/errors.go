@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrRelayDisabled is returned when a payload is requested while the
+	// relay is disabled.
+	ErrRelayDisabled = errors.New("relay is disabled")
+
+	// ErrCircuitOpen is returned when the relay has been cut off after too
+	// many faults and is not asked for a payload.
+	ErrCircuitOpen = errors.New("relay circuit breaker is open")
+
+	// ErrNoBid is returned when the relay has no bid for the slot.
+	ErrNoBid = errors.New("relay has no bid")
+
+	// ErrBidTooLow is returned when the bid of the relay is not worth more
+	// than the local payload.
+	ErrBidTooLow = errors.New("relay bid does not beat local payload")
+
+	// ErrUnexpectedStatus is returned when the relay answers with an
+	// unexpected HTTP status.
+	ErrUnexpectedStatus = errors.New("unexpected relay response status")
+
+	// ErrUnsupportedVersion is returned when the relay answers with a fork
+	// version that is not supported.
+	ErrUnsupportedVersion = errors.New("unsupported relay response version")
+
+	// ErrInvalidBid is returned when the bid of the relay does not fit the
+	// block being built.
+	ErrInvalidBid = errors.New("invalid relay bid")
+
+	// ErrInvalidPayload is returned when the payload revealed by the relay
+	// does not match its bid.
+	ErrInvalidPayload = errors.New("invalid relay payload")
+
+	// ErrUnsupportedBlock is returned when the block being built is of a
+	// fork that cannot be blinded.
+	ErrUnsupportedBlock = errors.New("unsupported block for relay")
+)
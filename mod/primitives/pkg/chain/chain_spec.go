@@ -85,12 +85,32 @@ type Spec[
 	Eth1FollowDistance() uint64
 	// TargetSecondsPerEth1Block returns the target time between eth1 blocks.
 	TargetSecondsPerEth1Block() uint64
+	// MaxPayloadTimestampDrift returns the maximum number of seconds the
+	// timestamp of an execution payload may deviate from the time of its
+	// slot.
+	MaxPayloadTimestampDrift() uint64
 
 	// Fork-related values.
 	//
 	// ElectraForkEpoch returns the epoch at which the Electra fork takes
 	// effect.
 	ElectraForkEpoch() EpochT
+	// TxEnvelopeForkEpoch returns the epoch from which the transactions of
+	// proposals are envelopes.
+	TxEnvelopeForkEpoch() EpochT
+	// BlobSidecarsRootForkEpoch returns the epoch from which proposals carry
+	// the root of their blob sidecars instead of the sidecars.
+	BlobSidecarsRootForkEpoch() EpochT
+	// DepositProofForkEpoch returns the epoch from which blocks vote for
+	// the deposit tree and carry the inclusion proofs of their deposits.
+	DepositProofForkEpoch() EpochT
+	// PayloadTimestampForkEpoch returns the epoch from which the timestamps
+	// of execution payloads must stay close to the time of their slot.
+	PayloadTimestampForkEpoch() EpochT
+	// StakingFixForkEpoch returns the epoch from which deposits credit
+	// exactly their amount and full withdrawal sweeps resume after the
+	// validator of their last withdrawal.
+	StakingFixForkEpoch() EpochT
 
 	// State list lengths
 	//
@@ -330,6 +350,14 @@ func (c chainSpec[
 	return c.Data.TargetSecondsPerEth1Block
 }
 
+// MaxPayloadTimestampDrift returns the maximum number of seconds the
+// timestamp of an execution payload may deviate from the time of its slot.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxPayloadTimestampDrift() uint64 {
+	return c.Data.MaxPayloadTimestampDrift
+}
+
 // ElectraForkEpoch returns the epoch of the Electra fork.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -337,6 +365,47 @@ func (c chainSpec[
 	return c.Data.ElectraForkEpoch
 }
 
+// TxEnvelopeForkEpoch returns the epoch from which the transactions of
+// proposals are envelopes.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) TxEnvelopeForkEpoch() EpochT {
+	return c.Data.TxEnvelopeForkEpoch
+}
+
+// BlobSidecarsRootForkEpoch returns the epoch from which proposals carry the
+// root of their blob sidecars.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) BlobSidecarsRootForkEpoch() EpochT {
+	return c.Data.BlobSidecarsRootForkEpoch
+}
+
+// DepositProofForkEpoch returns the epoch from which blocks vote for the
+// deposit tree and carry the inclusion proofs of their deposits.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) DepositProofForkEpoch() EpochT {
+	return c.Data.DepositProofForkEpoch
+}
+
+// PayloadTimestampForkEpoch returns the epoch from which the timestamps of
+// execution payloads must stay close to the time of their slot.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) PayloadTimestampForkEpoch() EpochT {
+	return c.Data.PayloadTimestampForkEpoch
+}
+
+// StakingFixForkEpoch returns the epoch from which deposits credit exactly
+// their amount and full withdrawal sweeps resume after the validator of
+// their last withdrawal.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) StakingFixForkEpoch() EpochT {
+	return c.Data.StakingFixForkEpoch
+}
+
 // EpochsPerHistoricalVector returns the number of epochs per historical vector.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
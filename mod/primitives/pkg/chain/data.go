@@ -88,11 +88,34 @@ type SpecData[
 	Eth1FollowDistance uint64 `mapstructure:"eth1-follow-distance"`
 	// TargetSecondsPerEth1Block is the target time between eth1 blocks.
 	TargetSecondsPerEth1Block uint64 `mapstructure:"target-seconds-per-eth1-block"`
+	// MaxPayloadTimestampDrift is the maximum number of seconds the timestamp
+	// of an execution payload may deviate from the time of its slot.
+	MaxPayloadTimestampDrift uint64 `mapstructure:"max-payload-timestamp-drift"`
 
 	// Fork-related values.
 	//
 	// ElectraForkEpoch is the epoch at which the Electra fork is activated.
 	ElectraForkEpoch EpochT `mapstructure:"electra-fork-epoch"`
+	// TxEnvelopeForkEpoch is the epoch from which the transactions of
+	// proposals are envelopes tagged with their type and fork version,
+	// rather than typed by their position.
+	TxEnvelopeForkEpoch EpochT `mapstructure:"tx-envelope-fork-epoch"`
+	// BlobSidecarsRootForkEpoch is the epoch from which proposals carry the
+	// root of their blob sidecars, which are gossiped outside of CometBFT,
+	// instead of the sidecars themselves. Roots are only carried in
+	// envelopes, so not before TxEnvelopeForkEpoch either.
+	BlobSidecarsRootForkEpoch EpochT `mapstructure:"blob-sidecars-root-fork-epoch"`
+	// DepositProofForkEpoch is the epoch from which blocks vote for the
+	// deposit tree in their eth1 data and carry the inclusion proofs of
+	// their deposits, which are verified against it.
+	DepositProofForkEpoch EpochT `mapstructure:"deposit-proof-fork-epoch"`
+	// PayloadTimestampForkEpoch is the epoch from which the timestamps of
+	// execution payloads must stay close to the time of their slot.
+	PayloadTimestampForkEpoch EpochT `mapstructure:"payload-timestamp-fork-epoch"`
+	// StakingFixForkEpoch is the epoch from which deposits credit exactly
+	// their amount to the balance of their validator and a full withdrawal
+	// sweep resumes after the validator of its last withdrawal.
+	StakingFixForkEpoch EpochT `mapstructure:"staking-fix-fork-epoch"`
 
 	// State list lengths
 	//
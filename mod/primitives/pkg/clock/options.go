@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package clock
+
+import "time"
+
+// TimeSource is the source of the current time of a SlotClock.
+type TimeSource interface {
+	// Now returns the current time.
+	Now() time.Time
+}
+
+// systemTime is the TimeSource backed by the system clock.
+type systemTime struct{}
+
+// Now returns the current system time.
+func (systemTime) Now() time.Time {
+	return time.Now()
+}
+
+// Option is a functional option for the SlotClock.
+type Option func(*SlotClock) error
+
+// WithTimeSource sets the source the SlotClock reads the current time from.
+// It defaults to the system clock and is mostly useful in tests.
+func WithTimeSource(src TimeSource) Option {
+	return func(c *SlotClock) error {
+		c.src = src
+		return nil
+	}
+}
+
+// WithGenesisTime sets the genesis time of the SlotClock.
+func WithGenesisTime(genesisTime time.Time) Option {
+	return func(c *SlotClock) error {
+		c.genesisTime = genesisTime
+		return nil
+	}
+}
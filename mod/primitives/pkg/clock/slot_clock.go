@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package clock
+
+import (
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// SlotClock maps slots to wall clock time. Slots are anchored to the
+// CometBFT time of the latest block seen by the node and are assumed to be
+// one slot duration apart from there on. Before any block is seen, slots
+// are counted from the genesis time instead.
+//
+// Since the CometBFT block time is agreed upon by consensus, so is the time
+// of the slot of that block, which makes it safe to validate execution
+// payload timestamps against it.
+type SlotClock struct {
+	// src is the source of the current time.
+	src TimeSource
+	// slotDuration is the target duration of a slot.
+	slotDuration time.Duration
+	// maxDrift is the maximum distance between the timestamp of a payload
+	// and the time of its slot. A zero maxDrift disables the check.
+	maxDrift time.Duration
+
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// genesisTime is the time of the genesis of the chain.
+	genesisTime time.Time
+	// anchorSlot and anchorTime are the slot and CometBFT time of the latest
+	// block seen by the node.
+	anchorSlot math.Slot
+	anchorTime time.Time
+}
+
+// NewSlotClock returns a new SlotClock.
+func NewSlotClock(
+	slotDuration time.Duration,
+	maxDrift time.Duration,
+	opts ...Option,
+) (*SlotClock, error) {
+	c := &SlotClock{
+		src:          systemTime{},
+		slotDuration: slotDuration,
+		maxDrift:     maxDrift,
+	}
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// Now returns the current time.
+func (c *SlotClock) Now() time.Time {
+	return c.src.Now()
+}
+
+// GenesisTime returns the genesis time of the chain.
+func (c *SlotClock) GenesisTime() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.genesisTime
+}
+
+// SetGenesisTime sets the genesis time of the chain.
+func (c *SlotClock) SetGenesisTime(genesisTime time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.genesisTime = genesisTime
+}
+
+// OnBlock anchors the clock to the CometBFT time of the block at the given
+// slot. Zero block times are ignored.
+func (c *SlotClock) OnBlock(slot math.Slot, blockTime time.Time) {
+	if blockTime.IsZero() {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.anchorSlot = slot
+	c.anchorTime = blockTime
+}
+
+// SlotTime returns the time of the given slot.
+func (c *SlotClock) SlotTime(slot math.Slot) time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.anchorTime.IsZero() {
+		//#nosec:G701 // slots will never overflow an int64.
+		return c.genesisTime.Add(time.Duration(slot) * c.slotDuration)
+	}
+	//#nosec:G701 // slots will never overflow an int64.
+	delta := int64(slot) - int64(c.anchorSlot)
+	return c.anchorTime.Add(time.Duration(delta) * c.slotDuration)
+}
+
+// CurrentSlot returns the slot the current time falls into.
+func (c *SlotClock) CurrentSlot() math.Slot {
+	now := c.src.Now()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	start, slot := c.genesisTime, math.Slot(0)
+	if !c.anchorTime.IsZero() {
+		start, slot = c.anchorTime, c.anchorSlot
+	}
+	if c.slotDuration <= 0 || !now.After(start) {
+		return slot
+	}
+	//#nosec:G701 // the elapsed number of slots is never negative.
+	return slot + math.Slot(now.Sub(start)/c.slotDuration)
+}
+
+// PayloadTimestamp returns the timestamp to build the execution payload of
+// the given slot with, which is the time of the slot unless that would not
+// be after the timestamp of the parent payload. Since payload timestamps
+// are in seconds, they run ahead of the slot time while blocks are less
+// than a second apart, which ValidatePayloadTimestamp allows only for
+// timestamps one second after their parent.
+func (c *SlotClock) PayloadTimestamp(
+	slot math.Slot,
+	parentTimestamp uint64,
+) uint64 {
+	//#nosec:G701 // slot times are never before the unix epoch.
+	return max(uint64(c.SlotTime(slot).Unix()), parentTimestamp+1)
+}
+
+// ValidatePayloadTimestamp checks that the timestamp of the execution
+// payload of the given slot is after the timestamp of its parent and within
+// the allowed drift from the time of the slot. A timestamp one second after
+// its parent is never too far ahead, as it is the earliest one the payload
+// can have.
+func (c *SlotClock) ValidatePayloadTimestamp(
+	slot math.Slot,
+	timestamp uint64,
+	parentTimestamp uint64,
+) error {
+	if timestamp <= parentTimestamp {
+		return errors.Wrapf(
+			ErrTimestampNotAfterParent,
+			"timestamp: %d, parent timestamp: %d",
+			timestamp, parentTimestamp,
+		)
+	}
+
+	if c.maxDrift == 0 {
+		return nil
+	}
+
+	slotTime := c.SlotTime(slot)
+	//#nosec:G701 // timestamps will never overflow an int64.
+	drift := time.Unix(int64(timestamp), 0).Sub(slotTime)
+	if drift < -c.maxDrift ||
+		(drift > c.maxDrift && timestamp != parentTimestamp+1) {
+		return errors.Wrapf(
+			ErrTimestampDrift,
+			"slot: %d, timestamp: %d, slot time: %d, max drift: %s",
+			slot, timestamp, slotTime.Unix(), c.maxDrift,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package clock_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/clock"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	slotDuration = 3 * time.Second
+	maxDrift     = 12 * time.Second
+)
+
+var genesisTime = time.Unix(1_700_000_000, 0)
+
+// fixedTime is a TimeSource that always returns the same time.
+type fixedTime struct {
+	now time.Time
+}
+
+func (f *fixedTime) Now() time.Time {
+	return f.now
+}
+
+func newSlotClock(t *testing.T, now time.Time) *clock.SlotClock {
+	t.Helper()
+	c, err := clock.NewSlotClock(
+		slotDuration, maxDrift,
+		clock.WithGenesisTime(genesisTime),
+		clock.WithTimeSource(&fixedTime{now: now}),
+	)
+	require.NoError(t, err)
+	return c
+}
+
+func TestSlotClock_SlotTime(t *testing.T) {
+	c := newSlotClock(t, genesisTime)
+
+	// Without an anchor, slots are counted from genesis.
+	require.Equal(t, genesisTime, c.SlotTime(0))
+	require.Equal(t, genesisTime.Add(30*time.Second), c.SlotTime(10))
+
+	// Once a block is seen, slots are counted from its time.
+	anchor := genesisTime.Add(time.Hour)
+	c.OnBlock(10, anchor)
+	require.Equal(t, anchor, c.SlotTime(10))
+	require.Equal(t, anchor.Add(slotDuration), c.SlotTime(11))
+	require.Equal(t, anchor.Add(-2*slotDuration), c.SlotTime(8))
+
+	// Zero block times do not move the anchor.
+	c.OnBlock(20, time.Time{})
+	require.Equal(t, anchor, c.SlotTime(10))
+}
+
+func TestSlotClock_CurrentSlot(t *testing.T) {
+	c := newSlotClock(t, genesisTime.Add(10*time.Second))
+	require.Equal(t, math.Slot(3), c.CurrentSlot())
+
+	c = newSlotClock(t, genesisTime.Add(-time.Second))
+	require.Equal(t, math.Slot(0), c.CurrentSlot())
+
+	c = newSlotClock(t, genesisTime.Add(time.Minute))
+	c.OnBlock(100, genesisTime.Add(50*time.Second))
+	require.Equal(t, math.Slot(103), c.CurrentSlot())
+}
+
+func TestSlotClock_PayloadTimestamp(t *testing.T) {
+	c := newSlotClock(t, genesisTime)
+	c.OnBlock(5, genesisTime)
+
+	slotTime := uint64(genesisTime.Unix()) + 3
+	require.Equal(t, slotTime, c.PayloadTimestamp(6, slotTime-10))
+
+	// The timestamp is always after the parent one.
+	require.Equal(t, slotTime+10, c.PayloadTimestamp(6, slotTime+9))
+}
+
+func TestSlotClock_ValidatePayloadTimestamp(t *testing.T) {
+	c := newSlotClock(t, genesisTime)
+	c.OnBlock(5, genesisTime)
+	slotTime := uint64(genesisTime.Unix())
+
+	tests := []struct {
+		name      string
+		timestamp uint64
+		parent    uint64
+		expErr    error
+	}{
+		{
+			name:      "at slot time",
+			timestamp: slotTime,
+			parent:    slotTime - 3,
+		},
+		{
+			name:      "within drift",
+			timestamp: slotTime + 12,
+			parent:    slotTime - 3,
+		},
+		{
+			name:      "ahead of slot time",
+			timestamp: slotTime + 13,
+			parent:    slotTime - 3,
+			expErr:    clock.ErrTimestampDrift,
+		},
+		{
+			name:      "ahead of slot time after parent",
+			timestamp: slotTime + 13,
+			parent:    slotTime + 12,
+		},
+		{
+			name:      "behind slot time",
+			timestamp: slotTime - 13,
+			parent:    slotTime - 20,
+			expErr:    clock.ErrTimestampDrift,
+		},
+		{
+			name:      "equal to parent",
+			timestamp: slotTime,
+			parent:    slotTime,
+			expErr:    clock.ErrTimestampNotAfterParent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := c.ValidatePayloadTimestamp(5, tt.timestamp, tt.parent)
+			if tt.expErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.expErr)
+		})
+	}
+}
+
+// TestSlotClock_SubSecondBlocks builds payloads for blocks half a second
+// apart, whose timestamps run ahead of the slot time by more than the
+// allowed drift.
+func TestSlotClock_SubSecondBlocks(t *testing.T) {
+	c := newSlotClock(t, genesisTime)
+	parent := uint64(genesisTime.Unix())
+	for slot := math.Slot(1); slot <= 100; slot++ {
+		c.OnBlock(slot, genesisTime.Add(time.Duration(slot)*time.Second/2))
+		timestamp := c.PayloadTimestamp(slot, parent)
+		require.NoError(t, c.ValidatePayloadTimestamp(slot, timestamp, parent))
+		parent = timestamp
+	}
+	require.Greater(
+		t, time.Unix(int64(parent), 0).Sub(c.SlotTime(100)), maxDrift,
+	)
+}
+
+func TestSlotClock_NoDrift(t *testing.T) {
+	c, err := clock.NewSlotClock(slotDuration, 0)
+	require.NoError(t, err)
+
+	// A zero drift only requires the timestamp to be after the parent one.
+	require.NoError(t, c.ValidatePayloadTimestamp(1, 1, 0))
+	require.ErrorIs(
+		t, c.ValidatePayloadTimestamp(1, 1, 1),
+		clock.ErrTimestampNotAfterParent,
+	)
+}
@@ -31,4 +31,10 @@ const (
 	GenesisEpoch uint64 = 0
 	// FarFutureEpoch represents a far future epoch value.
 	FarFutureEpoch = ^uint64(0)
+	// DepositContractTreeDepth is the depth of the deposit contract merkle
+	// tree.
+	DepositContractTreeDepth uint8 = 32
+	// DepositProofLength is the length of a deposit inclusion proof: the
+	// branch of the deposit contract tree followed by the length mix-in.
+	DepositProofLength = DepositContractTreeDepth + 1
 )
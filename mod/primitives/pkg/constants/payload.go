@@ -31,6 +31,10 @@ const (
 	// payload.
 	MaxTxsPerPayload uint64 = 1048576
 
+	// MaxBytesPerTx is the maximum number of bytes in a transaction of an
+	// execution payload.
+	MaxBytesPerTx uint64 = 1073741824
+
 	// MaxDepositsPerBlock is the maximum number of deposits per block.
 	MaxDepositsPerBlock uint64 = 16
 
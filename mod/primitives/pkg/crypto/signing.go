@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package crypto
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+
+// SigningType is the type of the object a signing root is computed for.
+type SigningType string
+
+const (
+	// SigningTypeRandaoReveal is the type of randao reveals.
+	SigningTypeRandaoReveal SigningType = "RANDAO_REVEAL"
+	// SigningTypeBlock is the type of beacon block proposals.
+	SigningTypeBlock SigningType = "BLOCK_V2"
+	// SigningTypeDeposit is the type of deposit messages.
+	SigningTypeDeposit SigningType = "DEPOSIT"
+	// SigningTypeValidatorRegistration is the type of validator
+	// registrations with external block builders.
+	SigningTypeValidatorRegistration SigningType = "VALIDATOR_REGISTRATION"
+)
+
+// SigningRequest is a signing root along with the object it is computed
+// for, which lets signers apply their own policies, such as slashing
+// protection, to each type of object.
+type SigningRequest struct {
+	// Type is the type of the signed object.
+	Type SigningType
+	// SigningRoot is the signing root of the object.
+	SigningRoot bytes.B32
+	// ForkInfo is the fork the signing domain is computed for. It is nil
+	// for objects signed independently of the fork, such as deposits.
+	ForkInfo *ForkInfo
+	// RandaoReveal is set for requests of type SigningTypeRandaoReveal.
+	RandaoReveal *RandaoRevealData
+	// Block is set for requests of type SigningTypeBlock.
+	Block *BlockHeaderData
+	// Deposit is set for requests of type SigningTypeDeposit.
+	Deposit *DepositData
+	// ValidatorRegistration is set for requests of type
+	// SigningTypeValidatorRegistration.
+	ValidatorRegistration *ValidatorRegistrationData
+}
+
+// ForkInfo is the fork a signing domain is computed for.
+type ForkInfo struct {
+	// PreviousVersion is the version of the previous fork.
+	PreviousVersion bytes.B4
+	// CurrentVersion is the version of the current fork.
+	CurrentVersion bytes.B4
+	// Epoch is the epoch of the current fork.
+	Epoch uint64
+	// GenesisValidatorsRoot is the genesis validators root of the chain.
+	GenesisValidatorsRoot bytes.B32
+}
+
+// NewForkInfo returns the ForkInfo of a domain computed with the given fork
+// version at the given epoch. Both versions of the fork are set to the
+// given one, so the fork is the same whatever the epoch it is used for.
+func NewForkInfo(
+	version bytes.B4,
+	epoch uint64,
+	genesisValidatorsRoot bytes.B32,
+) *ForkInfo {
+	return &ForkInfo{
+		PreviousVersion:       version,
+		CurrentVersion:        version,
+		Epoch:                 epoch,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}
+}
+
+// RandaoRevealData is the object signed by a randao reveal.
+type RandaoRevealData struct {
+	// Epoch is the epoch of the reveal.
+	Epoch uint64
+}
+
+// BlockHeaderData is the header of a proposed beacon block.
+type BlockHeaderData struct {
+	// Slot is the slot of the block.
+	Slot uint64
+	// ProposerIndex is the index of the proposer of the block.
+	ProposerIndex uint64
+	// ParentRoot is the root of the parent block.
+	ParentRoot bytes.B32
+	// StateRoot is the root of the post state of the block.
+	StateRoot bytes.B32
+	// BodyRoot is the root of the body of the block.
+	BodyRoot bytes.B32
+}
+
+// DepositData is the deposit message signed by a depositor.
+type DepositData struct {
+	// Pubkey is the public key of the validator.
+	Pubkey BLSPubkey
+	// WithdrawalCredentials are the withdrawal credentials of the
+	// validator.
+	WithdrawalCredentials bytes.B32
+	// Amount is the deposited amount, in gwei.
+	Amount uint64
+	// GenesisForkVersion is the fork version the deposit domain is
+	// computed for.
+	GenesisForkVersion bytes.B4
+}
+
+// ValidatorRegistrationData is a registration of a validator with external
+// block builders.
+type ValidatorRegistrationData struct {
+	// FeeRecipient is the address the validator wants to be paid at.
+	FeeRecipient [20]byte
+	// GasLimit is the gas limit the validator wants blocks built with.
+	GasLimit uint64
+	// Timestamp is the time of the registration.
+	Timestamp uint64
+	// Pubkey is the public key of the validator.
+	Pubkey BLSPubkey
+}
+
+// TypedBLSSigner is a BLSSigner that is told what it signs.
+type TypedBLSSigner interface {
+	BLSSigner
+	// SignRequest signs the signing root of the request.
+	SignRequest(req *SigningRequest) (BLSSignature, error)
+}
+
+// SignRequest signs the request with the signer, falling back to signing
+// the bare signing root if the signer is not a TypedBLSSigner.
+func SignRequest(signer BLSSigner, req *SigningRequest) (BLSSignature, error) {
+	if typed, ok := signer.(TypedBLSSigner); ok {
+		return typed.SignRequest(req)
+	}
+	return signer.Sign(req.SigningRoot[:])
+}
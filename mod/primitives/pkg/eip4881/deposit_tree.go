@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package eip4881
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// DepositProof is the inclusion proof of a deposit: the branch of the
+// deposit contract tree followed by the length mix-in.
+type DepositProof [constants.DepositProofLength]common.Root
+
+// DepositTree is the incremental deposit contract merkle tree of EIP-4881.
+// It holds every deposit that has not been finalized yet and keeps only
+// the roots of the finalized subtrees, so that it can be snapshotted and
+// restored without replaying the deposit contract logs from genesis.
+//
+// DepositTree is not safe for concurrent use.
+type DepositTree struct {
+	tree node
+	// count is the number of deposits pushed into the tree.
+	count uint64
+	// finalizedCount is the number of finalized deposits.
+	finalizedCount uint64
+	// executionBlockHash and executionBlockHeight identify the execution
+	// block at which the tree was last finalized.
+	executionBlockHash   common.ExecutionHash
+	executionBlockHeight math.U64
+}
+
+// NewDepositTree returns an empty deposit tree.
+func NewDepositTree() *DepositTree {
+	return &DepositTree{
+		tree: &zeroNode{depth: constants.DepositContractTreeDepth},
+	}
+}
+
+// NewDepositTreeFromSnapshot rebuilds a deposit tree from a snapshot.
+func NewDepositTreeFromSnapshot(s *Snapshot) (*DepositTree, error) {
+	t := &DepositTree{
+		tree: fromSnapshotParts(
+			s.Finalized, s.DepositCount.Unwrap(),
+			constants.DepositContractTreeDepth,
+		),
+		count:                s.DepositCount.Unwrap(),
+		finalizedCount:       s.DepositCount.Unwrap(),
+		executionBlockHash:   s.ExecutionBlockHash,
+		executionBlockHeight: s.ExecutionBlockHeight,
+	}
+	if t.Root() != s.DepositRoot {
+		return nil, ErrInvalidSnapshot
+	}
+	return t, nil
+}
+
+// Count returns the number of deposits in the tree.
+func (t *DepositTree) Count() uint64 {
+	return t.count
+}
+
+// FinalizedCount returns the number of finalized deposits.
+func (t *DepositTree) FinalizedCount() uint64 {
+	return t.finalizedCount
+}
+
+// Root returns the deposit root, as returned by the deposit contract.
+func (t *DepositTree) Root() common.Root {
+	var buf [64]byte
+	root := t.tree.root()
+	copy(buf[:32], root[:])
+	binary.LittleEndian.PutUint64(buf[32:40], t.count)
+	return sha256.Sum256(buf[:])
+}
+
+// PushLeaf appends the root of a deposit data to the tree.
+func (t *DepositTree) PushLeaf(leaf common.Root) error {
+	if t.count >= uint64(1)<<constants.DepositContractTreeDepth {
+		return ErrTreeFull
+	}
+	tree, err := t.tree.pushLeaf(leaf, constants.DepositContractTreeDepth)
+	if err != nil {
+		return err
+	}
+	t.tree = tree
+	t.count++
+	return nil
+}
+
+// Proof returns the leaf at the given index along with its inclusion
+// proof against the current root.
+func (t *DepositTree) Proof(index uint64) (common.Root, DepositProof, error) {
+	var proof DepositProof
+	if index >= t.count {
+		return common.Root{}, proof, ErrIndexOutOfRange
+	}
+	if index < t.finalizedCount {
+		return common.Root{}, proof, ErrIndexFinalized
+	}
+
+	leaf, branch, err := generateProof(
+		t.tree, index, constants.DepositContractTreeDepth,
+	)
+	if err != nil {
+		return common.Root{}, proof, err
+	}
+	copy(proof[:], branch)
+	binary.LittleEndian.PutUint64(
+		proof[constants.DepositContractTreeDepth][:8], t.count,
+	)
+	return leaf, proof, nil
+}
+
+// Finalize prunes the first count deposits of the tree, which are no
+// longer needed to build proofs, and records the execution block they
+// were finalized at.
+func (t *DepositTree) Finalize(
+	count uint64,
+	executionBlockHash common.ExecutionHash,
+	executionBlockHeight math.U64,
+) error {
+	switch {
+	case count > t.count:
+		return ErrFinalizeBeyondCount
+	case count < t.finalizedCount:
+		return ErrFinalizeBackwards
+	}
+	t.tree = t.tree.finalize(count, constants.DepositContractTreeDepth)
+	t.finalizedCount = count
+	t.executionBlockHash = executionBlockHash
+	t.executionBlockHeight = executionBlockHeight
+	return nil
+}
+
+// Snapshot returns the snapshot of the finalized part of the tree.
+func (t *DepositTree) Snapshot() *Snapshot {
+	count, finalized := t.tree.finalized(make([]common.Root, 0))
+	snapshotTree := fromSnapshotParts(
+		finalized, count, constants.DepositContractTreeDepth,
+	)
+
+	var buf [64]byte
+	root := snapshotTree.root()
+	copy(buf[:32], root[:])
+	binary.LittleEndian.PutUint64(buf[32:40], count)
+	return &Snapshot{
+		Finalized:            finalized,
+		DepositRoot:          sha256.Sum256(buf[:]),
+		DepositCount:         math.U64(count),
+		ExecutionBlockHash:   t.executionBlockHash,
+		ExecutionBlockHeight: t.executionBlockHeight,
+	}
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package eip4881_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4881"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+func leaves(n int) []common.Root {
+	out := make([]common.Root, n)
+	for i := range out {
+		out[i] = common.Root{byte(i), byte(i >> 8), 0xff}
+	}
+	return out
+}
+
+func TestDepositTreeMatchesMerkleTree(t *testing.T) {
+	tree := eip4881.NewDepositTree()
+	all := leaves(37)
+	for i, leaf := range all {
+		require.NoError(t, tree.PushLeaf(leaf))
+
+		reference, err := merkle.NewTreeFromLeavesWithDepth[
+			common.Root, common.Root,
+		](all[:i+1], constants.DepositContractTreeDepth)
+		require.NoError(t, err)
+		root, err := reference.HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, common.Root(root), tree.Root())
+	}
+	require.Equal(t, uint64(len(all)), tree.Count())
+
+	for i := range all {
+		leaf, proof, err := tree.Proof(uint64(i))
+		require.NoError(t, err)
+		require.Equal(t, all[i], leaf)
+		require.True(t, merkle.IsValidMerkleBranch(
+			leaf, proof[:], constants.DepositProofLength, uint64(i),
+			tree.Root(),
+		))
+	}
+
+	_, _, err := tree.Proof(uint64(len(all)))
+	require.ErrorIs(t, err, eip4881.ErrIndexOutOfRange)
+}
+
+func TestDepositTreeFinalizeAndSnapshot(t *testing.T) {
+	var (
+		all       = leaves(50)
+		tree      = eip4881.NewDepositTree()
+		reference = eip4881.NewDepositTree()
+	)
+	for _, leaf := range all[:20] {
+		require.NoError(t, tree.PushLeaf(leaf))
+		require.NoError(t, reference.PushLeaf(leaf))
+	}
+
+	require.NoError(t, tree.Finalize(13, common.ExecutionHash{0x01}, 7))
+	require.Equal(t, reference.Root(), tree.Root())
+	require.ErrorIs(
+		t, tree.Finalize(12, common.ExecutionHash{}, 0),
+		eip4881.ErrFinalizeBackwards,
+	)
+	require.ErrorIs(
+		t, tree.Finalize(21, common.ExecutionHash{}, 0),
+		eip4881.ErrFinalizeBeyondCount,
+	)
+
+	_, _, err := tree.Proof(12)
+	require.ErrorIs(t, err, eip4881.ErrIndexFinalized)
+	_, proof, err := tree.Proof(13)
+	require.NoError(t, err)
+	require.True(t, merkle.IsValidMerkleBranch(
+		all[13], proof[:], constants.DepositProofLength, 13, tree.Root(),
+	))
+
+	snapshot := tree.Snapshot()
+	require.Equal(t, uint64(13), snapshot.DepositCount.Unwrap())
+	require.Equal(t, common.ExecutionHash{0x01}, snapshot.ExecutionBlockHash)
+
+	restored, err := eip4881.NewDepositTreeFromSnapshot(snapshot)
+	require.NoError(t, err)
+	for _, leaf := range all[13:] {
+		require.NoError(t, restored.PushLeaf(leaf))
+	}
+	for _, leaf := range all[20:] {
+		require.NoError(t, reference.PushLeaf(leaf))
+	}
+	require.Equal(t, reference.Root(), restored.Root())
+
+	_, proof, err = restored.Proof(42)
+	require.NoError(t, err)
+	require.True(t, merkle.IsValidMerkleBranch(
+		all[42], proof[:], constants.DepositProofLength, 42, reference.Root(),
+	))
+
+	snapshot.DepositRoot = common.Root{0xde, 0xad}
+	_, err = eip4881.NewDepositTreeFromSnapshot(snapshot)
+	require.ErrorIs(t, err, eip4881.ErrInvalidSnapshot)
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package eip4881
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrTreeFull is returned when pushing a leaf into a deposit tree that
+	// already holds the maximum number of deposits.
+	ErrTreeFull = errors.New("deposit tree is full")
+
+	// ErrPushToFullNode is returned when pushing a leaf into a subtree that
+	// has no room left.
+	ErrPushToFullNode = errors.New("cannot push a leaf into a full node")
+
+	// ErrIndexOutOfRange is returned when requesting the proof of a deposit
+	// that is not in the tree.
+	ErrIndexOutOfRange = errors.New("deposit index out of range")
+
+	// ErrIndexFinalized is returned when requesting the proof of a deposit
+	// that has been finalized.
+	ErrIndexFinalized = errors.New("deposit index is finalized")
+
+	// ErrFinalizeBeyondCount is returned when finalizing more deposits than
+	// the tree holds.
+	ErrFinalizeBeyondCount = errors.New(
+		"cannot finalize more deposits than the tree holds",
+	)
+
+	// ErrFinalizeBackwards is returned when finalizing fewer deposits than
+	// have already been finalized.
+	ErrFinalizeBackwards = errors.New(
+		"cannot finalize fewer deposits than already finalized",
+	)
+
+	// ErrInvalidSnapshot is returned when a snapshot does not rebuild into a
+	// tree with the snapshot deposit root.
+	ErrInvalidSnapshot = errors.New("invalid deposit tree snapshot")
+)
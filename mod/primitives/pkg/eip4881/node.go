@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package eip4881
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
+)
+
+// node is a node of the sparse merkle tree described in EIP-4881. Full
+// subtrees whose leaves are no longer needed collapse into a single
+// finalized node.
+type node interface {
+	// root returns the merkle root of the subtree.
+	root() common.Root
+	// isFull reports whether every leaf of the subtree is set.
+	isFull() bool
+	// pushLeaf appends a leaf to the subtree of the given depth and returns
+	// the resulting subtree.
+	pushLeaf(leaf common.Root, depth uint8) (node, error)
+	// finalize collapses the first count leaves of the subtree of the
+	// given depth and returns the resulting subtree.
+	finalize(count uint64, depth uint8) node
+	// finalized appends the roots of the finalized subtrees to result and
+	// returns the number of deposits they cover.
+	finalized(result []common.Root) (uint64, []common.Root)
+}
+
+// finalizedNode is a full subtree whose leaves have been pruned.
+type finalizedNode struct {
+	count uint64
+	hash  common.Root
+}
+
+func (n *finalizedNode) root() common.Root { return n.hash }
+
+func (n *finalizedNode) isFull() bool { return true }
+
+func (n *finalizedNode) pushLeaf(common.Root, uint8) (node, error) {
+	return nil, ErrPushToFullNode
+}
+
+func (n *finalizedNode) finalize(uint64, uint8) node { return n }
+
+func (n *finalizedNode) finalized(
+	result []common.Root,
+) (uint64, []common.Root) {
+	return n.count, append(result, n.hash)
+}
+
+// leafNode is a single deposit.
+type leafNode struct {
+	hash common.Root
+}
+
+func (n *leafNode) root() common.Root { return n.hash }
+
+func (n *leafNode) isFull() bool { return true }
+
+func (n *leafNode) pushLeaf(common.Root, uint8) (node, error) {
+	return nil, ErrPushToFullNode
+}
+
+func (n *leafNode) finalize(uint64, uint8) node {
+	return &finalizedNode{count: 1, hash: n.hash}
+}
+
+func (n *leafNode) finalized(result []common.Root) (uint64, []common.Root) {
+	return 0, result
+}
+
+// zeroNode is an empty subtree of the given depth.
+type zeroNode struct {
+	depth uint8
+}
+
+func (n *zeroNode) root() common.Root { return zero.Hashes[n.depth] }
+
+func (n *zeroNode) isFull() bool { return false }
+
+func (n *zeroNode) pushLeaf(leaf common.Root, depth uint8) (node, error) {
+	return create([]common.Root{leaf}, depth), nil
+}
+
+func (n *zeroNode) finalize(uint64, uint8) node { return n }
+
+func (n *zeroNode) finalized(result []common.Root) (uint64, []common.Root) {
+	return 0, result
+}
+
+// innerNode is a subtree with at least one leaf set.
+type innerNode struct {
+	left, right node
+	// hash caches the root of the subtree, it is cleared on every push.
+	hash *common.Root
+}
+
+func (n *innerNode) root() common.Root {
+	if n.hash == nil {
+		var buf [64]byte
+		left, right := n.left.root(), n.right.root()
+		copy(buf[:32], left[:])
+		copy(buf[32:], right[:])
+		hash := common.Root(sha256.Sum256(buf[:]))
+		n.hash = &hash
+	}
+	return *n.hash
+}
+
+func (n *innerNode) isFull() bool { return n.right.isFull() }
+
+func (n *innerNode) pushLeaf(leaf common.Root, depth uint8) (node, error) {
+	var err error
+	if !n.left.isFull() {
+		n.left, err = n.left.pushLeaf(leaf, depth-1)
+	} else {
+		n.right, err = n.right.pushLeaf(leaf, depth-1)
+	}
+	n.hash = nil
+	return n, err
+}
+
+func (n *innerNode) finalize(count uint64, depth uint8) node {
+	deposits := uint64(1) << depth
+	if deposits <= count {
+		return &finalizedNode{count: deposits, hash: n.root()}
+	}
+	n.left = n.left.finalize(count, depth-1)
+	if count > deposits/2 {
+		n.right = n.right.finalize(count-deposits/2, depth-1)
+	}
+	return n
+}
+
+func (n *innerNode) finalized(result []common.Root) (uint64, []common.Root) {
+	count, result := n.left.finalized(result)
+	if _, ok := n.right.(*zeroNode); ok {
+		return count, result
+	}
+	rightCount, result := n.right.finalized(result)
+	return count + rightCount, result
+}
+
+// create builds the subtree of the given depth holding the given leaves.
+func create(leaves []common.Root, depth uint8) node {
+	switch {
+	case len(leaves) == 0:
+		return &zeroNode{depth: depth}
+	case depth == 0:
+		return &leafNode{hash: leaves[0]}
+	}
+	split := min(uint64(1)<<(depth-1), uint64(len(leaves)))
+	return &innerNode{
+		left:  create(leaves[:split], depth-1),
+		right: create(leaves[split:], depth-1),
+	}
+}
+
+// fromSnapshotParts rebuilds the subtree of the given depth from the roots
+// of its finalized subtrees and the number of deposits they cover.
+func fromSnapshotParts(
+	finalized []common.Root,
+	count uint64,
+	depth uint8,
+) node {
+	if len(finalized) == 0 || count == 0 {
+		return &zeroNode{depth: depth}
+	}
+	if count == uint64(1)<<depth {
+		return &finalizedNode{count: count, hash: finalized[0]}
+	}
+	leftDeposits := uint64(1) << (depth - 1)
+	if count <= leftDeposits {
+		return &innerNode{
+			left:  fromSnapshotParts(finalized, count, depth-1),
+			right: &zeroNode{depth: depth - 1},
+		}
+	}
+	return &innerNode{
+		left: &finalizedNode{count: leftDeposits, hash: finalized[0]},
+		right: fromSnapshotParts(
+			finalized[1:], count-leftDeposits, depth-1,
+		),
+	}
+}
+
+// generateProof returns the leaf at the given index of the subtree of the
+// given depth and its merkle branch, ordered from the leaf up.
+func generateProof(
+	n node,
+	index uint64,
+	depth uint8,
+) (common.Root, []common.Root, error) {
+	proof := make([]common.Root, depth)
+	for ; depth > 0; depth-- {
+		inner, ok := n.(*innerNode)
+		if !ok {
+			return common.Root{}, nil, ErrIndexFinalized
+		}
+		if (index>>(depth-1))&1 == 1 {
+			proof[depth-1] = inner.left.root()
+			n = inner.right
+		} else {
+			proof[depth-1] = inner.right.root()
+			n = inner.left
+		}
+	}
+	leaf, ok := n.(*leafNode)
+	if !ok {
+		return common.Root{}, nil, ErrIndexFinalized
+	}
+	return leaf.hash, proof, nil
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package eip4881
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Snapshot is the minimal state needed to restore a deposit tree, as
+// defined in EIP-4881 and served by the deposit_snapshot endpoint of the
+// beacon API.
+type Snapshot struct {
+	// Finalized holds the roots of the finalized subtrees, left to right.
+	Finalized []common.Root `json:"finalized"`
+	// DepositRoot is the root of the tree made of the finalized deposits.
+	DepositRoot common.Root `json:"deposit_root"`
+	// DepositCount is the number of finalized deposits.
+	DepositCount math.U64 `json:"deposit_count"`
+	// ExecutionBlockHash is the hash of the execution block the tree was
+	// finalized at.
+	ExecutionBlockHash common.ExecutionHash `json:"execution_block_hash"`
+	// ExecutionBlockHeight is the number of the execution block the tree
+	// was finalized at.
+	ExecutionBlockHeight math.U64 `json:"execution_block_height"`
+}
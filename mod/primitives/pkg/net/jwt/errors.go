@@ -32,4 +32,20 @@ var (
 	// illegal characters.
 	ErrContainsIllegalCharacter = errors.New(
 		"JWT secret contains illegal character(s)")
+
+	// ErrInvalidToken is returned when a JWT cannot be parsed or its
+	// signature does not match the secret.
+	ErrInvalidToken = errors.New("invalid JWT token")
+
+	// ErrMissingIssuedAt is returned when a JWT does not carry an
+	// issued-at claim.
+	ErrMissingIssuedAt = errors.New("JWT is missing the iat claim")
+
+	// ErrStaleIssuedAt is returned when the issued-at claim of a JWT is too
+	// far from the current time.
+	ErrStaleIssuedAt = errors.New("JWT iat claim is out of range")
+
+	// ErrNoSecret is returned when a request is to be authenticated with a
+	// keyring holding no secret.
+	ErrNoSecret = errors.New("no JWT secret to authenticate with")
 )
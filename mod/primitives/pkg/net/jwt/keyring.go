@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package jwt
+
+import "sync"
+
+// Keyring holds the JWT secrets a peer may currently expect, backed by a
+// secret file that can be rotated while in use. The secret last read from
+// the file is the current one. The secrets it replaced stay valid until the
+// current one is accepted, so that both ends of the connection can pick up
+// the new secret at their own pace.
+type Keyring struct {
+	// path is the path of the secret file.
+	path string
+
+	mu sync.RWMutex
+	// current is the secret last read from the file.
+	current *Secret
+	// previous are the secrets current replaced, newest first.
+	previous []*Secret
+	// accepted is the secret last accepted by the peer, if any.
+	accepted *Secret
+}
+
+// NewKeyring creates a new keyring holding the given secret, read from the
+// file at the given path.
+func NewKeyring(path string, secret *Secret) *Keyring {
+	return &Keyring{
+		path:    path,
+		current: secret,
+	}
+}
+
+// Current returns the secret last read from the file.
+func (k *Keyring) Current() *Secret {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.current
+}
+
+// Secrets returns the secrets to try, in order: the secret last accepted by
+// the peer, the current one, then the ones it replaced.
+func (k *Keyring) Secrets() []*Secret {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	secrets := make([]*Secret, 0, len(k.previous)+2) //nolint:mnd // 2 extra.
+	for _, secret := range append(
+		[]*Secret{k.accepted, k.current}, k.previous...,
+	) {
+		if secret != nil && !containsSecret(secrets, secret) {
+			secrets = append(secrets, secret)
+		}
+	}
+	return secrets
+}
+
+// Accept records that the peer accepted the given secret. Once the current
+// secret is accepted, the secrets it replaced are dropped.
+func (k *Keyring) Accept(secret *Secret) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.accepted = secret
+	if *secret == *k.current {
+		k.previous = nil
+	}
+}
+
+// Reload reads the secret file again and reports whether the secret
+// changed. The secret it replaces stays valid until the new one is
+// accepted.
+func (k *Keyring) Reload() (bool, error) {
+	secret, err := NewFromFile(k.path)
+	if err != nil {
+		return false, err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if *secret == *k.current {
+		return false, nil
+	}
+	previous := make([]*Secret, 0, len(k.previous)+1)
+	for _, s := range append([]*Secret{k.current}, k.previous...) {
+		if *s != *secret {
+			previous = append(previous, s)
+		}
+	}
+	k.current, k.previous = secret, previous
+	return true, nil
+}
+
+// containsSecret reports whether secrets holds the given secret.
+func containsSecret(secrets []*Secret, secret *Secret) bool {
+	for _, s := range secrets {
+		if *s == *secret {
+			return true
+		}
+	}
+	return false
+}
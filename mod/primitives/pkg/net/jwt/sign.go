@@ -24,6 +24,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
+
 	gjwt "github.com/golang-jwt/jwt/v5"
 )
 
@@ -38,3 +40,26 @@ func BuildSignedJWT(s *Secret) (string, error) {
 	}
 	return str, nil
 }
+
+// VerifySignedJWT verifies that the given token was signed with the provided
+// JWT secret and that its issued-at claim is within maxDrift of the current
+// time, as required by the Engine API authentication specification.
+func VerifySignedJWT(tokenStr string, s *Secret, maxDrift time.Duration) error {
+	token, err := gjwt.Parse(
+		tokenStr,
+		func(*gjwt.Token) (interface{}, error) { return s[:], nil },
+		gjwt.WithValidMethods([]string{gjwt.SigningMethodHS256.Alg()}),
+	)
+	if err != nil {
+		return errors.Wrap(ErrInvalidToken, err.Error())
+	}
+
+	iat, err := token.Claims.GetIssuedAt()
+	if err != nil || iat == nil {
+		return ErrMissingIssuedAt
+	}
+	if drift := time.Since(iat.Time); drift > maxDrift || drift < -maxDrift {
+		return errors.Wrapf(ErrStaleIssuedAt, "drift %s", drift)
+	}
+	return nil
+}
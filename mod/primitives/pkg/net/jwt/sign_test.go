@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package jwt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	gjwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySignedJWT(t *testing.T) {
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	other, err := jwt.NewRandom()
+	require.NoError(t, err)
+
+	token, err := jwt.BuildSignedJWT(secret)
+	require.NoError(t, err)
+	require.NoError(t, jwt.VerifySignedJWT(token, secret, time.Minute))
+	require.ErrorIs(
+		t, jwt.VerifySignedJWT(token, other, time.Minute), jwt.ErrInvalidToken,
+	)
+
+	stale, err := gjwt.NewWithClaims(gjwt.SigningMethodHS256, gjwt.MapClaims{
+		"iat": &gjwt.NumericDate{Time: time.Now().Add(-time.Hour)},
+	}).SignedString(secret[:])
+	require.NoError(t, err)
+	require.ErrorIs(
+		t, jwt.VerifySignedJWT(stale, secret, time.Minute), jwt.ErrStaleIssuedAt,
+	)
+
+	missing, err := gjwt.NewWithClaims(
+		gjwt.SigningMethodHS256, gjwt.MapClaims{},
+	).SignedString(secret[:])
+	require.NoError(t, err)
+	require.ErrorIs(
+		t, jwt.VerifySignedJWT(missing, secret, time.Minute),
+		jwt.ErrMissingIssuedAt,
+	)
+}
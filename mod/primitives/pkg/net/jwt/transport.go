@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package jwt
+
+import (
+	"io"
+	"net/http"
+)
+
+// Transport is an http.RoundTripper authenticating every request with a JWT
+// signed on the spot, so that tokens never go stale. A request rejected as
+// unauthorized is sent again with each of the other secrets of the keyring,
+// and the secret accepted is tried first from then on.
+type Transport struct {
+	// base is the round-tripper sending the authenticated requests.
+	base http.RoundTripper
+	// keyring holds the secrets to sign with.
+	keyring *Keyring
+	// onAuthFailure is called for every request rejected as unauthorized.
+	onAuthFailure func()
+}
+
+// NewTransport creates a new transport signing requests with the secrets of
+// the given keyring and sending them with the given round-tripper, or the
+// default one if nil. The given function, if any, is called for every
+// request rejected as unauthorized.
+func NewTransport(
+	base http.RoundTripper,
+	keyring *Keyring,
+	onAuthFailure func(),
+) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if onAuthFailure == nil {
+		onAuthFailure = func() {}
+	}
+	return &Transport{
+		base:          base,
+		keyring:       keyring,
+		onAuthFailure: onAuthFailure,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	secrets := t.keyring.Secrets()
+	for i, secret := range secrets {
+		authReq := req.Clone(req.Context())
+		if i > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			authReq.Body = body
+		}
+
+		token, err := BuildSignedJWT(secret)
+		if err != nil {
+			return nil, err
+		}
+		authReq.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := t.base.RoundTrip(authReq)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.keyring.Accept(secret)
+			return resp, nil
+		}
+
+		t.onAuthFailure()
+		// The request can only be sent again if its body can be replayed.
+		if i == len(secrets)-1 || !replayable(req) {
+			return resp, nil
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}
+	return nil, ErrNoSecret
+}
+
+// replayable reports whether the request can be sent more than once.
+func replayable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
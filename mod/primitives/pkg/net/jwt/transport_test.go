@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package jwt_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+// authServer is a peer accepting requests authenticated with one secret
+// at a time, echoing their body.
+type authServer struct {
+	mu     sync.Mutex
+	secret *jwt.Secret
+}
+
+func (s *authServer) setSecret(secret *jwt.Secret) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.secret = secret
+}
+
+func (s *authServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	secret := s.secret
+	s.mu.Unlock()
+	token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if jwt.VerifySignedJWT(token, secret, time.Minute) != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	_, _ = io.Copy(w, r.Body)
+}
+
+func randomSecret(t *testing.T) *jwt.Secret {
+	t.Helper()
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	return secret
+}
+
+func writeSecret(t *testing.T, path string, secret *jwt.Secret) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(secret.Hex()), 0o600))
+}
+
+func TestTransportRotation(t *testing.T) {
+	oldSecret, newSecret := randomSecret(t), randomSecret(t)
+	path := filepath.Join(t.TempDir(), "jwt.hex")
+	writeSecret(t, path, oldSecret)
+
+	server := &authServer{secret: oldSecret}
+	ts := httptest.NewServer(server)
+	t.Cleanup(ts.Close)
+
+	keyring := jwt.NewKeyring(path, oldSecret)
+	var failures int
+	client := &http.Client{
+		Transport: jwt.NewTransport(nil, keyring, func() { failures++ }),
+	}
+	post := func() int {
+		resp, err := client.Post(ts.URL, "text/plain", strings.NewReader("hi"))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		if resp.StatusCode == http.StatusOK {
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, "hi", string(body))
+		}
+		return resp.StatusCode
+	}
+	require.Equal(t, http.StatusOK, post())
+
+	// The file is rotated before the peer picks up the new secret: the old
+	// secret is still accepted and tried first.
+	writeSecret(t, path, newSecret)
+	changed, err := keyring.Reload()
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, *newSecret, *keyring.Current())
+	require.Equal(t, http.StatusOK, post())
+	require.Equal(t, http.StatusOK, post())
+	require.Zero(t, failures)
+
+	// The peer picks up the new secret: one failure, then the old secret is
+	// dropped.
+	server.setSecret(newSecret)
+	require.Equal(t, http.StatusOK, post())
+	require.Equal(t, 1, failures)
+	require.Len(t, keyring.Secrets(), 1)
+	require.Equal(t, http.StatusOK, post())
+	require.Equal(t, 1, failures)
+
+	// A secret the peer does not know is rejected.
+	server.setSecret(oldSecret)
+	require.Equal(t, http.StatusUnauthorized, post())
+	require.Equal(t, 2, failures)
+
+	changed, err = keyring.Reload()
+	require.NoError(t, err)
+	require.False(t, changed)
+}
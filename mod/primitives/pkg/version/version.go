@@ -44,3 +44,24 @@ func FromUint32[VersionT ~[4]byte](version uint32) VersionT {
 func ToUint32[VersionT ~[4]byte](version VersionT) uint32 {
 	return binary.LittleEndian.Uint32(version[:])
 }
+
+// Name returns the lowercase name of the fork of the given version, or
+// "unknown" if the version is not a known fork.
+func Name(version uint32) string {
+	switch version {
+	case Phase0:
+		return "phase0"
+	case Altair:
+		return "altair"
+	case Bellatrix:
+		return "bellatrix"
+	case Capella:
+		return "capella"
+	case Deneb:
+		return "deneb"
+	case Electra:
+		return "electra"
+	default:
+		return "unknown"
+	}
+}
@@ -139,3 +139,10 @@ func TestToUint32_CustomType(t *testing.T) {
 	result := version.ToUint32(input)
 	require.Equal(t, expected, result)
 }
+
+func TestName(t *testing.T) {
+	require.Equal(t, "phase0", version.Name(version.Phase0))
+	require.Equal(t, "deneb", version.Name(version.Deneb))
+	require.Equal(t, "electra", version.Name(version.Electra))
+	require.Equal(t, "unknown", version.Name(42))
+}
@@ -18,13 +18,14 @@
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
 
-package middleware
+package encoding
 
 const (
-	// BeaconBlockTxIndex represents the index of the beacon block transaction.
-	// It is the first transaction in the tx list.
+	// BeaconBlockTxIndex represents the index of the beacon block transaction
+	// in the legacy layout. It is the first transaction in the tx list.
 	BeaconBlockTxIndex uint = iota
-	// BlobSidecarsTxIndex represents the index of the blob sidecar transaction.
-	// It follows the beacon block transaction in the tx list.
+	// BlobSidecarsTxIndex represents the index of the blob sidecar transaction
+	// in the legacy layout. It follows the beacon block transaction in the tx
+	// list.
 	BlobSidecarsTxIndex
 )
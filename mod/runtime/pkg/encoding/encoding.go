@@ -23,6 +23,7 @@ package encoding
 import (
 	"reflect"
 
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
 )
 
@@ -33,8 +34,6 @@ func ExtractBlobsAndBlockFromRequest[
 	BlobSidecarsT ssz.Marshallable,
 ](
 	req ABCIRequest,
-	beaconBlkIndex uint,
-	blobSidecarsIndex uint,
 	forkVersion uint32,
 ) (BeaconBlockT, BlobSidecarsT, error) {
 	var (
@@ -48,7 +47,6 @@ func ExtractBlobsAndBlockFromRequest[
 
 	blk, err := UnmarshalBeaconBlockFromABCIRequest[BeaconBlockT](
 		req,
-		beaconBlkIndex,
 		forkVersion,
 	)
 	if err != nil {
@@ -57,7 +55,6 @@ func ExtractBlobsAndBlockFromRequest[
 
 	blobs, err = UnmarshalBlobSidecarsFromABCIRequest[BlobSidecarsT](
 		req,
-		blobSidecarsIndex,
 	)
 	if err != nil {
 		return blk, blobs, err
@@ -67,12 +64,11 @@ func ExtractBlobsAndBlockFromRequest[
 }
 
 // UnmarshalBeaconBlockFromABCIRequest extracts a beacon block from an ABCI
-// request.
+// request. The block must be encoded for the given fork version.
 func UnmarshalBeaconBlockFromABCIRequest[
 	BeaconBlockT BeaconBlock[BeaconBlockT],
 ](
 	req ABCIRequest,
-	bzIndex uint,
 	forkVersion uint32,
 ) (BeaconBlockT, error) {
 	var blk BeaconBlockT
@@ -80,64 +76,88 @@ func UnmarshalBeaconBlockFromABCIRequest[
 		return blk, ErrNilABCIRequest
 	}
 
-	txs := req.GetTxs()
-	lenTxs := uint(len(txs))
-
 	// Ensure there are transactions in the request and that the request is
 	// valid.
-	if txs == nil || lenTxs == 0 {
+	if len(req.GetTxs()) == 0 {
 		return blk, ErrNoBeaconBlockInRequest
 	}
-	if bzIndex >= lenTxs {
-		return blk, ErrBzIndexOutOfBounds
-	}
 
 	// Extract the beacon block from the ABCI request.
-	blkBz := txs[bzIndex]
-	if blkBz == nil {
+	envelope, err := FindEnvelope(req, TxTypeBeaconBlock)
+	if err != nil {
+		return blk, err
+	}
+	if envelope.Payload == nil {
 		return blk, ErrNilBeaconBlockInRequest
 	}
+	if err = checkForkVersion(envelope, forkVersion); err != nil {
+		return blk, err
+	}
 
-	return blk.NewFromSSZ(blkBz, forkVersion)
+	return blk.NewFromSSZ(envelope.Payload, forkVersion)
 }
 
 // UnmarshalBlobSidecarsFromABCIRequest extracts blob sidecars from an ABCI
-// request.
+// request. The encoding of the sidecars does not depend on the fork.
 func UnmarshalBlobSidecarsFromABCIRequest[
 	T interface{ UnmarshalSSZ([]byte) error },
 ](
 	req ABCIRequest,
-	bzIndex uint,
 ) (T, error) {
-	var sidecars T
-
-	sidecars, ok := reflect.New(reflect.TypeOf(sidecars).Elem()).Interface().(T)
-	if !ok {
-		return sidecars, ErrInvalidType
+	sidecars, err := newSSZInstance[T]()
+	if err != nil {
+		return sidecars, err
 	}
 
 	if req == nil {
 		return sidecars, ErrNilABCIRequest
 	}
 
-	txs := req.GetTxs()
-	lenTxs := uint(len(txs))
-
 	// Ensure there are transactions in the request and that the request is
 	// valid.
-	if txs == nil || lenTxs == 0 {
+	if len(req.GetTxs()) == 0 {
 		return sidecars, ErrNoBeaconBlockInRequest
 	}
-	if bzIndex >= lenTxs {
-		return sidecars, ErrBzIndexOutOfBounds
-	}
 
 	// Extract the blob sidecars from the ABCI request.
-	sidecarBz := txs[bzIndex]
-	if sidecarBz == nil {
-		return sidecars, ErrNilBeaconBlockInRequest
+	envelope, err := FindEnvelope(req, TxTypeBlobSidecars)
+	if err != nil {
+		return sidecars, err
+	}
+	if envelope.Payload == nil {
+		return sidecars, ErrNilBlobSidecarsInRequest
 	}
 
-	err := sidecars.UnmarshalSSZ(sidecarBz)
+	err = sidecars.UnmarshalSSZ(envelope.Payload)
 	return sidecars, err
 }
+
+// UnmarshalBlobSidecarsRootFromABCIRequest extracts the root of the blob
+// sidecars gossiped outside of an ABCI request.
+func UnmarshalBlobSidecarsRootFromABCIRequest(
+	req ABCIRequest,
+) (common.Root, error) {
+	envelope, err := FindEnvelope(req, TxTypeBlobSidecarsRoot)
+	if err != nil {
+		return common.Root{}, err
+	}
+	if len(envelope.Payload) != len(common.Root{}) {
+		return common.Root{}, ErrInvalidRoot
+	}
+	return common.Root(envelope.Payload), nil
+}
+
+// newSSZInstance allocates a new instance of T, which must be a pointer
+// type.
+func newSSZInstance[T any]() (T, error) {
+	var out T
+	typ := reflect.TypeOf(out)
+	if typ == nil || typ.Kind() != reflect.Ptr {
+		return out, ErrInvalidType
+	}
+	out, ok := reflect.New(typ.Elem()).Interface().(T)
+	if !ok {
+		return out, ErrInvalidType
+	}
+	return out, nil
+}
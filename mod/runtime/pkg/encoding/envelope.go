@@ -0,0 +1,307 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encoding
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// TxType tags the payload carried by a transaction of a proposal.
+type TxType uint8
+
+const (
+	// TxTypeBeaconBlock tags the SSZ encoding of a beacon block.
+	TxTypeBeaconBlock TxType = iota + 1
+	// TxTypeBlobSidecars tags the SSZ encoding of the blob sidecars of the
+	// beacon block.
+	TxTypeBlobSidecars
+	// TxTypeBlobSidecarsRoot tags the root of the blob sidecars of the
+	// beacon block, when they are gossiped outside of the proposal.
+	TxTypeBlobSidecarsRoot
+)
+
+// String returns the name of the transaction type.
+func (t TxType) String() string {
+	switch t {
+	case TxTypeBeaconBlock:
+		return "beacon-block"
+	case TxTypeBlobSidecars:
+		return "blob-sidecars"
+	case TxTypeBlobSidecarsRoot:
+		return "blob-sidecars-root"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(t))
+	}
+}
+
+const (
+	// LegacyEnvelopeVersion is the version of the envelopes of transactions
+	// in the legacy layout, where the kind of a transaction is given by its
+	// position in the proposal and which carry no fork version.
+	LegacyEnvelopeVersion uint8 = 0
+	// EnvelopeVersion is the version of the envelopes produced.
+	EnvelopeVersion uint8 = 1
+)
+
+// envelopeMagic starts every envelope. Read as the little-endian slot of a
+// beacon block in the legacy layout, its last byte makes it exceed any
+// reachable slot, so that envelopes are never mistaken for legacy blocks.
+//
+//nolint:gochecknoglobals // constant.
+var envelopeMagic = [8]byte{'b', 'e', 'a', 'c', 'o', 'n', 'k', 0xff}
+
+const (
+	// versionOffset is the offset of the envelope version.
+	versionOffset = len(envelopeMagic)
+	// typeOffset is the offset of the transaction type.
+	typeOffset = versionOffset + 1
+	// forkVersionOffset is the offset of the fork version.
+	forkVersionOffset = typeOffset + 1
+	// envelopeHeaderSize is the size of the header preceding the payload.
+	envelopeHeaderSize = forkVersionOffset + 4
+)
+
+// legacyTxTypes are the types of the transactions of the legacy layout, by
+// position in the proposal.
+//
+//nolint:gochecknoglobals // constant.
+var legacyTxTypes = [...]TxType{
+	BeaconBlockTxIndex:  TxTypeBeaconBlock,
+	BlobSidecarsTxIndex: TxTypeBlobSidecars,
+}
+
+// Envelope is a transaction of a proposal, tagged with the type of its
+// payload and the fork version the payload is encoded for.
+type Envelope struct {
+	// Version is the version of the envelope.
+	Version uint8
+	// Type is the type of the payload.
+	Type TxType
+	// ForkVersion is the fork version the payload is encoded for, zero in
+	// the legacy layout.
+	ForkVersion uint32
+	// Payload is the payload of the transaction.
+	Payload []byte
+}
+
+// NewEnvelope returns an envelope of the current version.
+func NewEnvelope(
+	txType TxType,
+	forkVersion uint32,
+	payload []byte,
+) Envelope {
+	return Envelope{
+		Version:     EnvelopeVersion,
+		Type:        txType,
+		ForkVersion: forkVersion,
+		Payload:     payload,
+	}
+}
+
+// NewEnvelopeAt returns the envelope of a transaction of a proposal of the
+// given slot, which is a legacy envelope before the envelope fork of the
+// given chain spec.
+func NewEnvelopeAt(
+	cs common.ChainSpec,
+	slot math.Slot,
+	txType TxType,
+	payload []byte,
+) Envelope {
+	if !EnvelopedAt(cs, slot) {
+		return Envelope{
+			Version: LegacyEnvelopeVersion,
+			Type:    txType,
+			Payload: payload,
+		}
+	}
+	return NewEnvelope(txType, cs.ActiveForkVersionForSlot(slot), payload)
+}
+
+// EnvelopedAt returns true if the transactions of the proposals of the
+// given slot are envelopes, which they are from the envelope fork of the
+// given chain spec on. Before it, they are in the legacy layout.
+func EnvelopedAt(cs common.ChainSpec, slot math.Slot) bool {
+	return cs.SlotToEpoch(slot) >= cs.TxEnvelopeForkEpoch()
+}
+
+// CheckLayout checks that the given transactions of a proposal of the given
+// slot are in the layout of that slot.
+func CheckLayout(cs common.ChainSpec, slot math.Slot, txs [][]byte) error {
+	if len(txs) == 0 || IsEnvelope(txs[0]) == EnvelopedAt(cs, slot) {
+		return nil
+	}
+	return errors.Wrapf(ErrUnexpectedLayout, "slot %d", slot)
+}
+
+// IsLegacy returns true if the envelope wraps a transaction of the legacy
+// layout.
+func (e Envelope) IsLegacy() bool {
+	return e.Version == LegacyEnvelopeVersion
+}
+
+// Marshal returns the transaction of the envelope. Legacy envelopes are
+// marshalled to their bare payload.
+func (e Envelope) Marshal() []byte {
+	if e.IsLegacy() {
+		return e.Payload
+	}
+
+	tx := make([]byte, envelopeHeaderSize, envelopeHeaderSize+len(e.Payload))
+	copy(tx, envelopeMagic[:])
+	tx[versionOffset] = e.Version
+	tx[typeOffset] = byte(e.Type)
+	fork := version.FromUint32[[4]byte](e.ForkVersion)
+	copy(tx[forkVersionOffset:], fork[:])
+	return append(tx, e.Payload...)
+}
+
+// IsEnvelope returns true if the given transaction is an envelope rather
+// than a transaction of the legacy layout.
+func IsEnvelope(tx []byte) bool {
+	return bytes.HasPrefix(tx, envelopeMagic[:])
+}
+
+// UnmarshalEnvelope decodes the envelope of the given transaction. The
+// payload of the returned envelope aliases the transaction.
+func UnmarshalEnvelope(tx []byte) (Envelope, error) {
+	if !IsEnvelope(tx) {
+		return Envelope{}, ErrNotAnEnvelope
+	}
+	if len(tx) < envelopeHeaderSize {
+		return Envelope{}, ErrTruncatedEnvelope
+	}
+	if tx[versionOffset] != EnvelopeVersion {
+		return Envelope{}, errors.Wrapf(
+			ErrUnsupportedEnvelopeVersion, "version %d", tx[versionOffset],
+		)
+	}
+
+	return Envelope{
+		Version: tx[versionOffset],
+		Type:    TxType(tx[typeOffset]),
+		ForkVersion: version.ToUint32(
+			[4]byte(tx[forkVersionOffset:envelopeHeaderSize]),
+		),
+		Payload: tx[envelopeHeaderSize:],
+	}, nil
+}
+
+// UnmarshalEnvelopes decodes the envelopes of the given transactions. The
+// transactions are either all envelopes, or all in the legacy layout, in
+// which case they are wrapped in legacy envelopes typed by their position.
+// Every type appears at most once. Both formats are decoded through the
+// default registry.
+func UnmarshalEnvelopes(txs [][]byte) ([]Envelope, error) {
+	return defaultFormats.UnmarshalEnvelopes(txs)
+}
+
+// envelopeVersion returns the envelope version of the given transaction,
+// LegacyEnvelopeVersion if it is in the legacy layout.
+func envelopeVersion(tx []byte) (uint8, error) {
+	if !IsEnvelope(tx) {
+		return LegacyEnvelopeVersion, nil
+	}
+	if len(tx) < envelopeHeaderSize {
+		return 0, ErrTruncatedEnvelope
+	}
+	return tx[versionOffset], nil
+}
+
+// decodeLegacyTxs wraps the given transactions of the legacy layout in
+// legacy envelopes typed by their position.
+func decodeLegacyTxs(txs [][]byte) ([]Envelope, error) {
+	if len(txs) > len(legacyTxTypes) {
+		return nil, ErrTooManyLegacyTxs
+	}
+	envelopes := make([]Envelope, 0, len(txs))
+	for i, tx := range txs {
+		if IsEnvelope(tx) {
+			return nil, ErrMixedTxLayouts
+		}
+		envelopes = append(envelopes, Envelope{
+			Version: LegacyEnvelopeVersion,
+			Type:    legacyTxTypes[i],
+			Payload: tx,
+		})
+	}
+	return envelopes, nil
+}
+
+// decodeEnvelopedTxs decodes the envelopes of the given transactions, each
+// of a distinct type.
+func decodeEnvelopedTxs(txs [][]byte) ([]Envelope, error) {
+	envelopes := make([]Envelope, 0, len(txs))
+	seen := make(map[TxType]struct{}, len(txs))
+	for i, tx := range txs {
+		if !IsEnvelope(tx) {
+			return nil, ErrMixedTxLayouts
+		}
+		envelope, err := UnmarshalEnvelope(tx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "tx %d", i)
+		}
+		if _, ok := seen[envelope.Type]; ok {
+			return nil, errors.Wrap(
+				ErrDuplicateTxType, envelope.Type.String(),
+			)
+		}
+		seen[envelope.Type] = struct{}{}
+		envelopes = append(envelopes, envelope)
+	}
+	return envelopes, nil
+}
+
+// FindEnvelope returns the envelope of the given type among the
+// transactions of the given request.
+func FindEnvelope(req ABCIRequest, txType TxType) (Envelope, error) {
+	if req == nil {
+		return Envelope{}, ErrNilABCIRequest
+	}
+
+	envelopes, err := UnmarshalEnvelopes(req.GetTxs())
+	if err != nil {
+		return Envelope{}, err
+	}
+	for _, envelope := range envelopes {
+		if envelope.Type == txType {
+			return envelope, nil
+		}
+	}
+	return Envelope{}, errors.Wrap(ErrTxTypeNotFound, txType.String())
+}
+
+// checkForkVersion checks that the given envelope is encoded for the given
+// fork version, which legacy envelopes are assumed to be.
+func checkForkVersion(envelope Envelope, forkVersion uint32) error {
+	if envelope.IsLegacy() || envelope.ForkVersion == forkVersion {
+		return nil
+	}
+	return errors.Wrapf(
+		ErrForkVersionMismatch, "%s: expected %#08x, got %#08x",
+		envelope.Type, forkVersion, envelope.ForkVersion,
+	)
+}
@@ -0,0 +1,386 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encoding_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testForkVersion  uint32 = 0x04000000
+	otherForkVersion uint32 = 0x05000000
+)
+
+// testBlock is a beacon block encoded as its raw data.
+type testBlock struct {
+	forkVersion uint32
+	data        []byte
+}
+
+func (b *testBlock) NewFromSSZ(
+	bz []byte,
+	forkVersion uint32,
+) (*testBlock, error) {
+	if len(bz) == 0 {
+		return nil, errors.New("empty block")
+	}
+	return &testBlock{forkVersion: forkVersion, data: bz}, nil
+}
+
+func (b *testBlock) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, b.data...), nil
+}
+
+func (b *testBlock) MarshalSSZ() ([]byte, error) {
+	return b.MarshalSSZTo(nil)
+}
+
+func (b *testBlock) UnmarshalSSZ(bz []byte) error {
+	b.data = append([]byte(nil), bz...)
+	return nil
+}
+
+func (b *testBlock) SizeSSZ() int {
+	return len(b.data)
+}
+
+func (b *testBlock) HashTreeRoot() ([32]byte, error) {
+	return sha256.Sum256(b.data), nil
+}
+
+// testSidecars are sidecars encoded as their raw data.
+type testSidecars struct {
+	testBlock
+}
+
+func request(txs ...[]byte) *cmtabci.ProcessProposalRequest {
+	return &cmtabci.ProcessProposalRequest{Txs: txs}
+}
+
+func envelope(txType encoding.TxType, forkVersion uint32, p []byte) []byte {
+	return encoding.NewEnvelope(txType, forkVersion, p).Marshal()
+}
+
+func TestEnvelope_RoundTrip(t *testing.T) {
+	tx := envelope(encoding.TxTypeBeaconBlock, testForkVersion, []byte("blk"))
+	require.True(t, encoding.IsEnvelope(tx))
+
+	got, err := encoding.UnmarshalEnvelope(tx)
+	require.NoError(t, err)
+	require.Equal(t, encoding.EnvelopeVersion, got.Version)
+	require.Equal(t, encoding.TxTypeBeaconBlock, got.Type)
+	require.Equal(t, testForkVersion, got.ForkVersion)
+	require.Equal(t, []byte("blk"), got.Payload)
+	require.Equal(t, tx, got.Marshal())
+}
+
+func TestUnmarshalEnvelope_Malformed(t *testing.T) {
+	tx := envelope(encoding.TxTypeBeaconBlock, testForkVersion, nil)
+
+	_, err := encoding.UnmarshalEnvelope([]byte("blk"))
+	require.ErrorIs(t, err, encoding.ErrNotAnEnvelope)
+
+	_, err = encoding.UnmarshalEnvelope(tx[:len(tx)-1])
+	require.ErrorIs(t, err, encoding.ErrTruncatedEnvelope)
+
+	tx[8]++
+	_, err = encoding.UnmarshalEnvelope(tx)
+	require.ErrorIs(t, err, encoding.ErrUnsupportedEnvelopeVersion)
+}
+
+func TestUnmarshalEnvelopes(t *testing.T) {
+	blk := envelope(encoding.TxTypeBeaconBlock, testForkVersion, []byte("b"))
+	sidecars := envelope(encoding.TxTypeBlobSidecars, testForkVersion, nil)
+
+	// Envelopes are found whatever their position.
+	envelopes, err := encoding.UnmarshalEnvelopes([][]byte{sidecars, blk})
+	require.NoError(t, err)
+	require.Len(t, envelopes, 2)
+	require.Equal(t, encoding.TxTypeBlobSidecars, envelopes[0].Type)
+	require.Equal(t, encoding.TxTypeBeaconBlock, envelopes[1].Type)
+
+	// Transactions of the legacy layout are typed by their position.
+	envelopes, err = encoding.UnmarshalEnvelopes(
+		[][]byte{[]byte("b"), []byte("s")},
+	)
+	require.NoError(t, err)
+	require.True(t, envelopes[0].IsLegacy())
+	require.Equal(t, encoding.TxTypeBeaconBlock, envelopes[0].Type)
+	require.Equal(t, encoding.TxTypeBlobSidecars, envelopes[1].Type)
+	require.Equal(t, []byte("s"), envelopes[1].Marshal())
+
+	for _, tc := range []struct {
+		name string
+		txs  [][]byte
+		err  error
+	}{
+		{"mixed", [][]byte{blk, []byte("s")}, encoding.ErrMixedTxLayouts},
+		{"mixed legacy", [][]byte{{}, blk}, encoding.ErrMixedTxLayouts},
+		{"duplicate", [][]byte{blk, blk}, encoding.ErrDuplicateTxType},
+		{
+			"too many legacy",
+			[][]byte{{1}, {2}, {3}},
+			encoding.ErrTooManyLegacyTxs,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err = encoding.UnmarshalEnvelopes(tc.txs)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestExtractBlobsAndBlockFromRequest(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		req  *cmtabci.ProcessProposalRequest
+	}{
+		{
+			"envelopes",
+			request(
+				envelope(
+					encoding.TxTypeBlobSidecars, testForkVersion, []byte("s"),
+				),
+				envelope(
+					encoding.TxTypeBeaconBlock, testForkVersion, []byte("b"),
+				),
+			),
+		},
+		{"legacy", request([]byte("b"), []byte("s"))},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			blk, sidecars, err := encoding.ExtractBlobsAndBlockFromRequest[
+				*testBlock, *testSidecars,
+			](tc.req, testForkVersion)
+			require.NoError(t, err)
+			require.Equal(t, []byte("b"), blk.data)
+			require.Equal(t, testForkVersion, blk.forkVersion)
+			require.Equal(t, []byte("s"), sidecars.data)
+		})
+	}
+}
+
+func TestUnmarshalBeaconBlockFromABCIRequest_Errors(t *testing.T) {
+	_, err := encoding.UnmarshalBeaconBlockFromABCIRequest[*testBlock](
+		nil, testForkVersion,
+	)
+	require.ErrorIs(t, err, encoding.ErrNilABCIRequest)
+
+	_, err = encoding.UnmarshalBeaconBlockFromABCIRequest[*testBlock](
+		request(), testForkVersion,
+	)
+	require.ErrorIs(t, err, encoding.ErrNoBeaconBlockInRequest)
+
+	_, err = encoding.UnmarshalBeaconBlockFromABCIRequest[*testBlock](
+		request(envelope(encoding.TxTypeBlobSidecars, testForkVersion, nil)),
+		testForkVersion,
+	)
+	require.ErrorIs(t, err, encoding.ErrTxTypeNotFound)
+
+	_, err = encoding.UnmarshalBeaconBlockFromABCIRequest[*testBlock](
+		request(envelope(encoding.TxTypeBeaconBlock, otherForkVersion, nil)),
+		testForkVersion,
+	)
+	require.ErrorIs(t, err, encoding.ErrForkVersionMismatch)
+}
+
+func TestNewEnvelopeAt(t *testing.T) {
+	cs := chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress,
+		math.Slot, any,
+	]{
+		SlotsPerEpoch:       1,
+		TxEnvelopeForkEpoch: 2,
+		ElectraForkEpoch:    10,
+	})
+
+	// Before the fork, proposals are in the legacy layout.
+	legacy := encoding.NewEnvelopeAt(
+		cs, 1, encoding.TxTypeBeaconBlock, []byte("b"),
+	).Marshal()
+	require.Equal(t, []byte("b"), legacy)
+	require.NoError(t, encoding.CheckLayout(cs, 1, [][]byte{legacy}))
+	require.ErrorIs(
+		t,
+		encoding.CheckLayout(cs, 2, [][]byte{legacy}),
+		encoding.ErrUnexpectedLayout,
+	)
+
+	// From it, they are enveloped for the fork version of their slot.
+	tx := encoding.NewEnvelopeAt(
+		cs, 2, encoding.TxTypeBeaconBlock, []byte("b"),
+	).Marshal()
+	got, err := encoding.UnmarshalEnvelope(tx)
+	require.NoError(t, err)
+	require.Equal(t, cs.ActiveForkVersionForSlot(2), got.ForkVersion)
+	require.NoError(t, encoding.CheckLayout(cs, 2, [][]byte{tx}))
+	require.ErrorIs(
+		t,
+		encoding.CheckLayout(cs, 1, [][]byte{tx}),
+		encoding.ErrUnexpectedLayout,
+	)
+}
+
+func TestRegistry(t *testing.T) {
+	registry := encoding.NewDefaultRegistry[*testBlock, *testSidecars]()
+	require.ErrorIs(
+		t,
+		registry.Register(
+			encoding.TxTypeBeaconBlock,
+			encoding.BeaconBlockDecoder[*testBlock](),
+		),
+		encoding.ErrDecoderAlreadyRegistered,
+	)
+
+	root := common.Root{1}
+	decoded, err := registry.DecodeRequest(
+		request(
+			envelope(encoding.TxTypeBeaconBlock, testForkVersion, []byte("b")),
+			envelope(encoding.TxTypeBlobSidecarsRoot, testForkVersion, root[:]),
+		),
+		testForkVersion,
+	)
+	require.NoError(t, err)
+	require.Len(t, decoded, 2)
+	blk, ok := decoded[encoding.TxTypeBeaconBlock].(*testBlock)
+	require.True(t, ok)
+	require.Equal(t, []byte("b"), blk.data)
+	require.Equal(t, root, decoded[encoding.TxTypeBlobSidecarsRoot])
+
+	// The legacy layout is decoded by its own registered decoder.
+	decoded, err = registry.DecodeRequest(
+		request([]byte("b"), []byte("s")), testForkVersion,
+	)
+	require.NoError(t, err)
+	blk, ok = decoded[encoding.TxTypeBeaconBlock].(*testBlock)
+	require.True(t, ok)
+	require.Equal(t, []byte("b"), blk.data)
+
+	_, err = encoding.NewRegistry().DecodeRequest(
+		request(envelope(encoding.TxTypeBeaconBlock, testForkVersion, nil)),
+		testForkVersion,
+	)
+	require.ErrorIs(t, err, encoding.ErrUnsupportedEnvelopeVersion)
+
+	_, err = registry.DecodeRequest(
+		request(envelope(encoding.TxTypeBlobSidecarsRoot, 0, []byte{1})),
+		0,
+	)
+	require.ErrorIs(t, err, encoding.ErrInvalidRoot)
+}
+
+func TestRegistry_Formats(t *testing.T) {
+	registry := encoding.NewRegistry()
+	require.NoError(t, registry.Register(
+		encoding.TxTypeBeaconBlock, encoding.BeaconBlockDecoder[*testBlock](),
+	))
+
+	// Without a decoder of their format, transactions are not decoded.
+	_, err := registry.DecodeRequest(request([]byte("b")), testForkVersion)
+	require.ErrorIs(t, err, encoding.ErrUnsupportedEnvelopeVersion)
+
+	// A decoder registered for a format decodes every transaction in it.
+	require.NoError(t, registry.RegisterFormat(
+		encoding.LegacyEnvelopeVersion,
+		func(txs [][]byte) ([]encoding.Envelope, error) {
+			return []encoding.Envelope{{
+				Version: encoding.LegacyEnvelopeVersion,
+				Type:    encoding.TxTypeBeaconBlock,
+				Payload: txs[len(txs)-1],
+			}}, nil
+		},
+	))
+	require.ErrorIs(
+		t,
+		registry.RegisterFormat(encoding.LegacyEnvelopeVersion, nil),
+		encoding.ErrDecoderAlreadyRegistered,
+	)
+	decoded, err := registry.DecodeRequest(
+		request([]byte("s"), []byte("b")), testForkVersion,
+	)
+	require.NoError(t, err)
+	blk, ok := decoded[encoding.TxTypeBeaconBlock].(*testBlock)
+	require.True(t, ok)
+	require.Equal(t, []byte("b"), blk.data)
+}
+
+func FuzzUnmarshalEnvelopes(f *testing.F) {
+	f.Add([]byte("b"), []byte("s"))
+	f.Add(
+		envelope(encoding.TxTypeBeaconBlock, testForkVersion, []byte("b")),
+		envelope(encoding.TxTypeBlobSidecars, testForkVersion, []byte("s")),
+	)
+	f.Add(envelope(encoding.TxTypeBeaconBlock, 0, nil)[:9], []byte{})
+	f.Fuzz(func(t *testing.T, tx0, tx1 []byte) {
+		txs := [][]byte{tx0, tx1}
+		envelopes, err := encoding.UnmarshalEnvelopes(txs)
+		if err != nil {
+			return
+		}
+
+		// Decoded transactions marshal back to themselves, and are either
+		// all legacy or all envelopes of distinct types.
+		require.Len(t, envelopes, len(txs))
+		seen := make(map[encoding.TxType]struct{})
+		for i, e := range envelopes {
+			require.True(t, bytes.Equal(txs[i], e.Marshal()))
+			require.Equal(t, envelopes[0].IsLegacy(), e.IsLegacy())
+			require.NotContains(t, seen, e.Type)
+			seen[e.Type] = struct{}{}
+		}
+	})
+}
+
+func FuzzExtractBlobsAndBlockFromRequest(f *testing.F) {
+	f.Add([]byte("b"), []byte("s"), testForkVersion)
+	f.Add(
+		envelope(encoding.TxTypeBeaconBlock, testForkVersion, []byte("b")),
+		envelope(encoding.TxTypeBlobSidecars, testForkVersion, []byte("s")),
+		testForkVersion,
+	)
+	f.Add(
+		envelope(encoding.TxTypeBlobSidecars, otherForkVersion, []byte("s")),
+		envelope(encoding.TxTypeBeaconBlock, otherForkVersion, []byte("b")),
+		testForkVersion,
+	)
+	f.Fuzz(func(t *testing.T, tx0, tx1 []byte, forkVersion uint32) {
+		blk, _, err := encoding.ExtractBlobsAndBlockFromRequest[
+			*testBlock, *testSidecars,
+		](request(tx0, tx1), forkVersion)
+		if err != nil {
+			return
+		}
+
+		// A decoded block is always decoded for the expected fork version,
+		// whatever the fork version its envelope claims.
+		require.Equal(t, forkVersion, blk.forkVersion)
+	})
+}
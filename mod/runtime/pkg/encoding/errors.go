@@ -47,4 +47,54 @@ var (
 	ErrNilBlobSidecarsInRequest = errors.New(
 		"nil blob sidecars in abci request",
 	)
+
+	// ErrNotAnEnvelope is an error for when a transaction is not an
+	// envelope.
+	ErrNotAnEnvelope = errors.New("transaction is not an envelope")
+
+	// ErrTruncatedEnvelope is an error for when a transaction is too short
+	// to hold the header of an envelope.
+	ErrTruncatedEnvelope = errors.New("truncated envelope")
+
+	// ErrUnsupportedEnvelopeVersion is an error for when the version of an
+	// envelope is not supported.
+	ErrUnsupportedEnvelopeVersion = errors.New("unsupported envelope version")
+
+	// ErrMixedTxLayouts is an error for when envelopes and transactions of
+	// the legacy layout are mixed in a request.
+	ErrMixedTxLayouts = errors.New(
+		"envelopes mixed with legacy transactions in abci request",
+	)
+
+	// ErrTooManyLegacyTxs is an error for when a request in the legacy
+	// layout holds more transactions than the layout defines.
+	ErrTooManyLegacyTxs = errors.New("too many legacy transactions")
+
+	// ErrDuplicateTxType is an error for when a type of transaction appears
+	// more than once in a request.
+	ErrDuplicateTxType = errors.New("duplicate transaction type")
+
+	// ErrTxTypeNotFound is an error for when no transaction of a type is
+	// found in a request.
+	ErrTxTypeNotFound = errors.New("transaction type not found")
+
+	// ErrForkVersionMismatch is an error for when the fork version of an
+	// envelope is not the one expected.
+	ErrForkVersionMismatch = errors.New("fork version mismatch")
+
+	// ErrNoDecoder is an error for when no decoder is registered for a
+	// type of transaction.
+	ErrNoDecoder = errors.New("no decoder registered")
+
+	// ErrDecoderAlreadyRegistered is an error for when a decoder is
+	// registered twice for a type of transaction or an envelope version.
+	ErrDecoderAlreadyRegistered = errors.New("decoder already registered")
+
+	// ErrUnexpectedLayout is an error for when the transactions of a
+	// proposal are not in the layout of its slot.
+	ErrUnexpectedLayout = errors.New("unexpected transaction layout")
+
+	// ErrInvalidRoot is an error for when a transaction does not hold a
+	// root.
+	ErrInvalidRoot = errors.New("invalid root")
 )
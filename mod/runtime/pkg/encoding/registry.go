@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encoding
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// Decoder decodes the payload of a transaction, encoded for the given fork
+// version.
+type Decoder func(forkVersion uint32, payload []byte) (any, error)
+
+// FormatDecoder decodes the transactions of a proposal, all in the same
+// format, into envelopes.
+type FormatDecoder func(txs [][]byte) ([]Envelope, error)
+
+// defaultFormats holds the decoders of the formats of the transactions of
+// proposals, through which UnmarshalEnvelopes decodes them.
+//
+//nolint:gochecknoglobals // registered once.
+var defaultFormats = newFormatRegistry()
+
+// Registry holds the decoders of the formats of the transactions of
+// proposals, by envelope version, and of their payloads, by type.
+type Registry struct {
+	// formats holds the decoders of the formats by envelope version, the
+	// legacy layout being LegacyEnvelopeVersion.
+	formats map[uint8]FormatDecoder
+	// decoders holds the decoders of the payloads by type of transaction.
+	decoders map[TxType]Decoder
+}
+
+// NewRegistry creates a new registry without decoders.
+func NewRegistry() *Registry {
+	return &Registry{
+		formats:  make(map[uint8]FormatDecoder),
+		decoders: make(map[TxType]Decoder),
+	}
+}
+
+// NewDefaultRegistry creates a new registry holding the decoders of the
+// legacy layout and of envelopes, and of the transactions of beacon blocks
+// and of their blob sidecars.
+func NewDefaultRegistry[
+	BeaconBlockT BeaconBlock[BeaconBlockT],
+	BlobSidecarsT interface{ UnmarshalSSZ([]byte) error },
+]() *Registry {
+	r := newFormatRegistry()
+	r.decoders = map[TxType]Decoder{
+		TxTypeBeaconBlock:      BeaconBlockDecoder[BeaconBlockT](),
+		TxTypeBlobSidecars:     SSZDecoder[BlobSidecarsT](),
+		TxTypeBlobSidecarsRoot: RootDecoder(),
+	}
+	return r
+}
+
+// newFormatRegistry creates a new registry holding the decoders of the
+// legacy layout and of envelopes only.
+func newFormatRegistry() *Registry {
+	r := NewRegistry()
+	r.formats[LegacyEnvelopeVersion] = decodeLegacyTxs
+	r.formats[EnvelopeVersion] = decodeEnvelopedTxs
+	return r
+}
+
+// RegisterFormat registers the decoder of the transactions enveloped with
+// the given version.
+func (r *Registry) RegisterFormat(
+	version uint8,
+	decoder FormatDecoder,
+) error {
+	if _, ok := r.formats[version]; ok {
+		return errors.Wrapf(
+			ErrDecoderAlreadyRegistered, "envelope version %d", version,
+		)
+	}
+	r.formats[version] = decoder
+	return nil
+}
+
+// Register registers the decoder of the given type of transaction.
+func (r *Registry) Register(txType TxType, decoder Decoder) error {
+	if _, ok := r.decoders[txType]; ok {
+		return errors.Wrap(ErrDecoderAlreadyRegistered, txType.String())
+	}
+	r.decoders[txType] = decoder
+	return nil
+}
+
+// UnmarshalEnvelopes decodes the envelopes of the given transactions with
+// the decoder of their format, told by the first of them.
+func (r *Registry) UnmarshalEnvelopes(txs [][]byte) ([]Envelope, error) {
+	if len(txs) == 0 {
+		return nil, nil
+	}
+
+	version, err := envelopeVersion(txs[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "tx 0")
+	}
+	decoder, ok := r.formats[version]
+	if !ok {
+		return nil, errors.Wrapf(
+			ErrUnsupportedEnvelopeVersion, "tx 0: version %d", version,
+		)
+	}
+	return decoder(txs)
+}
+
+// Decode decodes the payload of the given envelope. Legacy envelopes are
+// decoded for the given fork version, other envelopes must carry it.
+func (r *Registry) Decode(envelope Envelope, forkVersion uint32) (any, error) {
+	decoder, ok := r.decoders[envelope.Type]
+	if !ok {
+		return nil, errors.Wrap(ErrNoDecoder, envelope.Type.String())
+	}
+	if err := checkForkVersion(envelope, forkVersion); err != nil {
+		return nil, err
+	}
+	return decoder(forkVersion, envelope.Payload)
+}
+
+// DecodeRequest decodes the payloads of all the transactions of the given
+// request, by type, for the given fork version.
+func (r *Registry) DecodeRequest(
+	req ABCIRequest,
+	forkVersion uint32,
+) (map[TxType]any, error) {
+	if req == nil {
+		return nil, ErrNilABCIRequest
+	}
+
+	envelopes, err := r.UnmarshalEnvelopes(req.GetTxs())
+	if err != nil {
+		return nil, err
+	}
+	decoded := make(map[TxType]any, len(envelopes))
+	for _, envelope := range envelopes {
+		if decoded[envelope.Type], err = r.Decode(
+			envelope, forkVersion,
+		); err != nil {
+			return nil, errors.Wrap(err, envelope.Type.String())
+		}
+	}
+	return decoded, nil
+}
+
+// BeaconBlockDecoder returns a decoder of the SSZ encoding of beacon blocks.
+func BeaconBlockDecoder[BeaconBlockT BeaconBlock[BeaconBlockT]]() Decoder {
+	return func(forkVersion uint32, payload []byte) (any, error) {
+		var blk BeaconBlockT
+		return blk.NewFromSSZ(payload, forkVersion)
+	}
+}
+
+// SSZDecoder returns a decoder of the SSZ encoding of T, which must be a
+// pointer type.
+func SSZDecoder[T interface{ UnmarshalSSZ([]byte) error }]() Decoder {
+	return func(_ uint32, payload []byte) (any, error) {
+		out, err := newSSZInstance[T]()
+		if err != nil {
+			return nil, err
+		}
+		return out, out.UnmarshalSSZ(payload)
+	}
+}
+
+// RootDecoder returns a decoder of roots.
+func RootDecoder() Decoder {
+	return func(_ uint32, payload []byte) (any, error) {
+		if len(payload) != len(common.Root{}) {
+			return nil, ErrInvalidRoot
+		}
+		return common.Root(payload), nil
+	}
+}
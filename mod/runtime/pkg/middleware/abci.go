@@ -31,10 +31,14 @@ import (
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/telemetry"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
+	rp2p "github.com/berachain/beacon-kit/mod/runtime/pkg/p2p"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sourcegraph/conc/iter"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -63,6 +67,11 @@ func (h *ABCIMiddleware[
 	if err := json.Unmarshal(bz, data); err != nil {
 		return nil, err
 	}
+
+	// The block time of InitChain is the genesis time of the chain.
+	if sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context); ok {
+		h.slotClock.SetGenesisTime(sdkCtx.HeaderInfo().Time)
+	}
 	updates, err := h.chainService.ProcessGenesisData(
 		ctx,
 		*data,
@@ -96,19 +105,28 @@ func (h *ABCIMiddleware[
 ]) prepareProposal(
 	ctx sdk.Context,
 	req *cmtabci.PrepareProposalRequest,
-) (*cmtabci.PrepareProposalResponse, error) {
+) (_ *cmtabci.PrepareProposalResponse, err error) {
 	var (
 		wg                          sync.WaitGroup
 		startTime                   = time.Now()
 		beaconBlockErr, sidecarsErr error
 		beaconBlockBz, sidecarsBz   []byte
+		slot                        = math.Slot(req.Height)
 	)
 	defer h.metrics.measurePrepareProposalDuration(startTime)
 
+	spanCtx, span := h.tracer.Start(
+		ctx, "PrepareProposal",
+		trace.WithAttributes(attribute.Int64("slot", req.Height)),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+	ctx = ctx.WithContext(spanCtx)
+	h.slotClock.OnBlock(slot, req.Time)
+
 	// Send a request to the validator service to give us a beacon block
 	// and blob sidecards to pass to ABCI.
 	h.slotFeed.Send(asynctypes.NewEvent(
-		ctx, events.NewSlot, math.Slot(req.Height),
+		ctx, events.NewSlot, slot,
 	))
 
 	// Using a wait group instead of an errgroup to ensure we drain
@@ -117,12 +135,12 @@ func (h *ABCIMiddleware[
 	wg.Add(2)
 	go func() {
 		defer wg.Done()
-		beaconBlockBz, beaconBlockErr = h.waitforBeaconBlk(ctx)
+		beaconBlockBz, beaconBlockErr = h.waitforBeaconBlk(ctx, slot)
 	}()
 
 	go func() {
 		defer wg.Done()
-		sidecarsBz, sidecarsErr = h.waitForSidecars(ctx)
+		sidecarsBz, sidecarsErr = h.waitForSidecars(ctx, slot)
 	}()
 
 	wg.Wait()
@@ -132,15 +150,29 @@ func (h *ABCIMiddleware[
 		return nil, sidecarsErr
 	}
 
+	// From the envelope fork on, the transactions are enveloped with their
+	// type and the fork version of the slot, so that they do not depend on
+	// their position. Before it, the block comes first and the sidecars
+	// second.
 	return &cmtabci.PrepareProposalResponse{
-		Txs: [][]byte{beaconBlockBz, sidecarsBz},
+		Txs: [][]byte{
+			encoding.NewEnvelopeAt(
+				h.chainSpec, slot,
+				h.beaconBlockGossiper.TxType(slot), beaconBlockBz,
+			).Marshal(),
+			encoding.NewEnvelopeAt(
+				h.chainSpec, slot,
+				h.blobGossiper.TxType(slot), sidecarsBz,
+			).Marshal(),
+		},
 	}, nil
 }
 
-// waitForSidecars waits for the sidecars to be built and returns them.
+// waitForSidecars waits for the sidecars of the given slot to be built and
+// publishes them.
 func (h *ABCIMiddleware[
 	_, _, _, _, _, _, _,
-]) waitForSidecars(gCtx context.Context) ([]byte, error) {
+]) waitForSidecars(gCtx context.Context, slot math.Slot) ([]byte, error) {
 	select {
 	case <-gCtx.Done():
 		return nil, gCtx.Err()
@@ -151,7 +183,9 @@ func (h *ABCIMiddleware[
 			return nil, sidecars.Error()
 		}
 
-		sidecarsBz, err := h.blobGossiper.Publish(gCtx, sidecars.Data())
+		sidecarsBz, err := h.blobGossiper.Publish(
+			gCtx, slot, sidecars.Data(),
+		)
 		if err != nil {
 			h.logger.Error("failed to publish blobs", "error", err)
 		}
@@ -159,10 +193,11 @@ func (h *ABCIMiddleware[
 	}
 }
 
-// waitforBeaconBlk waits for the beacon block to be built and returns it.
+// waitforBeaconBlk waits for the beacon block of the given slot to be built
+// and publishes it.
 func (h *ABCIMiddleware[
 	_, _, _, _, _, _, _,
-]) waitforBeaconBlk(gCtx context.Context) ([]byte, error) {
+]) waitforBeaconBlk(gCtx context.Context, slot math.Slot) ([]byte, error) {
 	select {
 	case <-gCtx.Done():
 		return nil, gCtx.Err()
@@ -174,6 +209,7 @@ func (h *ABCIMiddleware[
 		}
 		beaconBlockBz, err := h.beaconBlockGossiper.Publish(
 			gCtx,
+			slot,
 			beaconBlock.Data(),
 		)
 		if err != nil {
@@ -200,39 +236,45 @@ func (h *ABCIMiddleware[
 
 // processProposal is the internal handler for processing proposals.
 func (h *ABCIMiddleware[
-	_, BeaconBlockT, _, BlobSidecarsT, _, _, _,
+	_, _, _, _, _, _, _,
 ]) processProposal(
 	ctx sdk.Context,
 	req *cmtabci.ProcessProposalRequest,
-) (*cmtabci.ProcessProposalResponse, error) {
+) (_ *cmtabci.ProcessProposalResponse, err error) {
 	var (
-		blk       BeaconBlockT
-		sidecars  BlobSidecarsT
-		err       error
-		g, _      = errgroup.WithContext(ctx)
 		startTime = time.Now()
 		args      = []any{"beacon_block", true, "blob_sidecars", true}
 	)
 	defer h.metrics.measureProcessProposalDuration(startTime)
 
+	spanCtx, span := h.tracer.Start(
+		ctx, "ProcessProposal",
+		trace.WithAttributes(attribute.Int64("slot", req.Height)),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+	ctx = ctx.WithContext(spanCtx)
+	h.slotClock.OnBlock(math.Slot(req.Height), req.Time)
+	g, _ := errgroup.WithContext(ctx)
+
 	// Decode the beacon block and emit an event.
-	blk, err = h.beaconBlockGossiper.Request(ctx, req)
-	if err != nil {
+	blk, blkErr := h.beaconBlockGossiper.Request(ctx, req)
+	if blkErr != nil {
 		args[1] = false
 	}
 
+	// Each goroutine has its own error, as they run concurrently.
 	g.Go(func() error {
 		// Emit event to notify the block has been received.
 		h.blkFeed.Send(asynctypes.NewEvent(
-			ctx, events.BeaconBlockReceived, blk, err,
+			ctx, events.BeaconBlockReceived, blk, blkErr,
 		))
 
-		if err = h.chainService.ReceiveBlock(
+		if err := h.chainService.ReceiveBlock(
 			ctx, blk,
-		); !errors.IsFatal(err) {
-			err = nil
+		); errors.IsFatal(err) {
+			return err
 		}
-		return err
+		return nil
 	})
 
 	g.Go(func() error {
@@ -242,23 +284,28 @@ func (h *ABCIMiddleware[
 			return nil
 		}
 
-		// Decode the blob sidecars and emit an event.
-		sidecars, err = h.blobGossiper.Request(ctx, req)
-		if err != nil {
+		// Obtain the blob sidecars and emit an event. A proposal whose
+		// sidecars are not available in time is rejected, which is where
+		// the availability of gossiped sidecars is decided.
+		sidecars, sidecarsErr := h.blobGossiper.Request(ctx, req)
+		if sidecarsErr != nil {
 			args[3] = false
 		}
+		if errors.Is(sidecarsErr, rp2p.ErrSidecarsUnavailable) {
+			return sidecarsErr
+		}
 
 		// Emit event to notify the sidecars have been received.
 		h.sidecarsFeed.Send(asynctypes.NewEvent(
-			ctx, events.BlobSidecarsReceived, sidecars, err,
+			ctx, events.BlobSidecarsReceived, sidecars, sidecarsErr,
 		))
 
-		if err = h.daService.ReceiveSidecars(
+		if err := h.daService.ReceiveSidecars(
 			ctx, blk.GetSlot(), sidecars,
-		); !errors.IsFatal(err) {
-			err = nil
+		); errors.IsFatal(err) {
+			return err
 		}
-		return err
+		return nil
 	})
 
 	resp := &cmtabci.ProcessProposalResponse{
@@ -287,6 +334,7 @@ func (h *ABCIMiddleware[
 	_ sdk.Context, req *cmtabci.FinalizeBlockRequest,
 ) error {
 	h.req = req
+	h.slotClock.OnBlock(math.Slot(req.Height), req.Time)
 	return nil
 }
 
@@ -295,29 +343,46 @@ func (h *ABCIMiddleware[
 	_, BeaconBlockT, _, BlobSidecarsT, _, _, _,
 ]) EndBlock(
 	ctx context.Context,
-) ([]appmodulev2.ValidatorUpdate, error) {
-	blk, blobs, err := encoding.
-		ExtractBlobsAndBlockFromRequest[BeaconBlockT, BlobSidecarsT](
-		h.req,
-		BeaconBlockTxIndex,
-		BlobSidecarsTxIndex,
-		h.chainSpec.ActiveForkVersionForSlot(
-			math.Slot(h.req.Height),
-		))
+) (_ []appmodulev2.ValidatorUpdate, err error) {
+	ctx, span := h.tracer.Start(
+		ctx, "EndBlock",
+		trace.WithAttributes(attribute.Int64("slot", h.req.Height)),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	blk, err := h.beaconBlockGossiper.Request(ctx, h.req)
 	if err != nil {
 		// If we don't have a block, we can't do anything.
 		//nolint:nilerr // by design.
 		return nil, nil
 	}
 
-	// TODO: Move to Async.
-	if err = h.daService.ProcessSidecars(
-		ctx, blk.GetSlot(), blobs,
-	); err != nil {
-		return nil, err
+	// The sidecars of a finalized block are obtained to be stored, even
+	// when they are no longer carried by the block. Gossiped sidecars that
+	// cannot be obtained are skipped, as their availability was voted on
+	// in ProcessProposal, so that finalizing the block does not depend on
+	// the peers of each node.
+	blobs, err := h.blobGossiper.Request(ctx, h.req)
+	switch {
+	case errors.Is(err, rp2p.ErrSidecarsUnavailable):
+		h.skipSidecars(blk.GetSlot(), err)
+	case err != nil:
+		//nolint:nilerr // by design.
+		return nil, nil
+	default:
+		// The sidecars are written to the availability store, on disk and
+		// outside of the app state, but are stored before the block is
+		// processed since it is only accepted once its blobs are available.
+		if err = h.daService.ProcessSidecars(
+			ctx, blk.GetSlot(), blobs,
+		); err != nil {
+			return nil, err
+		}
 	}
 
-	// TODO: Move to Async.
+	// The state transition determines the app hash and the validator
+	// updates, so it runs before EndBlock returns. The work left once the
+	// block is finalized is run by the chain service off the critical path.
 	valUpdates, err := h.chainService.ProcessBeaconBlock(ctx, blk)
 	if err != nil {
 		return nil, err
@@ -327,3 +392,14 @@ func (h *ABCIMiddleware[
 		valUpdates.RemoveDuplicates().Sort(), convertValidatorUpdate,
 	)
 }
+
+// skipSidecars logs that the gossiped sidecars of the finalized block at the
+// given slot could not be obtained and are skipped.
+func (h *ABCIMiddleware[
+	_, _, _, _, _, _, _,
+]) skipSidecars(slot math.Slot, err error) {
+	h.logger.Warn(
+		"Skipping blob sidecars that could not be obtained",
+		"slot", slot.Base10(), "error", err,
+	)
+}
@@ -0,0 +1,279 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package middleware_test
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/event"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/middleware"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/p2p"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+// testBlock is a beacon block encoded as its slot.
+type testBlock struct {
+	slot math.Slot
+}
+
+func (b *testBlock) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(dst, b.slot.Unwrap()), nil
+}
+
+func (b *testBlock) MarshalSSZ() ([]byte, error) {
+	return b.MarshalSSZTo(nil)
+}
+
+func (b *testBlock) UnmarshalSSZ(bz []byte) error {
+	if len(bz) != b.SizeSSZ() {
+		return errors.New("invalid block")
+	}
+	b.slot = math.Slot(binary.LittleEndian.Uint64(bz))
+	return nil
+}
+
+func (b *testBlock) SizeSSZ() int {
+	return 8
+}
+
+func (b *testBlock) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	binary.LittleEndian.PutUint64(root[:], b.slot.Unwrap())
+	return root, nil
+}
+
+func (b *testBlock) IsNil() bool {
+	return b == nil
+}
+
+func (b *testBlock) GetSlot() math.Slot {
+	return b.slot
+}
+
+func (b *testBlock) NewFromSSZ(bz []byte, _ uint32) (*testBlock, error) {
+	blk := new(testBlock)
+	return blk, blk.UnmarshalSSZ(bz)
+}
+
+// testSidecars are sidecars without any content.
+type testSidecars struct{}
+
+func (s *testSidecars) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return dst, nil
+}
+
+func (s *testSidecars) MarshalSSZ() ([]byte, error) {
+	return nil, nil
+}
+
+func (s *testSidecars) UnmarshalSSZ([]byte) error {
+	return nil
+}
+
+func (s *testSidecars) SizeSSZ() int {
+	return 0
+}
+
+func (s *testSidecars) HashTreeRoot() ([32]byte, error) {
+	return [32]byte{}, nil
+}
+
+// unavailableGossiper never obtains the sidecars of a proposal.
+type unavailableGossiper struct{}
+
+func (unavailableGossiper) Publish(
+	context.Context, math.Slot, *testSidecars,
+) ([]byte, error) {
+	return nil, nil
+}
+
+func (unavailableGossiper) Request(
+	context.Context, encoding.ABCIRequest,
+) (*testSidecars, error) {
+	return nil, p2p.ErrSidecarsUnavailable
+}
+
+func (unavailableGossiper) TxType(math.Slot) encoding.TxType {
+	return encoding.TxTypeBlobSidecarsRoot
+}
+
+// recorder records the blocks processed and the sidecars stored.
+type recorder struct {
+	blocks   []math.Slot
+	sidecars []math.Slot
+}
+
+func (r *recorder) ProcessGenesisData(
+	context.Context, *json.RawMessage,
+) (transition.ValidatorUpdates, error) {
+	return nil, nil
+}
+
+func (r *recorder) ProcessBeaconBlock(
+	_ context.Context, blk *testBlock,
+) (transition.ValidatorUpdates, error) {
+	r.blocks = append(r.blocks, blk.GetSlot())
+	return nil, nil
+}
+
+func (r *recorder) ReceiveBlock(context.Context, *testBlock) error {
+	return nil
+}
+
+func (r *recorder) ProcessSidecars(
+	_ context.Context, slot math.Slot, _ *testSidecars,
+) error {
+	r.sidecars = append(r.sidecars, slot)
+	return nil
+}
+
+func (r *recorder) ReceiveSidecars(
+	context.Context, math.Slot, *testSidecars,
+) error {
+	return nil
+}
+
+// noopClock is a slot clock ignoring the time of blocks.
+type noopClock struct{}
+
+func (noopClock) SetGenesisTime(time.Time) {}
+
+func (noopClock) OnBlock(math.Slot, time.Time) {}
+
+type noopSink struct{}
+
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+// testChainSpec is a chain spec with one slot per epoch.
+//
+//nolint:gochecknoglobals // test only.
+var testChainSpec = chain.NewChainSpec(chain.SpecData[
+	common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+]{
+	SlotsPerEpoch: 1,
+})
+
+// newMiddleware returns a middleware whose sidecars are never available.
+func newMiddleware(
+	r *recorder,
+) *middleware.ABCIMiddleware[
+	any, *testBlock, middleware.BeaconState, *testSidecars,
+	any, any, *json.RawMessage,
+] {
+	return middleware.NewABCIMiddleware[
+		any, *testBlock, middleware.BeaconState, *testSidecars,
+		any, any, *json.RawMessage,
+	](
+		testChainSpec, r, r, unavailableGossiper{}, noopClock{},
+		noop.NewLogger(), noopSink{}, tracenoop.NewTracerProvider().Tracer(""),
+		new(event.FeedOf[
+			asynctypes.EventID, *asynctypes.Event[*testBlock]]),
+		new(event.FeedOf[
+			asynctypes.EventID, *asynctypes.Event[*testSidecars]]),
+		new(event.FeedOf[
+			asynctypes.EventID, *asynctypes.Event[math.Slot]]),
+	)
+}
+
+// proposalTxs returns the transactions of the proposal of the given slot,
+// carrying the root of its sidecars.
+func proposalTxs(t *testing.T, slot math.Slot) [][]byte {
+	t.Helper()
+	blk, err := (&testBlock{slot: slot}).MarshalSSZ()
+	require.NoError(t, err)
+	forkVersion := testChainSpec.ActiveForkVersionForSlot(slot)
+	return [][]byte{
+		encoding.NewEnvelope(
+			encoding.TxTypeBeaconBlock, forkVersion, blk,
+		).Marshal(),
+		encoding.NewEnvelope(
+			encoding.TxTypeBlobSidecarsRoot, forkVersion, make([]byte, 32),
+		).Marshal(),
+	}
+}
+
+// finalize finalizes the block of the given slot with the given
+// middleware.
+func finalize(
+	t *testing.T,
+	h *middleware.ABCIMiddleware[
+		any, *testBlock, middleware.BeaconState, *testSidecars,
+		any, any, *json.RawMessage,
+	],
+	slot math.Slot,
+) error {
+	t.Helper()
+	require.NoError(t, h.PreBlock(sdk.Context{}, &cmtabci.FinalizeBlockRequest{
+		Height: int64(slot),
+		Txs:    proposalTxs(t, slot),
+	}))
+	_, err := h.EndBlock(context.Background())
+	return err
+}
+
+func TestProcessProposal_SidecarsUnavailable(t *testing.T) {
+	r := new(recorder)
+	h := newMiddleware(r)
+
+	// A proposal whose sidecars are not available is rejected, whatever
+	// its slot.
+	for _, slot := range []math.Slot{1, 8} {
+		resp, err := h.ProcessProposal(
+			sdk.Context{}.WithContext(context.Background()),
+			&cmtabci.ProcessProposalRequest{
+				Height: int64(slot),
+				Txs:    proposalTxs(t, slot),
+			},
+		)
+		require.ErrorIs(t, err, p2p.ErrSidecarsUnavailable)
+		require.Equal(
+			t, cmtabci.PROCESS_PROPOSAL_STATUS_REJECT, resp.GetStatus(),
+		)
+	}
+	require.Empty(t, r.blocks)
+}
+
+func TestEndBlock_SidecarsUnavailable(t *testing.T) {
+	r := new(recorder)
+	h := newMiddleware(r)
+
+	// Their availability was voted on, so finalized blocks are processed
+	// without the sidecars that cannot be obtained, whatever their slot.
+	require.NoError(t, finalize(t, h, 1))
+	require.NoError(t, finalize(t, h, 8))
+	require.Equal(t, []math.Slot{1, 8}, r.blocks)
+	require.Empty(t, r.sidecars)
+}
@@ -26,7 +26,6 @@ import (
 	"github.com/berachain/beacon-kit/mod/async/pkg/event"
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
-	"github.com/berachain/beacon-kit/mod/p2p"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -34,6 +33,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
 	rp2p "github.com/berachain/beacon-kit/mod/runtime/pkg/p2p"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // ABCIMiddleware is a middleware between ABCI and the validator logic.
@@ -54,24 +54,19 @@ type ABCIMiddleware[
 	]
 	// daService is the service responsible for building the data availability
 	daService DAService[BlobSidecarsT]
-	// TODO: we will eventually gossip the blobs separately from
-	// CometBFT, but for now, these are no-op gossipers.
-	blobGossiper p2p.PublisherReceiver[
-		BlobSidecarsT,
-		[]byte,
-		encoding.ABCIRequest,
-		BlobSidecarsT,
-	]
+	// blobGossiper publishes the sidecars of proposals and requests them
+	// back from the references the proposals carry.
+	blobGossiper Gossiper[BlobSidecarsT]
 	// TODO: we will eventually gossip the blocks separately from
 	// CometBFT, but for now, these are no-op gossipers.
-	beaconBlockGossiper p2p.PublisherReceiver[
-		BeaconBlockT,
-		[]byte,
-		encoding.ABCIRequest,
-		BeaconBlockT,
-	]
+	beaconBlockGossiper Gossiper[BeaconBlockT]
+	// slotClock is anchored to the time of every block seen by the
+	// middleware.
+	slotClock SlotClock
 	// metrics is the metrics emitter.
 	metrics *ABCIMiddlewareMetrics
+	// tracer is the tracer for the ABCI block lifecycle.
+	tracer trace.Tracer
 	// logger is the logger for the middleware.
 	logger log.Logger[any]
 
@@ -118,8 +113,11 @@ func NewABCIMiddleware[
 		BeaconBlockT, BlobSidecarsT, DepositT, GenesisT,
 	],
 	daService DAService[BlobSidecarsT],
+	blobGossiper Gossiper[BlobSidecarsT],
+	slotClock SlotClock,
 	logger log.Logger[any],
 	telemetrySink TelemetrySink,
+	tracer trace.Tracer,
 	blkFeed *event.FeedOf[
 		asynctypes.EventID, *asynctypes.Event[BeaconBlockT]],
 	sidecarsFeed *event.FeedOf[
@@ -137,14 +135,15 @@ func NewABCIMiddleware[
 		chainSpec:    chainSpec,
 		chainService: chainService,
 		daService:    daService,
-		blobGossiper: rp2p.NewNoopBlobHandler[
-			BlobSidecarsT, encoding.ABCIRequest](),
+		blobGossiper: blobGossiper,
 		beaconBlockGossiper: rp2p.
 			NewNoopBlockGossipHandler[BeaconBlockT, encoding.ABCIRequest](
 			chainSpec,
 		),
+		slotClock:    slotClock,
 		logger:       logger,
 		metrics:      newABCIMiddlewareMetrics(telemetrySink),
+		tracer:       tracer,
 		blkFeed:      blkFeed,
 		sidecarsFeed: sidecarsFeed,
 		slotFeed:     slotFeed,
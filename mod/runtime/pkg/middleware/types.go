@@ -25,11 +25,13 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/p2p"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
 )
 
 // BeaconBlock is an interface for accessing the beacon block.
@@ -112,6 +114,26 @@ type Genesis interface {
 	json.Unmarshaler
 }
 
+// Gossiper publishes the data carried by proposals, returning the payload of
+// the transaction referencing it, and requests it back from proposals.
+type Gossiper[DataT any] interface {
+	p2p.Receiver[encoding.ABCIRequest, DataT]
+	// Publish publishes the data of the proposal of the given slot and
+	// returns the payload of the transaction referencing it.
+	Publish(ctx context.Context, slot math.Slot, data DataT) ([]byte, error)
+	// TxType returns the type of the transaction referencing the data
+	// published for the given slot.
+	TxType(slot math.Slot) encoding.TxType
+}
+
+// SlotClock is the clock that maps slots to the CometBFT block time.
+type SlotClock interface {
+	// SetGenesisTime sets the genesis time of the chain.
+	SetGenesisTime(genesisTime time.Time)
+	// OnBlock anchors the clock to the time of the block at the given slot.
+	OnBlock(slot math.Slot, blockTime time.Time)
+}
+
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
 	// MeasureSince measures the time since the given time.
@@ -0,0 +1,259 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package p2p
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
+)
+
+// maxSlotsAhead is the number of slots past the latest one seen that
+// sidecars are received for before any proposal waits for them.
+const maxSlotsAhead = 2
+
+// Sidecars are the blob sidecars of a block.
+type Sidecars interface {
+	ssz.Marshallable
+	// GetSlot returns the slot of the block the sidecars belong to.
+	GetSlot() math.Slot
+}
+
+// BlobGossipHandler gossips blob sidecars to the configured peers over a
+// side channel, so that proposals only carry the root of their sidecars
+// from the fork set by the chain spec. Sidecars are pushed to the peers
+// when published and forwarded when first received, and are pulled from
+// the peers when not received in time. Before the fork, sidecars are
+// carried by proposals as with the NoopBlobHandler.
+type BlobGossipHandler[
+	BlobT Sidecars, ReqT encoding.ABCIRequest,
+] struct {
+	NoopBlobHandler[BlobT, ReqT]
+	// cfg is the transport configuration of the gossip.
+	cfg Config
+	// chainSpec sets the fork from which proposals carry roots.
+	chainSpec common.ChainSpec
+	// logger is the logger of the handler.
+	logger log.Logger[any]
+	// pool holds the sidecars received and published.
+	pool *sidecarPool
+	// limiter limits the rate of the pushes of each peer.
+	limiter *peerLimiter
+	// client is the client of the requests to the peers.
+	client *http.Client
+	// emptyRoot is the root of sidecars without blobs, which are never
+	// gossiped.
+	emptyRoot common.Root
+	// addr is the address sidecars are served on, once started.
+	addr net.Addr
+	// slot is the latest slot a proposal was published or requested for.
+	slot atomic.Uint64
+	// ctx is the context of the pushes, done once the handler stops.
+	ctx context.Context
+	// pushes tracks the pushes in flight.
+	pushes sync.WaitGroup
+}
+
+// NewBlobGossipHandler creates a new BlobGossipHandler.
+func NewBlobGossipHandler[
+	BlobT Sidecars, ReqT encoding.ABCIRequest,
+](
+	cfg Config,
+	chainSpec common.ChainSpec,
+	logger log.Logger[any],
+) (*BlobGossipHandler[BlobT, ReqT], error) {
+	empty, err := newInstance[BlobT]()
+	if err != nil {
+		return nil, err
+	}
+	emptyRoot, err := empty.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlobGossipHandler[BlobT, ReqT]{
+		NoopBlobHandler: NewNoopBlobHandler[BlobT, ReqT](),
+		cfg:             cfg,
+		chainSpec:       chainSpec,
+		logger:          logger,
+		pool:            newSidecarPool(cfg.Retention, maxPooledSidecars),
+		limiter:         newPeerLimiter(pushWindow, maxPushesPerWindow),
+		client:          &http.Client{Timeout: cfg.Timeout},
+		emptyRoot:       emptyRoot,
+		ctx:             context.Background(),
+	}, nil
+}
+
+// Name returns the name of the service.
+func (h *BlobGossipHandler[_, _]) Name() string {
+	return "blob-gossip"
+}
+
+// TxType returns the type of the transaction carrying the data published
+// for the given slot, the root of the sidecars from the fork on.
+func (h *BlobGossipHandler[_, _]) TxType(slot math.Slot) encoding.TxType {
+	if !h.carriesRoot(slot) {
+		return h.NoopBlobHandler.TxType(slot)
+	}
+	return encoding.TxTypeBlobSidecarsRoot
+}
+
+// Publish gossips the given sidecars of the proposal of the given slot and
+// returns their root, to be carried by the proposal in their place.
+func (h *BlobGossipHandler[BlobT, ReqT]) Publish(
+	ctx context.Context,
+	slot math.Slot,
+	data BlobT,
+) ([]byte, error) {
+	h.observe(slot)
+	if !h.carriesRoot(slot) {
+		return h.NoopBlobHandler.Publish(ctx, slot, data)
+	}
+
+	root, err := data.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	if root == h.emptyRoot {
+		return root[:], nil
+	}
+
+	bz, err := data.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	if h.pool.add(root, bz) {
+		h.goPush(root, bz)
+	}
+	return root[:], nil
+}
+
+// Request returns the sidecars of the root carried by the given request,
+// waiting for them to be received until the timeout and then pulling them
+// from the peers until the timeout again, so that it never takes more than
+// twice the timeout.
+func (h *BlobGossipHandler[BlobT, ReqT]) Request(
+	ctx context.Context,
+	req ReqT,
+) (BlobT, error) {
+	//#nosec:G701 // heights are never negative.
+	slot := math.Slot(req.GetHeight())
+	h.observe(slot)
+	if !h.carriesRoot(slot) {
+		return h.NoopBlobHandler.Request(ctx, req)
+	}
+
+	var sidecars BlobT
+	root, err := encoding.UnmarshalBlobSidecarsRootFromABCIRequest(req)
+	if err != nil {
+		return sidecars, errors.Wrapf(ErrInvalidSidecarsRef, "%v", err)
+	}
+	if root == h.emptyRoot {
+		return newInstance[BlobT]()
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, h.cfg.Timeout)
+	defer cancel()
+	if bz, err := h.pool.wait(waitCtx, root); err == nil {
+		return h.decode(root, bz)
+	}
+
+	h.logger.Warn(
+		"Blob sidecars not received in time, pulling from peers",
+		"root", root,
+	)
+	if sidecars, err = h.pull(ctx, root); err != nil {
+		return sidecars, errors.Wrapf(
+			ErrSidecarsUnavailable, "root %s: %v", root, err,
+		)
+	}
+	return sidecars, nil
+}
+
+// carriesRoot returns whether proposals of the given slot carry the root of
+// their sidecars rather than the sidecars. Roots are only carried in
+// envelopes.
+func (h *BlobGossipHandler[_, _]) carriesRoot(slot math.Slot) bool {
+	return h.chainSpec.SlotToEpoch(slot) >=
+		h.chainSpec.BlobSidecarsRootForkEpoch() &&
+		encoding.EnvelopedAt(h.chainSpec, slot)
+}
+
+// observe records that a proposal of the given slot is published or
+// requested, which moves the slots sidecars are received for.
+func (h *BlobGossipHandler[_, _]) observe(slot math.Slot) {
+	for {
+		latest := h.slot.Load()
+		if slot.Unwrap() <= latest ||
+			h.slot.CompareAndSwap(latest, slot.Unwrap()) {
+			return
+		}
+	}
+}
+
+// expects returns whether the sidecars of the given root and slot may be
+// received from a peer: either a proposal waits for them, or they belong to
+// one of the next slots and proposals of that slot carry roots.
+func (h *BlobGossipHandler[_, _]) expects(
+	root common.Root,
+	slot math.Slot,
+) bool {
+	if h.pool.waited(root) {
+		return true
+	}
+	latest := math.Slot(h.slot.Load())
+	return slot >= latest && slot <= latest+maxSlotsAhead &&
+		h.carriesRoot(slot)
+}
+
+// decode decodes the given sidecars and checks that they match the given
+// root.
+func (h *BlobGossipHandler[BlobT, _]) decode(
+	root common.Root,
+	bz []byte,
+) (BlobT, error) {
+	sidecars, err := newInstance[BlobT]()
+	if err != nil {
+		return sidecars, err
+	}
+	if err = sidecars.UnmarshalSSZ(bz); err != nil {
+		return sidecars, err
+	}
+	got, err := sidecars.HashTreeRoot()
+	if err != nil {
+		return sidecars, err
+	} else if got != root {
+		return sidecars, errors.Wrapf(
+			ErrSidecarsRootMismatch, "expected %s, got %s",
+			root, common.Root(got),
+		)
+	}
+	return sidecars, nil
+}
@@ -0,0 +1,302 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package p2p
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+const (
+	// sidecarsPath is the path sidecars are pushed to, and served under by
+	// root.
+	sidecarsPath = "/blob-sidecars"
+	// maxSidecarsSize is the maximum size of the SSZ encoding of sidecars
+	// accepted from a peer.
+	maxSidecarsSize = 16 << 20
+	// maxErrorSize is the maximum size of an error returned by a peer.
+	maxErrorSize = 512
+	// readHeaderTimeout is the timeout for reading request headers.
+	readHeaderTimeout = 5 * time.Second
+	// shutdownTimeout is the timeout for gracefully shutting down the
+	// server.
+	shutdownTimeout = 5 * time.Second
+)
+
+// Start starts receiving and serving sidecars until the context is
+// cancelled, after which the pushes in flight are waited for.
+func (h *BlobGossipHandler[_, _]) Start(ctx context.Context) error {
+	l, err := (&net.ListenConfig{}).Listen(
+		ctx, "tcp", h.cfg.ListenAddress,
+	)
+	if err != nil {
+		return err
+	}
+	h.addr = l.Addr()
+	h.ctx = ctx
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+sidecarsPath, h.handleReceive)
+	mux.HandleFunc("GET "+sidecarsPath+"/{root}", h.handleServe)
+	srv := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	go func() {
+		h.logger.Info("Gossiping blob sidecars", "address", h.addr)
+		if err = srv.Serve(l); err != nil &&
+			!errors.Is(err, http.ErrServerClosed) {
+			h.logger.Error("Blob gossip server failed", "error", err)
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		//nolint:contextcheck // the parent context is already done.
+		shutdownCtx, cancel := context.WithTimeout(
+			context.Background(), shutdownTimeout,
+		)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			h.logger.Error(
+				"Failed to shut down blob gossip server", "error", err,
+			)
+		}
+		h.pushes.Wait()
+	}()
+	return nil
+}
+
+// Addr returns the address sidecars are served on, nil until started.
+func (h *BlobGossipHandler[_, _]) Addr() net.Addr {
+	return h.addr
+}
+
+// handleReceive adds the sidecars pushed by a peer to the pool, forwarding
+// them to the peers when they are new. Only the sidecars of the proposals
+// waited for or about to be made are accepted, at a bounded rate per peer.
+func (h *BlobGossipHandler[BlobT, _]) handleReceive(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	if !h.limiter.allow(remoteHost(r)) {
+		http.Error(w, ErrRateLimited.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	bz, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxSidecarsSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	sidecars, err := newInstance[BlobT]()
+	if err == nil {
+		err = sidecars.UnmarshalSSZ(bz)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	root, err := sidecars.HashTreeRoot()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if _, ok := h.pool.get(root); !ok &&
+		!h.expects(root, sidecars.GetSlot()) {
+		http.Error(w, ErrUnexpectedSidecars.Error(), http.StatusConflict)
+		return
+	}
+
+	if h.pool.add(root, bz) {
+		h.goPush(root, bz)
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// handleServe serves the sidecars of the root in the path, if held.
+func (h *BlobGossipHandler[_, _]) handleServe(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	var root common.Root
+	if err := root.UnmarshalText([]byte(r.PathValue("root"))); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	bz, ok := h.pool.get(root)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if _, err := w.Write(bz); err != nil {
+		h.logger.Error("Failed to serve blob sidecars", "error", err)
+	}
+}
+
+// goPush pushes the given sidecars to all the peers in the background,
+// until the handler is stopped.
+func (h *BlobGossipHandler[_, _]) goPush(root common.Root, bz []byte) {
+	h.pushes.Add(1)
+	go func() {
+		defer h.pushes.Done()
+		h.push(h.ctx, root, bz)
+	}()
+}
+
+// push pushes the given sidecars to all the peers.
+func (h *BlobGossipHandler[_, _]) push(
+	ctx context.Context,
+	root common.Root,
+	bz []byte,
+) {
+	var wg sync.WaitGroup
+	for _, peer := range h.cfg.Peers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := h.do(
+				ctx, http.MethodPost, peer+sidecarsPath, bz, nil,
+			); err != nil {
+				h.logger.Warn(
+					"Failed to push blob sidecars",
+					"peer", peer, "root", root, "error", err,
+				)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// pull requests the sidecars of the given root from all the peers at once
+// and returns those of the first to serve them. It gives up after the
+// timeout, so that it bounds the time a block waits for its sidecars.
+func (h *BlobGossipHandler[BlobT, _]) pull(
+	ctx context.Context,
+	root common.Root,
+) (BlobT, error) {
+	var sidecars BlobT
+	if len(h.cfg.Peers) == 0 {
+		return sidecars, ErrNoPeers
+	}
+
+	// The requests still in flight are cancelled on return.
+	ctx, cancel := context.WithTimeout(ctx, h.cfg.Timeout)
+	defer cancel()
+
+	type pulled struct {
+		bz       []byte
+		sidecars BlobT
+		err      error
+	}
+	results := make(chan pulled, len(h.cfg.Peers))
+	for _, peer := range h.cfg.Peers {
+		go func() {
+			var (
+				buf bytes.Buffer
+				res pulled
+			)
+			res.err = h.do(
+				ctx, http.MethodGet, peer+sidecarsPath+"/"+root.String(),
+				nil, &buf,
+			)
+			if res.err == nil {
+				res.bz = buf.Bytes()
+				res.sidecars, res.err = h.decode(root, res.bz)
+			}
+			if res.err != nil {
+				res.err = errors.Wrapf(res.err, "peer %s", peer)
+			}
+			results <- res
+		}()
+	}
+
+	errs := make([]error, 0, len(h.cfg.Peers))
+	for range h.cfg.Peers {
+		res := <-results
+		if res.err != nil {
+			errs = append(errs, res.err)
+			continue
+		}
+		h.pool.add(root, res.bz)
+		return res.sidecars, nil
+	}
+	return sidecars, errors.Join(errs...)
+}
+
+// do sends a request with the given body to the given URL and copies the
+// response body to out, if not nil.
+func (h *BlobGossipHandler[_, _]) do(
+	ctx context.Context,
+	method, url string,
+	body []byte,
+	out io.Writer,
+) error {
+	req, err := http.NewRequestWithContext(
+		ctx, method, url, bytes.NewReader(body),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK ||
+		resp.StatusCode >= http.StatusMultipleChoices {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorSize))
+		return errors.Newf(
+			"%s: %s", resp.Status, strings.TrimSpace(string(msg)),
+		)
+	}
+	if out == nil {
+		return nil
+	}
+	_, err = io.Copy(out, io.LimitReader(resp.Body, maxSidecarsSize))
+	return err
+}
+
+// remoteHost returns the host of the peer the given request comes from.
+func remoteHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
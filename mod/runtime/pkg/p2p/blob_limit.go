@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package p2p
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// pushWindow is the window the pushes of a peer are counted over.
+	pushWindow = time.Second
+	// maxPushesPerWindow is the number of pushes accepted from a peer per
+	// window.
+	maxPushesPerWindow = 8
+)
+
+// pushCount is the number of pushes of a peer in its current window.
+type pushCount struct {
+	start time.Time
+	n     int
+}
+
+// peerLimiter limits the rate of the pushes of each peer, over a fixed
+// window per peer.
+type peerLimiter struct {
+	mu sync.Mutex
+	// window is the window pushes are counted over.
+	window time.Duration
+	// limit is the number of pushes accepted per window.
+	limit int
+	// peers holds the count of each peer with a window open.
+	peers map[string]*pushCount
+}
+
+// newPeerLimiter creates a new limiter accepting the given number of
+// pushes per peer and window.
+func newPeerLimiter(window time.Duration, limit int) *peerLimiter {
+	return &peerLimiter{
+		window: window,
+		limit:  limit,
+		peers:  make(map[string]*pushCount),
+	}
+}
+
+// allow returns whether a push of the given peer is accepted, counting it
+// if so, and drops the windows that are over.
+func (l *peerLimiter) allow(peer string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	for p, count := range l.peers {
+		if now.Sub(count.start) >= l.window {
+			delete(l.peers, p)
+		}
+	}
+
+	count, ok := l.peers[peer]
+	if !ok {
+		count = &pushCount{start: now}
+		l.peers[peer] = count
+	}
+	if count.n >= l.limit {
+		return false
+	}
+	count.n++
+	return true
+}
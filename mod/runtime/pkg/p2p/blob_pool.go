@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package p2p
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// maxPooledSidecars is the number of sidecars held by the pool, past which
+// the oldest are dropped.
+const maxPooledSidecars = 32
+
+// sidecarsEntry is the SSZ encoding of sidecars held by the pool.
+type sidecarsEntry struct {
+	bz      []byte
+	addedAt time.Time
+}
+
+// sidecarsWaiter is closed when the sidecars of a root are added, and counts
+// those waiting on it.
+type sidecarsWaiter struct {
+	ch chan struct{}
+	n  int
+}
+
+// sidecarPool holds the SSZ encoded sidecars received or published, by
+// root, for the retention period and up to a maximum number of sidecars.
+type sidecarPool struct {
+	mu sync.Mutex
+	// retention is the time sidecars are held for.
+	retention time.Duration
+	// maxSize is the number of sidecars held at most.
+	maxSize int
+	// entries holds the sidecars by root.
+	entries map[common.Root]sidecarsEntry
+	// waiters holds a waiter per root waited on.
+	waiters map[common.Root]*sidecarsWaiter
+}
+
+// newSidecarPool creates a new pool holding up to the given number of
+// sidecars for the given retention period.
+func newSidecarPool(retention time.Duration, maxSize int) *sidecarPool {
+	return &sidecarPool{
+		retention: retention,
+		maxSize:   maxSize,
+		entries:   make(map[common.Root]sidecarsEntry),
+		waiters:   make(map[common.Root]*sidecarsWaiter),
+	}
+}
+
+// add adds the sidecars of the given root, releasing those waiting for
+// them, and drops the sidecars past retention and the oldest ones past the
+// maximum size. It returns false if the sidecars were already held.
+func (p *sidecarPool) add(root common.Root, bz []byte) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	for r, entry := range p.entries {
+		if now.Sub(entry.addedAt) > p.retention {
+			delete(p.entries, r)
+		}
+	}
+
+	if _, ok := p.entries[root]; ok {
+		return false
+	}
+	for len(p.entries) >= p.maxSize {
+		p.dropOldest()
+	}
+	p.entries[root] = sidecarsEntry{bz: bz, addedAt: now}
+	if waiter, ok := p.waiters[root]; ok {
+		close(waiter.ch)
+		delete(p.waiters, root)
+	}
+	return true
+}
+
+// dropOldest drops the sidecars added first. The lock must be held.
+func (p *sidecarPool) dropOldest() {
+	var (
+		oldest common.Root
+		at     time.Time
+	)
+	for r, entry := range p.entries {
+		if at.IsZero() || entry.addedAt.Before(at) {
+			oldest, at = r, entry.addedAt
+		}
+	}
+	delete(p.entries, oldest)
+}
+
+// waited returns whether a proposal waits for the sidecars of the given
+// root.
+func (p *sidecarPool) waited(root common.Root) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, ok := p.waiters[root]
+	return ok
+}
+
+// get returns the sidecars of the given root, if held.
+func (p *sidecarPool) get(root common.Root) ([]byte, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	entry, ok := p.entries[root]
+	return entry.bz, ok
+}
+
+// wait returns the sidecars of the given root, waiting for them to be added
+// until the context is done.
+func (p *sidecarPool) wait(
+	ctx context.Context,
+	root common.Root,
+) ([]byte, error) {
+	p.mu.Lock()
+	if entry, ok := p.entries[root]; ok {
+		p.mu.Unlock()
+		return entry.bz, nil
+	}
+	waiter, ok := p.waiters[root]
+	if !ok {
+		waiter = &sidecarsWaiter{ch: make(chan struct{})}
+		p.waiters[root] = waiter
+	}
+	waiter.n++
+	p.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		// The waiter is dropped by the last to give up on it, unless the
+		// sidecars were added in the meantime.
+		if waiter.n--; waiter.n == 0 && p.waiters[root] == waiter {
+			delete(p.waiters, root)
+		}
+		return nil, ctx.Err()
+	case <-waiter.ch:
+		bz, _ := p.get(root)
+		return bz, nil
+	}
+}
@@ -0,0 +1,334 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package p2p_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/p2p"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+)
+
+// testSidecars are sidecars encoded as their slot followed by their raw
+// data.
+type testSidecars struct {
+	slot math.Slot
+	data []byte
+}
+
+func (s *testSidecars) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = binary.LittleEndian.AppendUint64(dst, s.slot.Unwrap())
+	return append(dst, s.data...), nil
+}
+
+func (s *testSidecars) MarshalSSZ() ([]byte, error) {
+	return s.MarshalSSZTo(nil)
+}
+
+func (s *testSidecars) UnmarshalSSZ(bz []byte) error {
+	if len(bz) < 8 {
+		return nil
+	}
+	s.slot = math.Slot(binary.LittleEndian.Uint64(bz))
+	s.data = append([]byte(nil), bz[8:]...)
+	return nil
+}
+
+func (s *testSidecars) SizeSSZ() int {
+	return 8 + len(s.data)
+}
+
+func (s *testSidecars) HashTreeRoot() ([32]byte, error) {
+	if len(s.data) == 0 {
+		return [32]byte{}, nil
+	}
+	bz, err := s.MarshalSSZ()
+	return sha256.Sum256(bz), err
+}
+
+func (s *testSidecars) GetSlot() math.Slot {
+	return s.slot
+}
+
+type testHandler = p2p.BlobGossipHandler[
+	*testSidecars, *cmtabci.ProcessProposalRequest,
+]
+
+// testChainSpec returns a chain spec with one slot per epoch, in which
+// proposals carry the root of their sidecars from the given epoch.
+func testChainSpec(forkEpoch math.Epoch) common.ChainSpec {
+	return chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress,
+		math.Slot, any,
+	]{
+		SlotsPerEpoch:             1,
+		BlobSidecarsRootForkEpoch: forkEpoch,
+	})
+}
+
+// newTestHandler creates and starts a handler gossiping to the given peers,
+// past the fork at which proposals carry roots.
+func newTestHandler(
+	t *testing.T,
+	timeout time.Duration,
+	peers ...*testHandler,
+) *testHandler {
+	t.Helper()
+	cfg := p2p.DefaultConfig()
+	cfg.ListenAddress = "127.0.0.1:0"
+	cfg.Timeout = timeout
+	for _, peer := range peers {
+		cfg.Peers = append(cfg.Peers, "http://"+peer.Addr().String())
+	}
+
+	h, err := p2p.NewBlobGossipHandler[
+		*testSidecars, *cmtabci.ProcessProposalRequest,
+	](cfg, testChainSpec(0), noop.NewLogger())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, h.Start(ctx))
+	return h
+}
+
+// proposal returns a proposal of the given slot carrying the given
+// reference to sidecars, as published by the given handler.
+func proposal(
+	h *testHandler,
+	slot math.Slot,
+	ref []byte,
+) *cmtabci.ProcessProposalRequest {
+	return &cmtabci.ProcessProposalRequest{
+		Height: int64(slot),
+		Txs: [][]byte{
+			encoding.NewEnvelope(encoding.TxTypeBeaconBlock, 0, nil).Marshal(),
+			encoding.NewEnvelope(h.TxType(slot), 0, ref).Marshal(),
+		},
+	}
+}
+
+// push pushes the given sidecars to the given handler and returns the
+// status of the response.
+func push(t *testing.T, h *testHandler, sidecars *testSidecars) int {
+	t.Helper()
+	bz, err := sidecars.MarshalSSZ()
+	require.NoError(t, err)
+	req, err := http.NewRequestWithContext(
+		context.Background(), http.MethodPost,
+		"http://"+h.Addr().String()+"/blob-sidecars", bytes.NewReader(bz),
+	)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	return resp.StatusCode
+}
+
+func TestBlobGossipHandler_Push(t *testing.T) {
+	receiver := newTestHandler(t, 5*time.Second)
+	publisher := newTestHandler(t, 5*time.Second, receiver)
+
+	sidecars := &testSidecars{slot: 1, data: []byte("sidecars")}
+	ref, err := publisher.Publish(context.Background(), 1, sidecars)
+	require.NoError(t, err)
+	root, err := sidecars.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root[:], ref)
+
+	got, err := receiver.Request(
+		context.Background(), proposal(publisher, 1, ref),
+	)
+	require.NoError(t, err)
+	require.Equal(t, sidecars.data, got.data)
+}
+
+func TestBlobGossipHandler_Pull(t *testing.T) {
+	publisher := newTestHandler(t, time.Second)
+	receiver := newTestHandler(t, 10*time.Millisecond, publisher)
+
+	sidecars := &testSidecars{slot: 1, data: []byte("sidecars")}
+	ref, err := publisher.Publish(context.Background(), 1, sidecars)
+	require.NoError(t, err)
+
+	got, err := receiver.Request(
+		context.Background(), proposal(publisher, 1, ref),
+	)
+	require.NoError(t, err)
+	require.Equal(t, sidecars.data, got.data)
+}
+
+func TestBlobGossipHandler_Unavailable(t *testing.T) {
+	peer := newTestHandler(t, time.Second)
+	h := newTestHandler(t, 10*time.Millisecond, peer)
+
+	root := sha256.Sum256([]byte("unknown"))
+	_, err := h.Request(context.Background(), proposal(h, 1, root[:]))
+	require.ErrorIs(t, err, p2p.ErrSidecarsUnavailable)
+
+	_, err = h.Request(context.Background(), proposal(h, 1, []byte("short")))
+	require.ErrorIs(t, err, p2p.ErrInvalidSidecarsRef)
+}
+
+func TestBlobGossipHandler_PullBounded(t *testing.T) {
+	// Peers that never answer.
+	hung := httptest.NewServer(http.HandlerFunc(
+		func(_ http.ResponseWriter, r *http.Request) { <-r.Context().Done() },
+	))
+	t.Cleanup(hung.Close)
+	publisher := newTestHandler(t, time.Second)
+
+	timeout := 100 * time.Millisecond
+	newHandler := func(peers ...string) *testHandler {
+		cfg := p2p.DefaultConfig()
+		cfg.ListenAddress = "127.0.0.1:0"
+		cfg.Timeout = timeout
+		cfg.Peers = peers
+		h, err := p2p.NewBlobGossipHandler[
+			*testSidecars, *cmtabci.ProcessProposalRequest,
+		](cfg, testChainSpec(0), noop.NewLogger())
+		require.NoError(t, err)
+		return h
+	}
+
+	// The peers are pulled from at once, so hung peers do not delay the
+	// others.
+	sidecars := &testSidecars{slot: 1, data: []byte("sidecars")}
+	ref, err := publisher.Publish(context.Background(), 1, sidecars)
+	require.NoError(t, err)
+	h := newHandler(
+		hung.URL, hung.URL, hung.URL, "http://"+publisher.Addr().String(),
+	)
+	start := time.Now()
+	got, err := h.Request(context.Background(), proposal(h, 1, ref))
+	require.NoError(t, err)
+	require.Equal(t, sidecars.data, got.data)
+	require.Less(t, time.Since(start), 2*timeout)
+
+	// Without any peer serving them, the request gives up after twice the
+	// timeout.
+	h = newHandler(hung.URL, hung.URL, hung.URL)
+	start = time.Now()
+	_, err = h.Request(context.Background(), proposal(h, 1, ref))
+	require.ErrorIs(t, err, p2p.ErrSidecarsUnavailable)
+	require.Less(t, time.Since(start), 3*timeout)
+}
+
+func TestBlobGossipHandler_Empty(t *testing.T) {
+	h := newTestHandler(t, time.Second)
+
+	ref, err := h.Publish(context.Background(), 1, &testSidecars{})
+	require.NoError(t, err)
+
+	got, err := h.Request(context.Background(), proposal(h, 1, ref))
+	require.NoError(t, err)
+	require.Empty(t, got.data)
+}
+
+func TestBlobGossipHandler_Unexpected(t *testing.T) {
+	h := newTestHandler(t, time.Second)
+
+	// Only the sidecars of the next slots are received.
+	require.Equal(t, http.StatusConflict, push(
+		t, h, &testSidecars{slot: 10, data: []byte("far")},
+	))
+	require.Equal(t, http.StatusAccepted, push(
+		t, h, &testSidecars{slot: 2, data: []byte("next")},
+	))
+
+	// Once a later proposal is seen, older sidecars are not received.
+	_, err := h.Publish(
+		context.Background(), 5, &testSidecars{slot: 5, data: []byte("5")},
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusConflict, push(
+		t, h, &testSidecars{slot: 4, data: []byte("old")},
+	))
+}
+
+func TestBlobGossipHandler_RateLimited(t *testing.T) {
+	h := newTestHandler(t, time.Second)
+
+	sidecars := &testSidecars{slot: 1, data: []byte("sidecars")}
+	status := http.StatusAccepted
+	for i := 0; i < 100 && status == http.StatusAccepted; i++ {
+		status = push(t, h, sidecars)
+	}
+	require.Equal(t, http.StatusTooManyRequests, status)
+}
+
+func TestBlobGossipHandler_BeforeFork(t *testing.T) {
+	cfg := p2p.DefaultConfig()
+	cfg.ListenAddress = "127.0.0.1:0"
+	h, err := p2p.NewBlobGossipHandler[
+		*testSidecars, *cmtabci.ProcessProposalRequest,
+	](cfg, testChainSpec(10), noop.NewLogger())
+	require.NoError(t, err)
+
+	sidecars := &testSidecars{slot: 1, data: []byte("sidecars")}
+	ref, err := h.Publish(context.Background(), 1, sidecars)
+	require.NoError(t, err)
+	bz, err := sidecars.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, bz, ref)
+
+	require.Equal(t, encoding.TxTypeBlobSidecars, h.TxType(1))
+	require.Equal(t, encoding.TxTypeBlobSidecarsRoot, h.TxType(10))
+
+	got, err := h.Request(context.Background(), proposal(h, 1, ref))
+	require.NoError(t, err)
+	require.Equal(t, sidecars.data, got.data)
+
+	// Proposals in the legacy layout are still understood.
+	got, err = h.Request(
+		context.Background(),
+		&cmtabci.ProcessProposalRequest{Height: 1, Txs: [][]byte{{}, ref}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, sidecars.data, got.data)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	cfg := p2p.DefaultConfig()
+	require.NoError(t, cfg.Validate())
+
+	cfg.Peers = []string{"http://10.0.0.2:26670"}
+	require.NoError(t, cfg.Validate())
+
+	cfg.Peers = []string{"10.0.0.2:26670"}
+	cfg.Timeout = 0
+	err := cfg.Validate()
+	require.ErrorIs(t, err, p2p.ErrInvalidPeer)
+	require.ErrorIs(t, err, p2p.ErrNonPositiveDuration)
+}
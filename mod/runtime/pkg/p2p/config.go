@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package p2p
+
+import (
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+const (
+	// defaultListenAddress is the default address sidecars are received and
+	// served on.
+	defaultListenAddress = "0.0.0.0:26670"
+	// defaultTimeout is the default time a proposal waits for its sidecars.
+	defaultTimeout = 2 * time.Second
+	// defaultRetention is the default time sidecars are kept and served for.
+	defaultRetention = 10 * time.Minute
+)
+
+// Config is the transport configuration of the blob sidecar gossip. Whether
+// proposals carry their sidecars or only their root is not configured here,
+// but by the chain spec, as every node must agree on it.
+type Config struct {
+	// ListenAddress is the address sidecars are received and served on.
+	ListenAddress string `mapstructure:"listen-address"`
+	// Peers are the base URLs of the peers sidecars are pushed to and
+	// pulled from.
+	Peers []string `mapstructure:"peers"`
+	// Timeout is the time a proposal waits for its sidecars before pulling
+	// them from the peers, and the time they are pulled for.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// Retention is the time received sidecars are kept and served for.
+	Retention time.Duration `mapstructure:"retention"`
+}
+
+// DefaultConfig returns the default configuration of the blob sidecar
+// gossip.
+func DefaultConfig() Config {
+	return Config{
+		ListenAddress: defaultListenAddress,
+		Timeout:       defaultTimeout,
+		Retention:     defaultRetention,
+	}
+}
+
+// Validate checks that the sidecars can be served and gossiped with the
+// configured values. All problems found are returned joined.
+func (c Config) Validate() error {
+	var errs []error
+	if _, _, err := net.SplitHostPort(c.ListenAddress); err != nil {
+		errs = append(errs, errors.Wrapf(
+			err, "listen-address %q", c.ListenAddress,
+		))
+	}
+	for _, peer := range c.Peers {
+		if u, err := url.Parse(peer); err != nil ||
+			(u.Scheme != "http" && u.Scheme != "https") {
+			errs = append(errs, errors.Wrapf(ErrInvalidPeer, "peers %q", peer))
+		}
+	}
+	for _, d := range []struct {
+		key   string
+		value time.Duration
+	}{
+		{"timeout", c.Timeout},
+		{"retention", c.Retention},
+	} {
+		if d.value <= 0 {
+			errs = append(errs, errors.Wrap(ErrNonPositiveDuration, d.key))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package p2p
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrSidecarsUnavailable is returned when the sidecars of a proposal
+	// could not be obtained in time.
+	ErrSidecarsUnavailable = errors.New("blob sidecars unavailable")
+	// ErrInvalidSidecarsRef is returned when a proposal does not carry a
+	// valid reference to its sidecars.
+	ErrInvalidSidecarsRef = errors.New("invalid blob sidecars reference")
+	// ErrSidecarsRootMismatch is returned when sidecars do not match the
+	// root they were requested by.
+	ErrSidecarsRootMismatch = errors.New("blob sidecars root mismatch")
+	// ErrNoPeers is returned when sidecars are pulled without any peer
+	// configured.
+	ErrNoPeers = errors.New("no peers configured")
+	// ErrInvalidPeer is returned when a peer is not an HTTP(S) URL.
+	ErrInvalidPeer = errors.New("peer must be an http or https url")
+	// ErrUnexpectedSidecars is returned when a peer pushes sidecars that
+	// no proposal waits for or is about to be made for.
+	ErrUnexpectedSidecars = errors.New("unexpected blob sidecars")
+	// ErrRateLimited is returned when a peer pushes sidecars too often.
+	ErrRateLimited = errors.New("too many blob sidecars pushed")
+	// ErrNonPositiveDuration is returned when a duration must be positive.
+	ErrNonPositiveDuration = errors.New("duration must be positive")
+)
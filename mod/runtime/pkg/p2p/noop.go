@@ -47,6 +47,15 @@ func (n NoopGossipHandler[DataT, BytesT]) Request(
 	_ context.Context,
 	ref BytesT,
 ) (DataT, error) {
+	out, err := newInstance[DataT]()
+	if err != nil {
+		return out, err
+	}
+	return out, out.UnmarshalSSZ(ref)
+}
+
+// newInstance returns a new DataT, allocating it if it is a pointer.
+func newInstance[DataT any]() (DataT, error) {
 	var (
 		out DataT
 		ok  bool
@@ -60,6 +69,5 @@ func (n NoopGossipHandler[DataT, BytesT]) Request(
 			return out, errors.New("failed to create new instance")
 		}
 	}
-
-	return out, out.UnmarshalSSZ(ref)
+	return out, nil
 }
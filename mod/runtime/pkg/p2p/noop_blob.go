@@ -23,6 +23,7 @@ package p2p
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
 )
@@ -44,6 +45,7 @@ func NewNoopBlobHandler[
 // Publish takes a Blob and returns the ssz marshalled data.
 func (n NoopBlobHandler[BlobT, ReqT]) Publish(
 	_ context.Context,
+	_ math.Slot,
 	data BlobT,
 ) ([]byte, error) {
 	return data.MarshalSSZ()
@@ -54,8 +56,10 @@ func (n NoopBlobHandler[BlobT, ReqT]) Request(
 	_ context.Context,
 	req ReqT,
 ) (BlobT, error) {
-	return encoding.UnmarshalBlobSidecarsFromABCIRequest[BlobT](
-		req,
-		1,
-	)
+	return encoding.UnmarshalBlobSidecarsFromABCIRequest[BlobT](req)
+}
+
+// TxType returns the type of the transaction carrying the published data.
+func (n NoopBlobHandler[BlobT, ReqT]) TxType(math.Slot) encoding.TxType {
+	return encoding.TxTypeBlobSidecars
 }
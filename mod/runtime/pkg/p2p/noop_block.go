@@ -54,6 +54,7 @@ func NewNoopBlockGossipHandler[BeaconBlockT interface {
 // Publish takes a BeaconBlock and returns the ssz marshalled data.
 func (n NoopBlockGossipHandler[BeaconBlockT, ReqT]) Publish(
 	_ context.Context,
+	_ math.Slot,
 	data BeaconBlockT,
 ) ([]byte, error) {
 	return data.MarshalSSZ()
@@ -64,9 +65,21 @@ func (n NoopBlockGossipHandler[BeaconBlockT, ReqT]) Request(
 	_ context.Context,
 	req ReqT,
 ) (BeaconBlockT, error) {
+	var blk BeaconBlockT
+	slot := math.Slot(req.GetHeight())
+	if err := encoding.CheckLayout(
+		n.chainSpec, slot, req.GetTxs(),
+	); err != nil {
+		return blk, err
+	}
 	return encoding.UnmarshalBeaconBlockFromABCIRequest[BeaconBlockT](
-		req,
-		0,
-		n.chainSpec.ActiveForkVersionForSlot(math.U64(req.GetHeight())),
+		req, n.chainSpec.ActiveForkVersionForSlot(slot),
 	)
 }
+
+// TxType returns the type of the transaction carrying the published data.
+func (n NoopBlockGossipHandler[BeaconBlockT, ReqT]) TxType(
+	math.Slot,
+) encoding.TxType {
+	return encoding.TxTypeBeaconBlock
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package telemetry
+
+const (
+	// defaultListenAddress is the default address the metrics server
+	// listens on.
+	defaultListenAddress = "0.0.0.0:9102"
+)
+
+// Config is the configuration for the Prometheus metrics registry and its
+// HTTP server.
+type Config struct {
+	// Enabled determines if the metrics server is started.
+	Enabled bool `mapstructure:"enabled"`
+	// ListenAddress is the address the metrics server listens on.
+	ListenAddress string `mapstructure:"listen-address"`
+	// GlobalLabels are constant labels attached to every series.
+	GlobalLabels map[string]string `mapstructure:"global-labels"`
+}
+
+// DefaultConfig returns the default configuration for the metrics registry.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:       true,
+		ListenAddress: defaultListenAddress,
+		GlobalLabels:  make(map[string]string),
+	}
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package telemetry
+
+import "strings"
+
+// Kind is the kind of a metric.
+type Kind uint8
+
+const (
+	// KindCounter is a monotonically increasing counter.
+	KindCounter Kind = iota
+	// KindGauge is a value that can go up and down.
+	KindGauge
+	// KindHistogram samples observations into buckets.
+	KindHistogram
+)
+
+// String returns the name of the kind.
+func (k Kind) String() string {
+	switch k {
+	case KindCounter:
+		return "counter"
+	case KindGauge:
+		return "gauge"
+	case KindHistogram:
+		return "histogram"
+	default:
+		return "unknown"
+	}
+}
+
+// Descriptor declares a metric ahead of its first use. The key is the dotted
+// key used by the TelemetrySink callers, e.g.
+// "beacon_kit.execution.client.new_payload_duration".
+type Descriptor struct {
+	// Key is the dotted key of the metric.
+	Key string
+	// Help is the help text exposed with the metric.
+	Help string
+	// Kind is the kind of the metric.
+	Kind Kind
+	// Labels are the variable label names of the metric. Label values not
+	// supplied by a caller are left empty.
+	Labels []string
+	// Buckets are the histogram buckets, in seconds for durations. If nil,
+	// DurationBuckets are used.
+	Buckets []float64
+}
+
+// Name returns the Prometheus name of the metric.
+func (d Descriptor) Name() string {
+	return strings.NewReplacer(".", "_", "-", "_").Replace(d.Key)
+}
+
+//nolint:gochecknoglobals // bucket layouts.
+var (
+	// DurationBuckets are the default buckets for durations, in seconds.
+	DurationBuckets = []float64{
+		.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10,
+	}
+	// FastDurationBuckets are buckets for operations that are expected to
+	// complete within a few milliseconds.
+	FastDurationBuckets = []float64{
+		.0001, .00025, .0005, .001, .0025, .005, .01, .025, .05, .1, .25,
+	}
+)
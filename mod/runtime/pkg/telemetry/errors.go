@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package telemetry
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrAlreadyDeclared is returned when a metric is declared twice.
+	ErrAlreadyDeclared = errors.New("metric already declared")
+	// ErrUnknownKind is returned when a metric has an unknown kind.
+	ErrUnknownKind = errors.New("unknown metric kind")
+	// ErrUndeclared is returned when a metric is used without having been
+	// declared.
+	ErrUndeclared = errors.New("metric not declared")
+	// ErrKindMismatch is returned when a metric is used as a different kind
+	// than it was declared with.
+	ErrKindMismatch = errors.New("metric used with mismatched kind")
+)
@@ -0,0 +1,235 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package telemetry
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// Registry is a Prometheus registry of typed BeaconKit metrics. Metrics are
+// addressed by their dotted key and must be declared with their kind,
+// labels and buckets before use. Updates of undeclared metrics are dropped
+// and reported to the error handler.
+type Registry struct {
+	// registry is the underlying Prometheus registry.
+	registry *prometheus.Registry
+	// constLabels are the labels attached to every series.
+	constLabels prometheus.Labels
+
+	// mu protects the maps below.
+	mu sync.RWMutex
+	// descriptors maps a metric key to its declaration.
+	descriptors map[string]Descriptor
+	// counters maps a metric key to its counter.
+	counters map[string]*prometheus.CounterVec
+	// gauges maps a metric key to its gauge.
+	gauges map[string]*prometheus.GaugeVec
+	// histograms maps a metric key to its histogram.
+	histograms map[string]*prometheus.HistogramVec
+	// onError is called with the error of every dropped update.
+	onError func(error)
+}
+
+// NewRegistry creates a new Registry with the given constant labels and
+// registers the given descriptors along with the Go runtime and process
+// collectors.
+func NewRegistry(
+	constLabels map[string]string,
+	descs ...Descriptor,
+) (*Registry, error) {
+	r := &Registry{
+		registry:    prometheus.NewRegistry(),
+		constLabels: constLabels,
+		descriptors: make(map[string]Descriptor),
+		counters:    make(map[string]*prometheus.CounterVec),
+		gauges:      make(map[string]*prometheus.GaugeVec),
+		histograms:  make(map[string]*prometheus.HistogramVec),
+	}
+
+	if err := r.registry.Register(collectors.NewGoCollector()); err != nil {
+		return nil, err
+	}
+	if err := r.registry.Register(
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	); err != nil {
+		return nil, err
+	}
+
+	for _, desc := range descs {
+		if err := r.Declare(desc); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Gatherer returns the Prometheus gatherer of the registry.
+func (r *Registry) Gatherer() prometheus.Gatherer {
+	return r.registry
+}
+
+// OnError sets the handler called with the error of every dropped metric
+// update, such as an update of an undeclared metric.
+func (r *Registry) OnError(handler func(error)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.onError = handler
+}
+
+// Declare registers the metric described by desc.
+func (r *Registry) Declare(desc Descriptor) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.declare(desc)
+}
+
+// declare registers the metric described by desc. It must be called with
+// the lock held.
+func (r *Registry) declare(desc Descriptor) error {
+	if _, ok := r.descriptors[desc.Key]; ok {
+		return errors.Wrap(ErrAlreadyDeclared, desc.Key)
+	}
+
+	var collector prometheus.Collector
+	switch desc.Kind {
+	case KindCounter:
+		vec := prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        desc.Name(),
+			Help:        desc.Help,
+			ConstLabels: r.constLabels,
+		}, desc.Labels)
+		r.counters[desc.Key], collector = vec, vec
+	case KindGauge:
+		vec := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        desc.Name(),
+			Help:        desc.Help,
+			ConstLabels: r.constLabels,
+		}, desc.Labels)
+		r.gauges[desc.Key], collector = vec, vec
+	case KindHistogram:
+		buckets := desc.Buckets
+		if buckets == nil {
+			buckets = DurationBuckets
+		}
+		vec := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:        desc.Name(),
+			Help:        desc.Help,
+			ConstLabels: r.constLabels,
+			Buckets:     buckets,
+		}, desc.Labels)
+		r.histograms[desc.Key], collector = vec, vec
+	default:
+		return errors.Wrapf(ErrUnknownKind, "%d", desc.Kind)
+	}
+
+	if err := r.registry.Register(collector); err != nil {
+		return err
+	}
+	r.descriptors[desc.Key] = desc
+	return nil
+}
+
+// IncrementCounter increments the counter identified by key. The args are
+// label name/value pairs.
+func (r *Registry) IncrementCounter(key string, args ...string) {
+	vec, desc, err := lookup(r, key, KindCounter, r.counters)
+	if err != nil {
+		r.report(err)
+		return
+	}
+	vec.WithLabelValues(desc.labelValues(args)...).Inc()
+}
+
+// SetGauge sets the gauge identified by key to value. The args are label
+// name/value pairs.
+func (r *Registry) SetGauge(key string, value float64, args ...string) {
+	vec, desc, err := lookup(r, key, KindGauge, r.gauges)
+	if err != nil {
+		r.report(err)
+		return
+	}
+	vec.WithLabelValues(desc.labelValues(args)...).Set(value)
+}
+
+// Observe records value in the histogram identified by key. The args are
+// label name/value pairs.
+func (r *Registry) Observe(key string, value float64, args ...string) {
+	vec, desc, err := lookup(r, key, KindHistogram, r.histograms)
+	if err != nil {
+		r.report(err)
+		return
+	}
+	vec.WithLabelValues(desc.labelValues(args)...).Observe(value)
+}
+
+// lookup returns the collector declared for key in collectors.
+func lookup[VecT any](
+	r *Registry,
+	key string,
+	kind Kind,
+	collectors map[string]VecT,
+) (VecT, Descriptor, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	vec, ok := collectors[key]
+	desc, declared := r.descriptors[key]
+	switch {
+	case ok:
+		return vec, desc, nil
+	case declared:
+		return vec, desc, errors.Wrapf(
+			ErrKindMismatch, "%s declared as %s, used as %s",
+			key, desc.Kind, kind,
+		)
+	default:
+		return vec, desc, errors.Wrap(ErrUndeclared, key)
+	}
+}
+
+// report passes err to the error handler, if any.
+func (r *Registry) report(err error) {
+	r.mu.RLock()
+	handler := r.onError
+	r.mu.RUnlock()
+	if handler != nil {
+		handler(err)
+	}
+}
+
+// labelValues returns the values of the declared labels from the given
+// name/value pairs, in declaration order. Labels that are not declared are
+// dropped and declared labels that are not supplied are left empty.
+func (d Descriptor) labelValues(args []string) []string {
+	values := make([]string, len(d.Labels))
+	for i := 0; i+1 < len(args); i += 2 {
+		for j, name := range d.Labels {
+			if name == args[i] {
+				values[j] = args[i+1]
+				break
+			}
+		}
+	}
+	return values
+}
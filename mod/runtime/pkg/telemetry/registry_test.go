@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package telemetry_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/telemetry"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_DeclaredMetrics(t *testing.T) {
+	r, err := telemetry.NewRegistry(
+		map[string]string{"chain_id": "80087"},
+		telemetry.DefaultDescriptors()...,
+	)
+	require.NoError(t, err)
+
+	r.IncrementCounter(
+		"beacon_kit.execution.engine.new_payload",
+		"payload_block_hash", "0xabc",
+		"is_optimistic", "true",
+	)
+	r.SetGauge("beacon_kit.chain.head_slot", 42, "fork", "deneb")
+
+	expected := `
+# HELP beacon_kit_execution_engine_new_payload Number of new payload calls.
+# TYPE beacon_kit_execution_engine_new_payload counter
+beacon_kit_execution_engine_new_payload{chain_id="80087",is_optimistic="true"} 1
+# HELP beacon_kit_chain_head_slot Slot of the latest finalized beacon block.
+# TYPE beacon_kit_chain_head_slot gauge
+beacon_kit_chain_head_slot{chain_id="80087",fork="deneb"} 42
+`
+	require.NoError(t, testutil.GatherAndCompare(
+		r.Gatherer(),
+		strings.NewReader(expected),
+		"beacon_kit_execution_engine_new_payload",
+		"beacon_kit_chain_head_slot",
+	))
+}
+
+func TestRegistry_UndeclaredMetrics(t *testing.T) {
+	r, err := telemetry.NewRegistry(nil, telemetry.Descriptor{
+		Key:  "beacon_kit.test.counter",
+		Kind: telemetry.KindCounter,
+	})
+	require.NoError(t, err)
+	var errs []error
+	r.OnError(func(err error) { errs = append(errs, err) })
+
+	r.IncrementCounter("beacon_kit.test.counter")
+	r.Observe("beacon_kit.test.duration", 0.2)
+	r.SetGauge("beacon_kit.test.counter", 1)
+
+	require.Len(t, errs, 2)
+	require.ErrorIs(t, errs[0], telemetry.ErrUndeclared)
+	require.ErrorIs(t, errs[1], telemetry.ErrKindMismatch)
+
+	count, err := testutil.GatherAndCount(
+		r.Gatherer(),
+		"beacon_kit_test_counter",
+		"beacon_kit_test_duration",
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
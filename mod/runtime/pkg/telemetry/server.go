@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package telemetry
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	// readHeaderTimeout is the timeout for reading request headers.
+	readHeaderTimeout = 5 * time.Second
+	// shutdownTimeout is the timeout for gracefully shutting down the
+	// server.
+	shutdownTimeout = 5 * time.Second
+)
+
+// Server serves the metrics of a Registry on /metrics.
+type Server struct {
+	// cfg is the configuration of the server.
+	cfg Config
+	// logger is the logger of the server.
+	logger log.Logger[any]
+	// registry is the registry that is served.
+	registry *Registry
+}
+
+// NewServer creates a new metrics Server.
+func NewServer(
+	cfg Config,
+	logger log.Logger[any],
+	registry *Registry,
+) *Server {
+	return &Server{
+		cfg:      cfg,
+		logger:   logger,
+		registry: registry,
+	}
+}
+
+// Name returns the name of the service.
+func (s *Server) Name() string {
+	return "metrics-server"
+}
+
+// Start starts serving metrics until the context is cancelled.
+func (s *Server) Start(ctx context.Context) error {
+	if !s.cfg.Enabled {
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(
+		s.registry.Gatherer(),
+		promhttp.HandlerOpts{},
+	))
+	srv := &http.Server{
+		Addr:              s.cfg.ListenAddress,
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	go func() {
+		s.logger.Info("Serving metrics", "address", s.cfg.ListenAddress)
+		if err := srv.ListenAndServe(); err != nil &&
+			!errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error("Metrics server failed", "error", err)
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		//nolint:contextcheck // the parent context is already done.
+		shutdownCtx, cancel := context.WithTimeout(
+			context.Background(), shutdownTimeout,
+		)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			s.logger.Error("Failed to shut down metrics server", "error", err)
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,415 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package telemetry
+
+// DefaultDescriptors returns the declared metrics of every BeaconKit
+// subsystem.
+func DefaultDescriptors() []Descriptor {
+	descs := make([]Descriptor, 0)
+	for _, subsystem := range [][]Descriptor{
+		chainDescriptors(),
+		blockchainDescriptors(),
+		validatorDescriptors(),
+		daDescriptors(),
+		engineDescriptors(),
+		engineClientDescriptors(),
+		depositDescriptors(),
+		runtimeDescriptors(),
+		storageDescriptors(),
+	} {
+		descs = append(descs, subsystem...)
+	}
+	return descs
+}
+
+// chainDescriptors declares the slot, fork and client labelled series that
+// describe the head of the chain.
+func chainDescriptors() []Descriptor {
+	return []Descriptor{
+		{
+			Key:    "beacon_kit.chain.head_slot",
+			Help:   "Slot of the latest finalized beacon block.",
+			Kind:   KindGauge,
+			Labels: []string{"fork"},
+		},
+		{
+			Key:    "beacon_kit.chain.execution_client",
+			Help:   "Execution client the node is connected to, always 1.",
+			Kind:   KindGauge,
+			Labels: []string{"client", "version"},
+		},
+	}
+}
+
+// blockchainDescriptors declares the metrics of the blockchain service.
+func blockchainDescriptors() []Descriptor {
+	return []Descriptor{
+		{
+			Key:  "beacon_kit.beacon.blockchain.state_transition_duration",
+			Help: "Time taken to run the state transition for a block.",
+			Kind: KindHistogram,
+		},
+		{
+			Key:  "beacon_kit.blockchain.state_root_verification_duration",
+			Help: "Time taken to verify the state root of a block.",
+			Kind: KindHistogram,
+		},
+		{
+			Key:  "beacon_kit.blockchain.optimistic_payload_build_success",
+			Help: "Number of successful optimistic payload builds.",
+			Kind: KindCounter,
+		},
+		{
+			Key:    "beacon_kit.blockchain.optimistic_payload_build_failure",
+			Help:   "Number of failed optimistic payload builds.",
+			Kind:   KindCounter,
+			Labels: []string{"reason"},
+		},
+		{
+			Key: "beacon_kit.blockchain." +
+				"rebuild_payload_for_rejected_block_success",
+			Help: "Number of payloads rebuilt after a rejected block.",
+			Kind: KindCounter,
+		},
+		{
+			Key: "beacon_kit.blockchain." +
+				"rebuild_payload_for_rejected_block_failure",
+			Help:   "Number of failed payload rebuilds after a rejected block.",
+			Kind:   KindCounter,
+			Labels: []string{"reason"},
+		},
+		{
+			Key:  "beacon_kit.blockchain.post_block_queue_depth",
+			Help: "Number of finalized blocks whose post block work is pending.",
+			Kind: KindGauge,
+		},
+		{
+			Key:  "beacon_kit.blockchain.post_block_task_duration",
+			Help: "Time taken to run the post block work of a block.",
+			Kind: KindHistogram,
+		},
+		{
+			Key:  "beacon_kit.blockchain.post_block_fcu_superseded",
+			Help: "Number of post block forkchoice updates superseded.",
+			Kind: KindCounter,
+		},
+		{
+			Key:    "beacon_kit.blockchain.post_block_fcu_failure",
+			Help:   "Number of failed forkchoice updates after a block.",
+			Kind:   KindCounter,
+			Labels: []string{"reason"},
+		},
+		{
+			Key:  "beacon_kit.blockchain.fcu_retry_success",
+			Help: "Number of owed forkchoice updates retried successfully.",
+			Kind: KindCounter,
+		},
+		{
+			Key:    "beacon_kit.blockchain.fcu_retry_failure",
+			Help:   "Number of failed retries of owed forkchoice updates.",
+			Kind:   KindCounter,
+			Labels: []string{"reason"},
+		},
+	}
+}
+
+// validatorDescriptors declares the metrics of the validator service.
+func validatorDescriptors() []Descriptor {
+	return []Descriptor{
+		{
+			Key:  "beacon_kit.validator.request_block_for_proposal_duration",
+			Help: "Time taken to build a block for a proposal.",
+			Kind: KindHistogram,
+		},
+		{
+			Key:  "beacon_kit.validator.state_root_computation_duration",
+			Help: "Time taken to compute the state root of a proposal.",
+			Kind: KindHistogram,
+		},
+		{
+			Key:    "beacon_kit.validator.failed_to_retrieve_payload",
+			Help:   "Number of times a payload could not be retrieved.",
+			Kind:   KindCounter,
+			Labels: []string{"slot", "error"},
+		},
+		{
+			Key:  "beacon_kit.validator.remote_payload_used",
+			Help: "Number of proposals built with a payload from the relay.",
+			Kind: KindCounter,
+		},
+		{
+			Key:    "beacon_kit.validator.remote_payload_fallback",
+			Help:   "Number of proposals that fell back to the local payload.",
+			Kind:   KindCounter,
+			Labels: []string{"error"},
+		},
+	}
+}
+
+// daDescriptors declares the metrics of the data availability layer.
+func daDescriptors() []Descriptor {
+	descs := make([]Descriptor, 0)
+	for _, d := range []struct{ key, help string }{
+		{
+			"beacon_kit.da.blob.processor.process_blob_duration",
+			"Time taken to process blob sidecars.",
+		},
+		{
+			"beacon_kit.da.blob.processor.verify_blobs_duration",
+			"Time taken to verify blob sidecars in the processor.",
+		},
+		{
+			"beacon_kit.da.blob.verifier.verify_blobs_duration",
+			"Time taken to verify blob sidecars.",
+		},
+		{
+			"beacon_kit.da.blob.verifier.verify_inclusion_proofs_duration",
+			"Time taken to verify the inclusion proofs of blob sidecars.",
+		},
+		{
+			"beacon_kit.da.blob.verifier.verify_kzg_proofs_duration",
+			"Time taken to verify the KZG proofs of blob sidecars.",
+		},
+		{
+			"beacon_kit.da.blob.factory.build_sidecar_duration",
+			"Time taken to build blob sidecars.",
+		},
+		{
+			"beacon_kit.da.blob.factory.build_kzg_inclusion_proof_duration",
+			"Time taken to build a KZG inclusion proof.",
+		},
+		{
+			"beacon_kit.da.blob.factory.build_block_body_proof_duration",
+			"Time taken to build a block body proof.",
+		},
+		{
+			"beacon_kit.da.blob.factory.build_commitment_proof_duration",
+			"Time taken to build a commitment proof.",
+		},
+	} {
+		descs = append(descs, Descriptor{
+			Key:     d.key,
+			Help:    d.help,
+			Kind:    KindHistogram,
+			Labels:  []string{"num_sidecars", "kzg_implementation"},
+			Buckets: FastDurationBuckets,
+		})
+	}
+	return descs
+}
+
+// engineDescriptors declares the metrics of the execution engine.
+func engineDescriptors() []Descriptor {
+	return []Descriptor{
+		{
+			Key:    "beacon_kit.execution.engine.new_payload",
+			Help:   "Number of new payload calls.",
+			Kind:   KindCounter,
+			Labels: []string{"is_optimistic"},
+		},
+		{
+			Key: "beacon_kit.execution.engine." +
+				"new_payload_accepted_syncing_payload_status",
+			Help:   "Number of new payload calls returning ACCEPTED/SYNCING.",
+			Kind:   KindCounter,
+			Labels: []string{"is_optimistic"},
+		},
+		{
+			Key:    "beacon_kit.execution.engine.new_payload_invalid_payload_status",
+			Help:   "Number of new payload calls returning INVALID.",
+			Kind:   KindCounter,
+			Labels: []string{"is_optimistic"},
+		},
+		{
+			Key:    "beacon_kit.execution.engine.new_payload_json_rpc_error",
+			Help:   "Number of new payload calls failing with a JSON-RPC error.",
+			Kind:   KindCounter,
+			Labels: []string{"is_optimistic", "error"},
+		},
+		{
+			Key:    "beacon_kit.execution.engine.new_payload_undefined_error",
+			Help:   "Number of new payload calls failing with an unknown error.",
+			Kind:   KindCounter,
+			Labels: []string{"is_optimistic", "error"},
+		},
+		{
+			Key:    "beacon_kit.execution.engine.forkchoice_update",
+			Help:   "Number of forkchoice update calls.",
+			Kind:   KindCounter,
+			Labels: []string{"has_payload_attributes"},
+		},
+		{
+			Key:    "beacon_kit.execution.engine.forkchoice_update_accepted_syncing",
+			Help:   "Number of forkchoice updates returning SYNCING.",
+			Kind:   KindCounter,
+			Labels: []string{"error"},
+		},
+		{
+			Key:    "beacon_kit.execution.engine.forkchoice_update_invalid",
+			Help:   "Number of forkchoice updates returning INVALID.",
+			Kind:   KindCounter,
+			Labels: []string{"error"},
+		},
+		{
+			Key:    "beacon_kit.execution.engine.forkchoice_update_json_rpc_error",
+			Help:   "Number of forkchoice updates failing with a JSON-RPC error.",
+			Kind:   KindCounter,
+			Labels: []string{"error"},
+		},
+		{
+			Key:    "beacon_kit.execution.engine.forkchoice_update_undefined_error",
+			Help:   "Number of forkchoice updates failing with an unknown error.",
+			Kind:   KindCounter,
+			Labels: []string{"error"},
+		},
+	}
+}
+
+// engineClientDescriptors declares the metrics of the engine API client.
+func engineClientDescriptors() []Descriptor {
+	descs := []Descriptor{
+		{
+			Key:  "beacon_kit.execution.client.forkchoice_update_duration",
+			Help: "Latency of engine_forkchoiceUpdated calls.",
+			Kind: KindHistogram,
+		},
+		{
+			Key:  "beacon_kit.execution.client.new_payload_duration",
+			Help: "Latency of engine_newPayload calls.",
+			Kind: KindHistogram,
+		},
+		{
+			Key:  "beacon_kit.execution.client.get_payload_duration",
+			Help: "Latency of engine_getPayload calls.",
+			Kind: KindHistogram,
+		},
+	}
+	descs = append(descs,
+		Descriptor{
+			Key:  "beacon_kit.execution.client.auth_failure",
+			Help: "Number of engine API calls rejected as unauthorized.",
+			Kind: KindCounter,
+		},
+		Descriptor{
+			Key:  "beacon_kit.execution.client.jwt_reload",
+			Help: "Number of times the JWT secret was reloaded.",
+			Kind: KindCounter,
+		},
+		Descriptor{
+			Key:  "beacon_kit.execution.client.jwt_reload_failure",
+			Help: "Number of times the JWT secret failed to reload.",
+			Kind: KindCounter,
+		},
+	)
+	for _, key := range []string{
+		"forkchoice_update_duration_timeout",
+		"new_payload_duration_timeout",
+		"get_payload_duration_timeout",
+		"http_timeout",
+		"parse_error",
+		"invalid_request",
+		"method_not_found",
+		"invalid_params",
+		"internal_error",
+		"unknown_payload_error",
+		"invalid_forkchoice_state",
+		"invalid_payload_attributes",
+		"request_too_large",
+		"internal_server_error",
+	} {
+		descs = append(descs, Descriptor{
+			Key:  "beacon_kit.execution.client." + key,
+			Help: "Number of engine API calls failing with " + key + ".",
+			Kind: KindCounter,
+		})
+	}
+	return descs
+}
+
+// depositDescriptors declares the metrics of the deposit service.
+func depositDescriptors() []Descriptor {
+	return []Descriptor{
+		{
+			Key:    "beacon_kit.execution.deposit.failed_to_get_block_logs",
+			Help:   "Number of times deposit logs could not be fetched.",
+			Kind:   KindCounter,
+			Labels: []string{"block_num"},
+		},
+	}
+}
+
+// runtimeDescriptors declares the metrics of the ABCI runtime.
+func runtimeDescriptors() []Descriptor {
+	return []Descriptor{
+		{
+			Key:  "beacon_kit.runtime.prepare_proposal_duration",
+			Help: "Time taken to prepare a proposal.",
+			Kind: KindHistogram,
+		},
+		{
+			Key:  "beacon_kit.runtime.process_proposal_duration",
+			Help: "Time taken to process a proposal.",
+			Kind: KindHistogram,
+		},
+		{
+			Key:    "beacon_kit.runtime.version.reported",
+			Help:   "Number of times the node version was reported.",
+			Kind:   KindCounter,
+			Labels: []string{"version", "system"},
+		},
+	}
+}
+
+// storageDescriptors declares the metrics of the file databases.
+func storageDescriptors() []Descriptor {
+	return []Descriptor{
+		{
+			Key:  "beacon_kit.storage.filedb.corrupted",
+			Help: "Number of values found corrupted on read.",
+			Kind: KindCounter,
+		},
+		{
+			Key:  "beacon_kit.storage.filedb.quarantined",
+			Help: "Number of corrupted values moved to quarantine.",
+			Kind: KindCounter,
+		},
+		{
+			Key:  "beacon_kit.storage.filedb.scrub",
+			Help: "Number of scrubs run.",
+			Kind: KindCounter,
+		},
+		{
+			Key:  "beacon_kit.storage.filedb.scrub_checked",
+			Help: "Number of values checked by the last scrub.",
+			Kind: KindGauge,
+		},
+		{
+			Key:  "beacon_kit.storage.filedb.scrub_corrupted",
+			Help: "Number of corrupted values found by the last scrub.",
+			Kind: KindGauge,
+		},
+		{
+			Key:  "beacon_kit.storage.filedb.scrub_migrated",
+			Help: "Number of legacy values rewritten by the last scrub.",
+			Kind: KindGauge,
+		},
+	}
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing
+
+const (
+	// ExporterNoop discards all spans.
+	ExporterNoop = "noop"
+	// ExporterOTLP exports spans over OTLP/HTTP.
+	ExporterOTLP = "otlp"
+
+	// defaultEndpoint is the default OTLP/HTTP collector endpoint.
+	defaultEndpoint = "localhost:4318"
+	// defaultServiceName is the default service name reported with spans.
+	defaultServiceName = "beacond"
+)
+
+// Config is the configuration for OpenTelemetry tracing.
+type Config struct {
+	// Exporter is the span exporter to use, one of "noop" or "otlp".
+	Exporter string `mapstructure:"exporter"`
+	// Endpoint is the host:port of the OTLP/HTTP collector.
+	Endpoint string `mapstructure:"endpoint"`
+	// Insecure determines if the exporter connects over plain HTTP.
+	Insecure bool `mapstructure:"insecure"`
+	// SampleRatio is the fraction of traces that are sampled.
+	SampleRatio float64 `mapstructure:"sample-ratio"`
+	// ServiceName is the service name reported with every span.
+	ServiceName string `mapstructure:"service-name"`
+}
+
+// DefaultConfig returns the default tracing configuration, which discards
+// all spans.
+func DefaultConfig() Config {
+	return Config{
+		Exporter:    ExporterNoop,
+		Endpoint:    defaultEndpoint,
+		Insecure:    true,
+		SampleRatio: 1,
+		ServiceName: defaultServiceName,
+	}
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// shutdownTimeout is the timeout for flushing spans on shutdown.
+const shutdownTimeout = 5 * time.Second
+
+// Provider is a trace.TracerProvider that flushes its spans when the node
+// shuts down.
+type Provider struct {
+	trace.TracerProvider
+	// shutdown flushes and stops the exporter, nil for the no-op provider.
+	shutdown func(context.Context) error
+}
+
+// NewProvider creates a new Provider from the given configuration. If the
+// no-op exporter is configured, spans are discarded without any overhead.
+func NewProvider(cfg Config) (*Provider, error) {
+	switch cfg.Exporter {
+	case ExporterNoop, "":
+		return &Provider{TracerProvider: noop.NewTracerProvider()}, nil
+	case ExporterOTLP:
+		opts := []otlptracehttp.Option{
+			otlptracehttp.WithEndpoint(cfg.Endpoint),
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		// Creating the exporter does not dial the collector, so a
+		// background context is sufficient.
+		exporter, err := otlptracehttp.New(context.Background(), opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		tp := sdktrace.NewTracerProvider(
+			sdktrace.WithBatcher(exporter),
+			sdktrace.WithSampler(sdktrace.ParentBased(
+				sdktrace.TraceIDRatioBased(cfg.SampleRatio),
+			)),
+			sdktrace.WithResource(resource.NewSchemaless(
+				semconv.ServiceName(cfg.ServiceName),
+			)),
+		)
+		return &Provider{TracerProvider: tp, shutdown: tp.Shutdown}, nil
+	default:
+		return nil, errors.Wrap(ErrUnknownExporter, cfg.Exporter)
+	}
+}
+
+// Name returns the name of the service.
+func (p *Provider) Name() string {
+	return "tracing"
+}
+
+// Start flushes the exporter once the context is cancelled.
+func (p *Provider) Start(ctx context.Context) error {
+	if p.shutdown == nil {
+		return nil
+	}
+	go func() {
+		<-ctx.Done()
+		//nolint:contextcheck // the parent context is already done.
+		shutdownCtx, cancel := context.WithTimeout(
+			context.Background(), shutdownTimeout,
+		)
+		defer cancel()
+		_ = p.shutdown(shutdownCtx)
+	}()
+	return nil
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/tracing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProvider(t *testing.T) {
+	tests := []struct {
+		name     string
+		exporter string
+		sampled  bool
+		wantErr  error
+	}{
+		{name: "noop", exporter: tracing.ExporterNoop},
+		{name: "empty exporter", exporter: ""},
+		{name: "otlp", exporter: tracing.ExporterOTLP, sampled: true},
+		{
+			name:     "unknown exporter",
+			exporter: "jaeger",
+			wantErr:  tracing.ErrUnknownExporter,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := tracing.DefaultConfig()
+			cfg.Exporter = tt.exporter
+
+			provider, err := tracing.NewProvider(cfg)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			_, span := provider.Tracer("test").Start(
+				context.Background(), "span",
+			)
+			defer span.End()
+			require.Equal(t, tt.sampled, span.SpanContext().IsSampled())
+		})
+	}
+}
+
+func TestProviderStart(t *testing.T) {
+	cfg := tracing.DefaultConfig()
+	cfg.Exporter = tracing.ExporterOTLP
+
+	provider, err := tracing.NewProvider(cfg)
+	require.NoError(t, err)
+	require.Equal(t, "tracing", provider.Name())
+
+	// Cancelling the context flushes the provider without a collector
+	// being reachable.
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, provider.Start(ctx))
+	cancel()
+}
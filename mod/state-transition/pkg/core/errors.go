@@ -51,6 +51,32 @@ var (
 	// deposit limit.
 	ErrExceedsBlockDepositLimit = errors.New("block exceeds deposit limit")
 
+	// ErrDepositCountMismatch is returned when a block does not include
+	// exactly the deposits it is expected to.
+	ErrDepositCountMismatch = errors.New("deposit count mismatch")
+
+	// ErrDepositCountTooLow is returned when the eth1 data of a block has
+	// fewer deposits than the state has already processed.
+	ErrDepositCountTooLow = errors.New(
+		"eth1 data deposit count below deposit index")
+
+	// ErrDepositRootMismatch is returned when the deposit root of the eth1
+	// data of a block does not match the local deposit tree.
+	ErrDepositRootMismatch = errors.New("deposit root mismatch")
+
+	// ErrDepositProofsMismatch is returned when a block body carries the
+	// inclusion proofs of its deposits before the deposit proof fork, or
+	// does not carry them after it.
+	ErrDepositProofsMismatch = errors.New("deposit proofs mismatch")
+
+	// ErrDepositIndexMismatch is returned when a deposit is not the next
+	// deposit to be processed.
+	ErrDepositIndexMismatch = errors.New("deposit index mismatch")
+
+	// ErrInvalidDepositProof is returned when a deposit is not included in
+	// the deposit tree of the eth1 data.
+	ErrInvalidDepositProof = errors.New("invalid deposit inclusion proof")
+
 	// ErrRewardsLengthMismatch is returned when the length of the rewards
 	// in a block does not match the expected value.
 	ErrRewardsLengthMismatch = errors.New("rewards length mismatch")
@@ -26,18 +26,21 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/telemetry"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // StateProcessor is a basic Processor, which takes care of the
 // main state transition for the beacon chain.
 type StateProcessor[
 	BeaconBlockT BeaconBlock[
-		DepositT, BeaconBlockBodyT,
+		DepositT, BeaconBlockBodyT, Eth1DataT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, DepositT,
+		BeaconBlockBodyT, DepositT, Eth1DataT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
 	],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
@@ -51,6 +54,7 @@ type StateProcessor[
 	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
 	Eth1DataT interface {
 		New(common.Root, math.U64, common.ExecutionHash) Eth1DataT
+		GetDepositRoot() common.Root
 		GetDepositCount() math.U64
 	},
 	ExecutionPayloadT ExecutionPayload[
@@ -74,17 +78,25 @@ type StateProcessor[
 	executionEngine ExecutionEngine[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
 	]
+	// depositTree is the local deposit tree that eth1 data votes are
+	// checked against.
+	depositTree DepositTree
+	// slotClock is used to check execution payload timestamps against the
+	// time of their slot.
+	slotClock SlotClock
+	// tracer is used to trace state transitions.
+	tracer trace.Tracer
 }
 
 // NewStateProcessor creates a new state processor.
 func NewStateProcessor[
 	BeaconBlockT BeaconBlock[
-		DepositT, BeaconBlockBodyT,
+		DepositT, BeaconBlockBodyT, Eth1DataT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
 		BeaconBlockBodyT,
-		DepositT, ExecutionPayloadT,
+		DepositT, Eth1DataT, ExecutionPayloadT,
 		ExecutionPayloadHeaderT,
 		WithdrawalT,
 	],
@@ -98,6 +110,7 @@ func NewStateProcessor[
 	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
 	Eth1DataT interface {
 		New(common.Root, math.U64, common.ExecutionHash) Eth1DataT
+		GetDepositRoot() common.Root
 		GetDepositCount() math.U64
 	},
 	ExecutionPayloadT ExecutionPayload[
@@ -117,6 +130,9 @@ func NewStateProcessor[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
 	],
 	signer crypto.BLSSigner,
+	depositTree DepositTree,
+	slotClock SlotClock,
+	tracer trace.Tracer,
 ) *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
@@ -133,6 +149,9 @@ func NewStateProcessor[
 		cs:              cs,
 		executionEngine: executionEngine,
 		signer:          signer,
+		depositTree:     depositTree,
+		slotClock:       slotClock,
+		tracer:          tracer,
 	}
 }
 
@@ -146,11 +165,19 @@ func (sp *StateProcessor[
 	ctx ContextT,
 	st BeaconStateT,
 	blk BeaconBlockT,
-) (transition.ValidatorUpdates, error) {
+) (_ transition.ValidatorUpdates, err error) {
 	if blk.IsNil() {
 		return nil, nil
 	}
 
+	_, span := sp.tracer.Start(
+		ctx, "Transition",
+		trace.WithAttributes(
+			attribute.Int64("slot", int64(blk.GetSlot().Unwrap())),
+		),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	// Process the slots.
 	validatorUpdates, err := sp.ProcessSlots(st, blk.GetSlot())
 	if err != nil {
@@ -317,9 +344,12 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	// TODO:
-	//
-	// phase0.ProcessEth1Vote
+	// process the eth1 data voted for by the block.
+	if err := sp.processEth1Data(
+		st, blk.GetBody(), !ctx.GetOptimisticEngine(),
+	); err != nil {
+		return err
+	}
 
 	// process the deposits and ensure they match the local state.
 	if err := sp.processOperations(st, blk); err != nil {
@@ -23,6 +23,7 @@ package core
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4881"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
@@ -67,9 +68,30 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
-	if err := st.SetEth1Data(eth1Data.New(
-		common.Bytes32(common.ZeroHash),
-		0,
+	// From the deposit proof fork on, the genesis eth1 data holds the deposit
+	// tree made of the genesis deposits.
+	proven, err := sp.depositsProven(st)
+	if err != nil {
+		return nil, err
+	}
+	depositRoot, depositCount := common.Root{}, uint64(0)
+	if proven {
+		depositTree := eip4881.NewDepositTree()
+		for _, deposit := range deposits {
+			var leaf common.Root
+			if leaf, err = deposit.DataRoot(); err != nil {
+				return nil, err
+			}
+			if err = depositTree.PushLeaf(leaf); err != nil {
+				return nil, err
+			}
+		}
+		depositRoot, depositCount = depositTree.Root(), depositTree.Count()
+	}
+
+	if err = st.SetEth1Data(eth1Data.New(
+		depositRoot,
+		math.U64(depositCount),
 		executionPayloadHeader.GetBlockHash(),
 	)); err != nil {
 		return nil, err
@@ -98,9 +120,13 @@ func (sp *StateProcessor[
 		}
 	}
 
-	for _, deposit := range deposits {
-		// TODO: process deposits into eth1 data.
-		if err = sp.processDeposit(st, deposit); err != nil {
+	// The genesis deposits are trusted, hence they are applied without
+	// checking their inclusion proofs.
+	for i, deposit := range deposits {
+		if err = st.SetEth1DepositIndex(uint64(i) + 1); err != nil {
+			return nil, err
+		}
+		if err = sp.applyDeposit(st, deposit); err != nil {
 			return nil, err
 		}
 	}
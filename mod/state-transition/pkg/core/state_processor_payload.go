@@ -108,6 +108,24 @@ func (sp *StateProcessor[
 		)
 	}
 
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	// From the payload timestamp fork on, ensure the payload timestamp is
+	// after the parent one and close enough to the time of the slot, so
+	// proposers cannot pick arbitrary timestamps.
+	if sp.cs.SlotToEpoch(slot) >= sp.cs.PayloadTimestampForkEpoch() {
+		if err = sp.slotClock.ValidatePayloadTimestamp(
+			slot,
+			payload.GetTimestamp().Unwrap(),
+			lph.GetTimestamp().Unwrap(),
+		); err != nil {
+			return err
+		}
+	}
+
 	parentBeaconBlockRoot := blk.GetParentBlockRoot()
 	if err = sp.executionEngine.VerifyAndNotifyNewPayload(
 		ctx, engineprimitives.BuildNewPayloadRequest(
@@ -120,12 +138,6 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	// Get the current epoch.
-	slot, err := st.GetSlot()
-	if err != nil {
-		return err
-	}
-
 	// When we are verifying a payload we expect that it was produced by
 	// the proposer for the slot that it is for.
 	expectedMix, err := st.GetRandaoMixAtIndex(
@@ -143,18 +155,6 @@ func (sp *StateProcessor[
 		)
 	}
 
-	// TODO: Verify timestamp data once Clock is done.
-	// if expectedTime, err := spec.TimeAtSlot(slot, genesisTime); err !=
-	// nil { 	return errors.Newf("slot or genesis time in state is corrupt,
-	// cannot
-	// compute time: %v", err)
-	// } else if payload.Timestamp != expectedTime {
-	// 	return errors.Newf("state at slot %d, genesis time %d, expected
-	// execution
-	// payload time %d, but got %d",
-	// 		slot, genesisTime, expectedTime, payload.Timestamp)
-	// }
-
 	// Verify the number of blobs.
 	blobKzgCommitments := body.GetBlobKzgCommitments()
 	if uint64(len(blobKzgCommitments)) > sp.cs.MaxBlobsPerBlock() {
@@ -23,11 +23,70 @@ package core
 import (
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/davecgh/go-spew/spew"
 )
 
+// processEth1Data adopts the eth1 data voted for by the block. Since every
+// block is agreed upon by the validator set, the vote takes effect
+// immediately instead of waiting for a voting period to end. If
+// verifyRoot is set, the deposit root is checked against the local
+// deposit tree when it knows the root, a node whose deposits lag behind
+// relies on the inclusion proofs of the deposits instead. Blocks before
+// the deposit proof fork vote for nothing.
+func (sp *StateProcessor[
+	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+]) processEth1Data(
+	st BeaconStateT,
+	body BeaconBlockBodyT,
+	verifyRoot bool,
+) error {
+	proven, err := sp.depositsProven(st)
+	if err != nil {
+		return err
+	}
+	if body.HasDepositProofs() != proven {
+		return errors.Wrapf(
+			ErrDepositProofsMismatch, "expected %t, got %t",
+			proven, body.HasDepositProofs(),
+		)
+	}
+	if !proven {
+		return nil
+	}
+
+	eth1Data := body.GetEth1Data()
+	index, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return err
+	}
+
+	count := uint64(eth1Data.GetDepositCount())
+	if count < index {
+		return errors.Wrapf(
+			ErrDepositCountTooLow,
+			"deposit count %d, deposit index %d", count, index,
+		)
+	}
+
+	if verifyRoot {
+		root, known := sp.depositTree.DepositRoot(count)
+		if known && root != eth1Data.GetDepositRoot() {
+			return errors.Wrapf(
+				ErrDepositRootMismatch, "expected %s, got %s",
+				root, eth1Data.GetDepositRoot(),
+			)
+		}
+	}
+	return st.SetEth1Data(eth1Data)
+}
+
 // processOperations processes the operations and ensures they match the
 // local state.
 func (sp *StateProcessor[
@@ -39,9 +98,17 @@ func (sp *StateProcessor[
 	st BeaconStateT,
 	blk BeaconBlockT,
 ) error {
+	deposits := blk.GetBody().GetDeposits()
+	proven, err := sp.depositsProven(st)
+	if err != nil {
+		return err
+	}
+	if !proven {
+		return sp.processDeposits(st, deposits)
+	}
+
 	// Verify that outstanding deposits are processed up to the maximum number
 	// of deposits.
-	deposits := blk.GetBody().GetDeposits()
 	index, err := st.GetEth1DepositIndex()
 	if err != nil {
 		return err
@@ -54,11 +121,12 @@ func (sp *StateProcessor[
 		sp.cs.MaxDepositsPerBlock(),
 		uint64(eth1Data.GetDepositCount())-index,
 	)
-	_ = depositCount
-	// TODO: Update eth1data count and check this.
-	// if uint64(len(deposits)) != depositCount {
-	// 	return errors.New("deposit count mismatch")
-	// }
+	if uint64(len(deposits)) != depositCount {
+		return errors.Wrapf(
+			ErrDepositCountMismatch, "expected %d, got %d",
+			depositCount, len(deposits),
+		)
+	}
 	return sp.processDeposits(st, deposits)
 }
 
@@ -92,21 +160,19 @@ func (sp *StateProcessor[
 	st BeaconStateT,
 	dep DepositT,
 ) error {
-	// TODO: fill this in properly
-	// if !sp.isValidMerkleBranch(
-	// 	leaf,
-	// 	dep.Credentials,
-	// 	32 + 1,
-	// 	dep.Index,
-	// 	st.root,
-	// ) {
-	// 	return errors.New("invalid merkle branch")
-	// }
-
 	depositIndex, err := st.GetEth1DepositIndex()
 	if err != nil {
 		return err
 	}
+	proven, err := sp.depositsProven(st)
+	if err != nil {
+		return err
+	}
+	if proven {
+		if err = sp.verifyDeposit(st, dep, depositIndex); err != nil {
+			return err
+		}
+	}
 
 	if err = st.SetEth1DepositIndex(
 		depositIndex + 1,
@@ -117,6 +183,48 @@ func (sp *StateProcessor[
 	return sp.applyDeposit(st, dep)
 }
 
+// verifyDeposit ensures the deposit is the next one to be processed and is
+// included in the deposit tree voted for by the eth1 data.
+func (sp *StateProcessor[
+	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+]) verifyDeposit(
+	st BeaconStateT,
+	dep DepositT,
+	depositIndex uint64,
+) error {
+	if dep.GetIndex() != depositIndex {
+		return errors.Wrapf(
+			ErrDepositIndexMismatch, "expected %d, got %d",
+			depositIndex, dep.GetIndex(),
+		)
+	}
+
+	eth1Data, err := st.GetEth1Data()
+	if err != nil {
+		return err
+	}
+	leaf, err := dep.DataRoot()
+	if err != nil {
+		return err
+	}
+	proof := dep.GetProof()
+	if !merkle.IsValidMerkleBranch(
+		leaf,
+		proof[:],
+		constants.DepositProofLength,
+		depositIndex,
+		eth1Data.GetDepositRoot(),
+	) {
+		return errors.Wrapf(
+			ErrInvalidDepositProof, "deposit index %d", depositIndex,
+		)
+	}
+	return nil
+}
+
 // applyDeposit processes the deposit and ensures it matches the local state.
 func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
@@ -136,10 +244,21 @@ func (sp *StateProcessor[
 			return err
 		}
 
-		// TODO: Modify balance here and then effective balance once per epoch.
+		// TODO: Update the effective balance once per epoch instead.
 		val.SetEffectiveBalance(min(val.GetEffectiveBalance()+dep.GetAmount(),
 			math.Gwei(sp.cs.MaxEffectiveBalance())))
-		return st.UpdateValidatorAtIndex(idx, val)
+		if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+			return err
+		}
+
+		// Top-ups only credit the balance from the staking fix on.
+		var fixed bool
+		if fixed, err = sp.stakingFixed(st); err != nil {
+			return err
+		} else if !fixed {
+			return nil
+		}
+		return st.IncreaseBalance(idx, dep.GetAmount())
 	}
 
 	// If the validator does not exist, we add the validator.
@@ -229,7 +348,16 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	return st.IncreaseBalance(idx, dep.GetAmount())
+	// Before the staking fix, the balance of a new validator is credited
+	// its effective balance on top of its deposit.
+	amount := dep.GetAmount()
+	fixed, err := sp.stakingFixed(st)
+	if err != nil {
+		return err
+	} else if !fixed {
+		amount += val.GetEffectiveBalance()
+	}
+	return st.IncreaseBalance(idx, amount)
 }
 
 // processWithdrawals as per the Ethereum 2.0 specification.
@@ -300,13 +428,23 @@ func (sp *StateProcessor[
 		return err
 	}
 
+	fixed, err := sp.stakingFixed(st)
+	if err != nil {
+		return err
+	}
+
 	// Update the next validator index to start the next withdrawal sweep
 	//#nosec:G701 // won't overflow in practice.
 	if numWithdrawals == int(sp.cs.MaxWithdrawalsPerPayload()) {
-		// Next sweep starts after the latest withdrawal's validator index
-		nextValidatorIndex =
-			(expectedWithdrawals[len(expectedWithdrawals)-1].GetIndex() + 1) %
-				math.U64(totalValidators)
+		// Next sweep starts after the latest withdrawal's validator index,
+		// which was taken from its withdrawal index before the staking fix.
+		last := expectedWithdrawals[len(expectedWithdrawals)-1]
+		nextValidatorIndex = last.GetIndex()
+		if fixed {
+			nextValidatorIndex = last.GetValidatorIndex()
+		}
+		nextValidatorIndex = (nextValidatorIndex + 1) %
+			math.ValidatorIndex(totalValidators)
 	} else {
 		// Advance sweep by the max length of the sweep if there was not
 		// a full set of withdrawals
@@ -321,3 +459,37 @@ func (sp *StateProcessor[
 
 	return st.SetNextWithdrawalValidatorIndex(nextValidatorIndex)
 }
+
+// stakingFixed returns whether the staking fix is active at the slot of the
+// given state.
+func (sp *StateProcessor[
+	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+]) stakingFixed(
+	st BeaconStateT,
+) (bool, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return false, err
+	}
+	return sp.cs.SlotToEpoch(slot) >= sp.cs.StakingFixForkEpoch(), nil
+}
+
+// depositsProven returns whether the deposit proof fork is active at the
+// slot of the given state.
+func (sp *StateProcessor[
+	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+]) depositsProven(
+	st BeaconStateT,
+) (bool, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return false, err
+	}
+	return sp.cs.SlotToEpoch(slot) >= sp.cs.DepositProofForkEpoch(), nil
+}
@@ -0,0 +1,441 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// testForkEpoch is the epoch of the staking fix and the deposit proof
+	// fork, with one slot per epoch.
+	testForkEpoch = 1
+	// gwei is the number of gwei in an ether.
+	gwei = 1e9
+)
+
+var errUnknownPubkey = errors.New("unknown pubkey")
+
+type (
+	testState = BeaconState[
+		*types.BeaconBlockHeader, *types.Eth1Data,
+		*types.ExecutionPayloadHeader, *types.Fork,
+		*types.Validator, *engineprimitives.Withdrawal,
+	]
+
+	testProcessor = StateProcessor[
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*types.BeaconBlockHeader,
+		*fakeState,
+		testSidecars,
+		*transition.Context,
+		*types.Deposit,
+		*types.Eth1Data,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.ForkData,
+		*types.Validator,
+		*engineprimitives.Withdrawal,
+		types.WithdrawalCredentials,
+	]
+)
+
+// fakeState is an in-memory beacon state holding the validators, balances
+// and withdrawals only. The methods it does not implement panic.
+type fakeState struct {
+	testState
+	slot                math.Slot
+	eth1Data            *types.Eth1Data
+	eth1DepositIndex    uint64
+	validators          []*types.Validator
+	balances            []math.Gwei
+	withdrawals         []*engineprimitives.Withdrawal
+	nextWithdrawalIndex uint64
+	nextValidatorIndex  math.ValidatorIndex
+}
+
+func (s *fakeState) GetSlot() (math.Slot, error) {
+	return s.slot, nil
+}
+
+func (s *fakeState) GetEth1Data() (*types.Eth1Data, error) {
+	return s.eth1Data, nil
+}
+
+func (s *fakeState) SetEth1Data(eth1Data *types.Eth1Data) error {
+	s.eth1Data = eth1Data
+	return nil
+}
+
+func (s *fakeState) GetEth1DepositIndex() (uint64, error) {
+	return s.eth1DepositIndex, nil
+}
+
+func (s *fakeState) SetEth1DepositIndex(index uint64) error {
+	s.eth1DepositIndex = index
+	return nil
+}
+
+func (s *fakeState) GetGenesisValidatorsRoot() (common.Root, error) {
+	return common.Root{}, nil
+}
+
+func (s *fakeState) ValidatorIndexByPubkey(
+	pubkey crypto.BLSPubkey,
+) (math.ValidatorIndex, error) {
+	for i, val := range s.validators {
+		if val.GetPubkey() == pubkey {
+			return math.ValidatorIndex(i), nil
+		}
+	}
+	return 0, errUnknownPubkey
+}
+
+func (s *fakeState) ValidatorByIndex(
+	idx math.ValidatorIndex,
+) (*types.Validator, error) {
+	val := *s.validators[idx]
+	return &val, nil
+}
+
+func (s *fakeState) UpdateValidatorAtIndex(
+	idx math.ValidatorIndex,
+	val *types.Validator,
+) error {
+	s.validators[idx] = val
+	return nil
+}
+
+// AddValidator adds the validator with an empty balance, as the store does.
+func (s *fakeState) AddValidator(val *types.Validator) error {
+	s.validators = append(s.validators, val)
+	s.balances = append(s.balances, 0)
+	return nil
+}
+
+func (s *fakeState) GetTotalValidators() (uint64, error) {
+	return uint64(len(s.validators)), nil
+}
+
+func (s *fakeState) IncreaseBalance(
+	idx math.ValidatorIndex,
+	delta math.Gwei,
+) error {
+	s.balances[idx] += delta
+	return nil
+}
+
+func (s *fakeState) DecreaseBalance(
+	idx math.ValidatorIndex,
+	delta math.Gwei,
+) error {
+	s.balances[idx] -= min(s.balances[idx], delta)
+	return nil
+}
+
+func (s *fakeState) ExpectedWithdrawals() (
+	[]*engineprimitives.Withdrawal, error,
+) {
+	return s.withdrawals, nil
+}
+
+func (s *fakeState) SetNextWithdrawalIndex(index uint64) error {
+	s.nextWithdrawalIndex = index
+	return nil
+}
+
+func (s *fakeState) GetNextWithdrawalValidatorIndex() (
+	math.ValidatorIndex, error,
+) {
+	return s.nextValidatorIndex, nil
+}
+
+func (s *fakeState) SetNextWithdrawalValidatorIndex(
+	idx math.ValidatorIndex,
+) error {
+	s.nextValidatorIndex = idx
+	return nil
+}
+
+// fakeDepositTree knows the deposit roots of the counts it holds.
+type fakeDepositTree map[uint64]common.Root
+
+func (t fakeDepositTree) DepositRoot(count uint64) (common.Root, bool) {
+	root, ok := t[count]
+	return root, ok
+}
+
+type testSidecars struct{}
+
+func (testSidecars) Len() int { return 0 }
+
+// newTestProcessor returns a state processor under a chain spec with one
+// slot per epoch, accepting every deposit signature.
+func newTestProcessor(t *testing.T) *testProcessor {
+	t.Helper()
+	signer := mocks.NewBLSSigner(t)
+	signer.EXPECT().
+		VerifySignature(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).
+		Maybe()
+	return NewStateProcessor[
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*types.BeaconBlockHeader,
+		*fakeState,
+		testSidecars,
+		*transition.Context,
+		*types.Deposit,
+		*types.Eth1Data,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.ForkData,
+		*types.Validator,
+		*engineprimitives.Withdrawal,
+		types.WithdrawalCredentials,
+	](
+		chain.NewChainSpec(chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{
+			SlotsPerEpoch:                    1,
+			DepositProofForkEpoch:            testForkEpoch,
+			StakingFixForkEpoch:              testForkEpoch,
+			ElectraForkEpoch:                 math.Epoch(^uint64(0)),
+			EffectiveBalanceIncrement:        gwei,
+			MaxEffectiveBalance:              32 * gwei,
+			MaxWithdrawalsPerPayload:         2,
+			MaxValidatorsPerWithdrawalsSweep: 4,
+		}),
+		nil, signer, fakeDepositTree{5: {5}}, nil, nil,
+	)
+}
+
+func TestApplyDeposit_NewValidator(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		slot    math.Slot
+		balance math.Gwei
+	}{
+		// The effective balance was credited on top of the deposit.
+		{"before fork", testForkEpoch - 1, 20 * gwei},
+		{"after fork", testForkEpoch, 10 * gwei},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			st := &fakeState{slot: tc.slot}
+			require.NoError(t, newTestProcessor(t).applyDeposit(
+				st, &types.Deposit{
+					Pubkey: crypto.BLSPubkey{1},
+					Amount: 10 * gwei,
+				},
+			))
+			require.Len(t, st.validators, 1)
+			require.Equal(
+				t, math.Gwei(10*gwei), st.validators[0].GetEffectiveBalance(),
+			)
+			require.Equal(t, []math.Gwei{tc.balance}, st.balances)
+		})
+	}
+}
+
+func TestApplyDeposit_TopUp(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		slot    math.Slot
+		balance math.Gwei
+	}{
+		// Top-ups only raised the effective balance.
+		{"before fork", testForkEpoch - 1, 10 * gwei},
+		{"after fork", testForkEpoch, 15 * gwei},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			st := &fakeState{
+				slot: tc.slot,
+				validators: []*types.Validator{{
+					Pubkey:           crypto.BLSPubkey{1},
+					EffectiveBalance: 10 * gwei,
+				}},
+				balances: []math.Gwei{10 * gwei},
+			}
+			require.NoError(t, newTestProcessor(t).applyDeposit(
+				st, &types.Deposit{
+					Pubkey: crypto.BLSPubkey{1},
+					Amount: 5 * gwei,
+				},
+			))
+			require.Equal(
+				t, math.Gwei(15*gwei), st.validators[0].GetEffectiveBalance(),
+			)
+			require.Equal(t, []math.Gwei{tc.balance}, st.balances)
+		})
+	}
+}
+
+func TestProcessWithdrawals_FullSweep(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		slot math.Slot
+		next math.ValidatorIndex
+	}{
+		// The sweep resumed after the withdrawal index of the last
+		// withdrawal, 6 modulo 4 validators.
+		{"before fork", testForkEpoch - 1, 3},
+		{"after fork", testForkEpoch, 2},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			withdrawals := []*engineprimitives.Withdrawal{
+				{Index: 5, Validator: 0, Amount: gwei},
+				{Index: 6, Validator: 1, Amount: gwei},
+			}
+			st := &fakeState{
+				slot:        tc.slot,
+				validators:  make([]*types.Validator, 4),
+				balances:    []math.Gwei{gwei, gwei, 0, 0},
+				withdrawals: withdrawals,
+			}
+			require.NoError(t, newTestProcessor(t).processWithdrawals(
+				st, &types.BeaconBlockBody{
+					RawBeaconBlockBody: &types.BeaconBlockBodyDeneb{
+						ExecutionPayload: &types.ExecutableDataDeneb{
+							Withdrawals: withdrawals,
+						},
+					},
+				},
+			))
+			require.Equal(t, []math.Gwei{0, 0, 0, 0}, st.balances)
+			require.Equal(t, uint64(7), st.nextWithdrawalIndex)
+			require.Equal(t, tc.next, st.nextValidatorIndex)
+		})
+	}
+}
+
+func TestProcessEth1Data(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		slot     math.Slot
+		proofs   bool
+		eth1Data *types.Eth1Data
+		err      error
+	}{
+		// Blocks voted for nothing before the fork.
+		{"before fork", testForkEpoch - 1, false, nil, nil},
+		{
+			"before fork with proofs", testForkEpoch - 1, true, nil,
+			ErrDepositProofsMismatch,
+		},
+		{
+			"after fork without proofs", testForkEpoch, false, nil,
+			ErrDepositProofsMismatch,
+		},
+		{
+			"known root", testForkEpoch, true,
+			&types.Eth1Data{DepositRoot: common.Root{5}, DepositCount: 5},
+			nil,
+		},
+		{
+			"wrong root", testForkEpoch, true,
+			&types.Eth1Data{DepositRoot: common.Root{6}, DepositCount: 5},
+			ErrDepositRootMismatch,
+		},
+		// A node whose deposits lag behind accepts the vote, the deposit
+		// proofs are checked against it.
+		{
+			"unknown root", testForkEpoch, true,
+			&types.Eth1Data{DepositRoot: common.Root{6}, DepositCount: 6},
+			nil,
+		},
+		{
+			"count below index", testForkEpoch, true,
+			&types.Eth1Data{DepositRoot: common.Root{2}, DepositCount: 2},
+			ErrDepositCountTooLow,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			eth1Data := tc.eth1Data
+			if eth1Data == nil {
+				eth1Data = &types.Eth1Data{}
+			}
+			body := &types.BeaconBlockBody{
+				RawBeaconBlockBody: &types.BeaconBlockBodyDeneb{
+					BeaconBlockBodyBase: types.BeaconBlockBodyBase{
+						Eth1Data: eth1Data,
+					},
+				},
+			}
+			body.SetDepositProofs(tc.proofs)
+			st := &fakeState{slot: tc.slot, eth1DepositIndex: 3}
+
+			err := newTestProcessor(t).processEth1Data(st, body, true)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.eth1Data, st.eth1Data)
+		})
+	}
+}
+
+func TestProcessDeposit(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		slot math.Slot
+		err  error
+	}{
+		// Deposits were applied unchecked before the fork.
+		{"before fork", testForkEpoch - 1, nil},
+		{"after fork", testForkEpoch, ErrDepositIndexMismatch},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			st := &fakeState{slot: tc.slot, eth1DepositIndex: 3}
+			err := newTestProcessor(t).processDeposit(
+				st, &types.Deposit{
+					Pubkey: crypto.BLSPubkey{1},
+					Amount: 10 * gwei,
+					Index:  7,
+				},
+			)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Empty(t, st.validators)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, uint64(4), st.eth1DepositIndex)
+			require.Len(t, st.validators, 1)
+		})
+	}
+}
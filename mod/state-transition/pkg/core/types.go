@@ -26,6 +26,7 @@ import (
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -51,9 +52,10 @@ type AvailabilityStore[BeaconBlockBodyT any, BlobSidecarsT any] interface {
 type BeaconBlock[
 	DepositT any,
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, DepositT,
+		BeaconBlockBodyT, DepositT, Eth1DataT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
+	Eth1DataT any,
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
@@ -78,6 +80,7 @@ type BeaconBlock[
 type BeaconBlockBody[
 	BeaconBlockBodyT any,
 	DepositT any,
+	Eth1DataT any,
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
 	],
@@ -92,6 +95,11 @@ type BeaconBlockBody[
 	GetExecutionPayload() ExecutionPayloadT
 	// GetDeposits returns the list of deposits.
 	GetDeposits() []DepositT
+	// GetEth1Data returns the eth1 data voted for by the block.
+	GetEth1Data() Eth1DataT
+	// HasDepositProofs returns whether the body carries the inclusion proofs
+	// of its deposits.
+	HasDepositProofs() bool
 	// HashTreeRoot returns the hash tree root of the block body.
 	HashTreeRoot() ([32]byte, error)
 	// GetBlobKzgCommitments returns the KZG commitments for the blobs.
@@ -130,10 +138,16 @@ type Deposit[
 	ForkDataT any,
 	WithdrawlCredentialsT ~[32]byte,
 ] interface {
+	// DataRoot returns the hash tree root of the deposit data, i.e. its
+	// leaf in the deposit tree.
+	DataRoot() (common.Root, error)
 	// GetAmount returns the amount of the deposit.
 	GetAmount() math.Gwei
 	// GetIndex returns the index of the deposit.
 	GetIndex() uint64
+	// GetProof returns the inclusion proof of the deposit in the deposit
+	// tree.
+	GetProof() [constants.DepositProofLength]common.Root
 	// GetPubkey returns the public key of the validator.
 	GetPubkey() crypto.BLSPubkey
 	// GetSignature returns the signature of the deposit.
@@ -151,6 +165,13 @@ type Deposit[
 	) error
 }
 
+// DepositTree is the local view of the deposit contract merkle tree.
+type DepositTree interface {
+	// DepositRoot returns the root of the deposit tree when it held the
+	// given number of deposits, and whether it is known.
+	DepositRoot(count uint64) (common.Root, bool)
+}
+
 type ExecutionPayload[
 	ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT any,
 ] interface {
@@ -225,6 +246,16 @@ type ForkData[ForkDataT any] interface {
 	) (common.Root, error)
 }
 
+// SlotClock maps slots to time.
+type SlotClock interface {
+	// ValidatePayloadTimestamp checks the timestamp of the execution payload
+	// of the given slot against the timestamp of its parent and the time of
+	// the slot.
+	ValidatePayloadTimestamp(
+		slot math.Slot, timestamp uint64, parentTimestamp uint64,
+	) error
+}
+
 // Validator represents an interface for a validator with generic type
 // ValidatorT.
 type Validator[
@@ -44,8 +44,9 @@ func (kv *KVStore[
 		return err
 	}
 
-	// Push onto the balances list.
-	return kv.balances.Set(kv.ctx, idx, uint64(val.GetEffectiveBalance()))
+	// Push onto the balances list. The balance starts empty and is credited
+	// by the deposit adding the validator.
+	return kv.balances.Set(kv.ctx, idx, 0)
 }
 
 // UpdateValidatorAtIndex updates a validator at a specific index.
@@ -22,19 +22,30 @@ package deposit
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"sync"
 
 	sdkcollections "cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4881"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
 
 // Deposit is a struct that holds the deposit information.
-var _ pruner.Prunable = (*KVStore[Deposit])(nil)
+var (
+	_ pruner.Prunable   = (*KVStore[Deposit])(nil)
+	_ pruner.Rewindable = (*KVStore[Deposit])(nil)
+)
 
-const KeyDepositPrefix = "deposit"
+const (
+	KeyDepositPrefix  = "deposit"
+	KeySnapshotPrefix = "deposit_snapshot"
+)
 
 type KVStoreProvider struct {
 	store.KVStoreWithBatch
@@ -46,16 +57,30 @@ func (p *KVStoreProvider) OpenKVStore(context.Context) store.KVStore {
 }
 
 // KVStore is a simple KV store based implementation that assumes
-// the deposit indexes are tracked outside of the kv store.
+// the deposit indexes are tracked outside of the kv store. Alongside the
+// deposits it maintains the EIP-4881 deposit tree, which is extended as
+// soon as the deposits are contiguous and persisted as a snapshot when
+// it is finalized.
 type KVStore[DepositT Deposit] struct {
-	store sdkcollections.Map[uint64, DepositT]
+	store    sdkcollections.Map[uint64, DepositT]
+	snapshot sdkcollections.Item[[]byte]
+	tree     *eip4881.DepositTree
+	// roots holds the deposit root for every deposit count since the
+	// last finalization.
+	roots map[uint64]common.Root
 	mu    sync.RWMutex
 }
 
-// NewStore creates a new deposit store.
-func NewStore[DepositT Deposit](kvsp store.KVStoreService) *KVStore[DepositT] {
+// NewStore creates a new deposit store, restoring the deposit tree from
+// the last persisted snapshot and the deposits stored after it. It fails
+// with ErrDepositTreeGap if those deposits do not directly follow the
+// snapshot, rather than building a tree that disagrees with the deposit
+// contract.
+func NewStore[DepositT Deposit](
+	kvsp store.KVStoreService,
+) (*KVStore[DepositT], error) {
 	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
-	return &KVStore[DepositT]{
+	kv := &KVStore[DepositT]{
 		store: sdkcollections.NewMap(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte{uint8(0)}),
@@ -63,7 +88,67 @@ func NewStore[DepositT Deposit](kvsp store.KVStoreService) *KVStore[DepositT] {
 			sdkcollections.Uint64Key,
 			encoding.SSZValueCodec[DepositT]{},
 		),
+		snapshot: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{uint8(1)}),
+			KeySnapshotPrefix,
+			sdkcollections.BytesValue,
+		),
+		tree:  eip4881.NewDepositTree(),
+		roots: make(map[uint64]common.Root),
+	}
+
+	bz, err := kv.snapshot.Get(context.TODO())
+	switch {
+	case errors.Is(err, sdkcollections.ErrNotFound):
+	case err != nil:
+		return nil, err
+	default:
+		snapshot := new(eip4881.Snapshot)
+		if err = json.Unmarshal(bz, snapshot); err != nil {
+			return nil, err
+		}
+		if kv.tree, err = eip4881.NewDepositTreeFromSnapshot(
+			snapshot,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	kv.roots[kv.tree.Count()] = kv.tree.Root()
+	if err = kv.extendTree(); err != nil {
+		return nil, err
 	}
+	if err = kv.checkContiguous(); err != nil {
+		return nil, err
+	}
+	return kv, nil
+}
+
+// checkContiguous checks that no deposit is stored past the last deposit
+// of the tree, which extendTree stops short of when a deposit in between
+// is missing.
+func (kv *KVStore[DepositT]) checkContiguous() error {
+	iter, err := kv.store.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).StartInclusive(kv.tree.Count()),
+	)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil
+	}
+	next, err := iter.Key()
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf(
+		"%w: tree holds %d deposits but the next stored deposit is %d, "+
+			"resync the deposit store from the deposit contract",
+		ErrDepositTreeGap, kv.tree.Count(), next,
+	)
 }
 
 // GetDepositsByIndex returns the first N deposits starting from the given
@@ -89,11 +174,55 @@ func (kv *KVStore[DepositT]) GetDepositsByIndex(
 	return deposits, nil
 }
 
+// GetDepositsWithProofs returns up to numView deposits of the deposit
+// tree starting from the given index, each carrying its inclusion proof.
+// It also returns the deposit root and count the proofs are built
+// against.
+func (kv *KVStore[DepositT]) GetDepositsWithProofs(
+	startIndex uint64,
+	numView uint64,
+) ([]DepositT, common.Root, uint64, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	var (
+		count    = kv.tree.Count()
+		root     = kv.tree.Root()
+		deposits = []DepositT{}
+	)
+	for i := startIndex; i < count && i < startIndex+numView; i++ {
+		deposit, err := kv.store.Get(context.TODO(), i)
+		if err != nil {
+			return nil, root, count, err
+		}
+		_, proof, err := kv.tree.Proof(i)
+		if err != nil {
+			return nil, root, count, err
+		}
+		deposit.SetProof(proof)
+		deposits = append(deposits, deposit)
+	}
+	return deposits, root, count, nil
+}
+
+// DepositRoot returns the root of the deposit tree when it held the given
+// number of deposits, and whether it is known. It is not known if the tree
+// does not hold that many deposits yet or if the count precedes the last
+// finalization.
+func (kv *KVStore[DepositT]) DepositRoot(count uint64) (common.Root, bool) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	root, ok := kv.roots[count]
+	return root, ok
+}
+
 // EnqueueDeposit pushes the deposit to the queue.
 func (kv *KVStore[DepositT]) EnqueueDeposit(deposit DepositT) error {
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
-	return kv.setDeposit(deposit)
+	if err := kv.setDeposit(deposit); err != nil {
+		return err
+	}
+	return kv.extendTree()
 }
 
 // EnqueueDeposits pushes multiple deposits to the queue.
@@ -105,7 +234,7 @@ func (kv *KVStore[DepositT]) EnqueueDeposits(deposits []DepositT) error {
 			return err
 		}
 	}
-	return nil
+	return kv.extendTree()
 }
 
 // setDeposit sets the deposit in the store.
@@ -113,6 +242,65 @@ func (kv *KVStore[DepositT]) setDeposit(deposit DepositT) error {
 	return kv.store.Set(context.TODO(), deposit.GetIndex(), deposit)
 }
 
+// extendTree pushes the stored deposits that directly follow the last
+// deposit of the tree, stopping at the first missing index.
+func (kv *KVStore[DepositT]) extendTree() error {
+	for {
+		deposit, err := kv.store.Get(context.TODO(), kv.tree.Count())
+		if errors.Is(err, sdkcollections.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		leaf, err := deposit.DataRoot()
+		if err != nil {
+			return err
+		}
+		if err = kv.tree.PushLeaf(leaf); err != nil {
+			return err
+		}
+		kv.roots[kv.tree.Count()] = kv.tree.Root()
+	}
+}
+
+// FinalizeDeposits finalizes the first count deposits of the deposit tree
+// at the given execution block and persists the resulting snapshot.
+func (kv *KVStore[DepositT]) FinalizeDeposits(
+	count uint64,
+	executionBlockHash common.ExecutionHash,
+	executionBlockHeight math.U64,
+) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if count == kv.tree.FinalizedCount() {
+		return nil
+	}
+	if err := kv.tree.Finalize(
+		count, executionBlockHash, executionBlockHeight,
+	); err != nil {
+		return err
+	}
+	for c := range kv.roots {
+		if c < count {
+			delete(kv.roots, c)
+		}
+	}
+
+	bz, err := json.Marshal(kv.tree.Snapshot())
+	if err != nil {
+		return err
+	}
+	return kv.snapshot.Set(context.TODO(), bz)
+}
+
+// Snapshot returns the EIP-4881 snapshot of the finalized deposit tree.
+func (kv *KVStore[DepositT]) Snapshot() *eip4881.Snapshot {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	return kv.tree.Snapshot()
+}
+
 // Prune removes the [start, end) deposits from the store.
 func (kv *KVStore[DepositT]) Prune(start, end uint64) error {
 	kv.mu.Lock()
@@ -125,3 +313,35 @@ func (kv *KVStore[DepositT]) Prune(start, end uint64) error {
 	}
 	return nil
 }
+
+// CanRewind returns pruner.ErrPruned if the deposits following the first
+// count ones, which a chain rewound to count deposits includes again, are
+// no longer stored or can no longer be proven because the deposit tree was
+// finalized past them.
+func (kv *KVStore[DepositT]) CanRewind(count uint64) error {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	if finalized := kv.tree.FinalizedCount(); count < finalized {
+		return fmt.Errorf(
+			"%w: deposit tree finalized at %d deposits, rewinding to %d",
+			pruner.ErrPruned, finalized, count,
+		)
+	}
+	for i := count; i < kv.tree.Count(); i++ {
+		found, err := kv.store.Has(context.TODO(), i)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("%w: deposit %d", pruner.ErrPruned, i)
+		}
+	}
+	return nil
+}
+
+// Rewind checks that the store can be rewound to the given deposit count.
+// Deposits are execution layer data the rewound chain includes again, so
+// they are kept along with the deposit tree.
+func (kv *KVStore[DepositT]) Rewind(count uint64) error {
+	return kv.CanRewind(count)
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4881"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/stretchr/testify/require"
+)
+
+// testDeposit is a deposit holding nothing but its index.
+type testDeposit struct {
+	Index uint64
+}
+
+func (d *testDeposit) MarshalSSZTo(buf []byte) ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(buf, d.Index), nil
+}
+
+func (d *testDeposit) MarshalSSZ() ([]byte, error) {
+	return d.MarshalSSZTo(nil)
+}
+
+func (d *testDeposit) UnmarshalSSZ(buf []byte) error {
+	d.Index = binary.LittleEndian.Uint64(buf)
+	return nil
+}
+
+func (d *testDeposit) SizeSSZ() int {
+	return 8
+}
+
+func (d *testDeposit) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	binary.LittleEndian.PutUint64(root[:], d.Index)
+	return root, nil
+}
+
+func (d *testDeposit) GetIndex() uint64 {
+	return d.Index
+}
+
+func (d *testDeposit) DataRoot() (common.Root, error) {
+	bz, _ := d.MarshalSSZ()
+	return sha256.Sum256(bz), nil
+}
+
+func (d *testDeposit) SetProof([constants.DepositProofLength]common.Root) {}
+
+// newDeposits returns the deposits with indexes in [start, end).
+func newDeposits(start, end uint64) []*testDeposit {
+	deposits := make([]*testDeposit, 0, end-start)
+	for i := start; i < end; i++ {
+		deposits = append(deposits, &testDeposit{Index: i})
+	}
+	return deposits
+}
+
+// expectedRoot returns the root of a deposit tree holding the first count
+// deposits.
+func expectedRoot(t *testing.T, count uint64) common.Root {
+	t.Helper()
+	tree := eip4881.NewDepositTree()
+	for _, d := range newDeposits(0, count) {
+		leaf, err := d.DataRoot()
+		require.NoError(t, err)
+		require.NoError(t, tree.PushLeaf(leaf))
+	}
+	return tree.Root()
+}
+
+// open opens a deposit store on the given database.
+func open(
+	db *storev2.MemDB,
+) (*deposit.KVStore[*testDeposit], error) {
+	return deposit.NewStore[*testDeposit](
+		&deposit.KVStoreProvider{KVStoreWithBatch: db},
+	)
+}
+
+func TestNewStore_RestoresSnapshot(t *testing.T) {
+	db := storev2.NewMemDB()
+	kv, err := open(db)
+	require.NoError(t, err)
+	require.NoError(t, kv.EnqueueDeposits(newDeposits(0, 6)))
+	require.NoError(t, kv.FinalizeDeposits(4, common.ExecutionHash{}, 10))
+	require.NoError(t, kv.Prune(0, 4))
+
+	kv, err = open(db)
+	require.NoError(t, err)
+	root, ok := kv.DepositRoot(6)
+	require.True(t, ok)
+	require.Equal(t, expectedRoot(t, 6), root)
+}
+
+// TestNewStore_UpgradeWithoutSnapshot opens stores written before the
+// deposit tree was persisted, which hold deposits but no snapshot.
+func TestNewStore_UpgradeWithoutSnapshot(t *testing.T) {
+	t.Run("all deposits stored", func(t *testing.T) {
+		db := storev2.NewMemDB()
+		kv, err := open(db)
+		require.NoError(t, err)
+		require.NoError(t, kv.EnqueueDeposits(newDeposits(0, 5)))
+
+		kv, err = open(db)
+		require.NoError(t, err)
+		root, ok := kv.DepositRoot(5)
+		require.True(t, ok)
+		require.Equal(t, expectedRoot(t, 5), root)
+	})
+
+	t.Run("deposits pruned", func(t *testing.T) {
+		db := storev2.NewMemDB()
+		kv, err := open(db)
+		require.NoError(t, err)
+		require.NoError(t, kv.EnqueueDeposits(newDeposits(0, 5)))
+		require.NoError(t, kv.Prune(0, 3))
+
+		_, err = open(db)
+		require.ErrorIs(t, err, deposit.ErrDepositTreeGap)
+	})
+}
@@ -21,8 +21,10 @@
 package filedb
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -32,17 +34,34 @@ import (
 // DB represents a filesystem backed key-value store.
 // It is useful for storing amounts of data that exceed what is
 // performant to store in a traditional key-value database.
+//
+// Values are written to a temporary file renamed over the final one, so
+// that a crash never leaves a partially written value behind, and prefixed
+// with a checksum header verified on every read. Values failing
+// verification are moved to the quarantine directory.
 type DB struct {
-	fs        afero.Fs
-	logger    log.Logger[any]
-	rootDir   string
-	extension string
-	dirPerms  os.FileMode
+	fs             afero.Fs
+	logger         log.Logger[any]
+	rootDir        string
+	extension      string
+	dirPerms       os.FileMode
+	syncPolicy     SyncPolicy
+	readOnly       bool
+	validateLegacy func([]byte) error
+	metrics        *dbMetrics
+
+	// mu orders the writes of values, which hold it for reading, with the
+	// removals, quarantines and migrations of values, which hold it for
+	// writing, so that a value being removed is never written back.
+	mu sync.RWMutex
 }
 
 // NewDB creates a new instance of the DB.
 func NewDB(opts ...Option) *DB {
-	db := &DB{}
+	db := &DB{
+		syncPolicy: SyncFile,
+		metrics:    newDBMetrics(nil),
+	}
 	for _, opt := range opts {
 		if err := opt(db); err != nil {
 			panic(errors.Wrap(err, "failed to apply option"))
@@ -50,55 +69,165 @@ func NewDB(opts ...Option) *DB {
 	}
 
 	db.fs = afero.NewBasePathFs(afero.NewOsFs(), db.rootDir)
+	if db.readOnly {
+		db.fs = afero.NewReadOnlyFs(db.fs)
+	}
 	return db
 }
 
-// Get retrieves the value for a key.
+// Get retrieves the value for a key. A value failing verification is
+// quarantined and ErrCorruptedValue returned.
 func (db *DB) Get(key []byte) ([]byte, error) {
-	return afero.ReadFile(db.fs, db.pathForKey(key))
+	path := db.pathForKey(key)
+	bz, err := afero.ReadFile(db.fs, path)
+	if err != nil {
+		return nil, err
+	}
+	value, _, err := db.decode(bz)
+	if err != nil {
+		db.quarantine(path, bz, err)
+		return nil, errors.Wrapf(err, "key %s", key)
+	}
+	return value, nil
 }
 
-// Has returns true if the key exists in the database.
+// Has returns true if the key exists in the database. The value is not
+// read, use Get to verify it.
 func (db *DB) Has(key []byte) (bool, error) {
-	exists, err := afero.Exists(db.fs, db.pathForKey(key))
-	if err != nil {
-		return false, err
-	}
-	return exists, nil
+	return afero.Exists(db.fs, db.pathForKey(key))
 }
 
 // Set stores the value for a key.
 func (db *DB) Set(key []byte, value []byte) error {
-	if exists, err := afero.Exists(db.fs, db.pathForKey(key)); err != nil {
+	path := db.pathForKey(key)
+	if exists, err := afero.Exists(db.fs, path); err != nil {
 		return err
 	} else if exists {
 		db.logger.Warn("Overriding existing key", "key", key)
 	}
 
-	if err := db.fs.MkdirAll(
-		filepath.Dir(db.pathForKey(key)), db.dirPerms,
-	); err != nil {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	if err := db.fs.MkdirAll(filepath.Dir(path), db.dirPerms); err != nil {
+		return err
+	}
+	if err := db.writeFile(path, encodeValue(value)); err != nil {
 		return err
 	}
+	db.logger.Debug("wrote value", "bytes", len(value), "path", path)
+	return nil
+}
 
-	file, err := db.fs.Create(db.pathForKey(key))
+// Delete removes the value for a key.
+func (db *DB) Delete(key []byte) error {
+	return db.removeAll(db.pathForKey(key))
+}
+
+// removeAll removes the given path and everything it contains.
+func (db *DB) removeAll(path string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.fs.RemoveAll(path)
+}
+
+// decode decodes the given encoded value, validating it if it was written
+// before values carried a header.
+func (db *DB) decode(bz []byte) ([]byte, bool, error) {
+	value, legacy, err := decodeValue(bz)
+	if err != nil || !legacy || db.validateLegacy == nil {
+		return value, legacy, err
+	}
+	if err = db.validateLegacy(value); err != nil {
+		return nil, false, errors.Wrapf(
+			ErrCorruptedValue, "invalid legacy value: %v", err,
+		)
+	}
+	return value, true, nil
+}
+
+// writeFile writes the given data to a temporary file next to the given
+// path and renames it over the path, syncing as the sync policy requires.
+func (db *DB) writeFile(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	file, err := afero.TempFile(
+		db.fs, dir, filepath.Base(path)+tempFileInfix+"*",
+	)
 	if err != nil {
 		return errors.Wrap(err, "failed to create file")
 	}
-	defer file.Close()
+	tmpPath := filepath.Join(dir, filepath.Base(file.Name()))
+	defer func() {
+		// The temporary file only remains if the rename did not happen.
+		_ = db.fs.Remove(tmpPath)
+	}()
 
-	n, err := file.Write(value)
-	if err != nil {
+	if _, err = file.Write(data); err != nil {
+		_ = file.Close()
 		return errors.Wrap(err, "failed to write to file")
 	}
-	db.logger.Debug("wrote %d bytes to %s", n, db.pathForKey(key))
+	if db.syncPolicy >= SyncFile {
+		if err = file.Sync(); err != nil {
+			_ = file.Close()
+			return errors.Wrap(err, "failed to sync file")
+		}
+	}
+	if err = file.Close(); err != nil {
+		return errors.Wrap(err, "failed to close file")
+	}
+	if err = db.fs.Rename(tmpPath, path); err != nil {
+		return errors.Wrap(err, "failed to rename file")
+	}
+	if db.syncPolicy >= SyncFull {
+		return db.syncDir(dir)
+	}
+	return nil
+}
 
+// syncDir syncs the given directory, making the renames in it durable.
+func (db *DB) syncDir(dir string) error {
+	d, err := db.fs.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	if err = d.Sync(); err != nil {
+		return errors.Wrap(err, "failed to sync directory")
+	}
 	return nil
 }
 
-// Delete removes the value for a key.
-func (db *DB) Delete(key []byte) error {
-	return db.fs.RemoveAll(db.pathForKey(key))
+// quarantine moves the file at the given path, whose content bz failed
+// verification with the given error, to the quarantine directory unless the
+// database is read-only. The file is left in place if it was rewritten or
+// removed since it was read.
+func (db *DB) quarantine(path string, bz []byte, cause error) {
+	db.metrics.markCorrupted()
+	if db.readOnly {
+		return
+	}
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if current, err := afero.ReadFile(db.fs, path); err != nil ||
+		!bytes.Equal(current, bz) {
+		return
+	}
+	target := filepath.Join(quarantineDir, path)
+	err := db.fs.MkdirAll(filepath.Dir(target), db.dirPerms)
+	if err == nil {
+		_ = db.fs.Remove(target)
+		err = db.fs.Rename(path, target)
+	}
+	if err != nil {
+		db.logger.Error(
+			"Failed to quarantine corrupted value",
+			"path", path, "cause", cause, "error", err,
+		)
+		return
+	}
+	db.metrics.markQuarantined()
+	db.logger.Warn(
+		"Quarantined corrupted value", "path", path, "cause", cause,
+	)
 }
 
 // pathForKey returns the path for a key.
@@ -23,12 +23,51 @@ package filedb
 import (
 	"os"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/spf13/afero"
 )
 
 type Option func(*DB) error
 
+// SyncPolicy is the policy of the DB for syncing written values to disk.
+type SyncPolicy uint8
+
+const (
+	// SyncNone leaves syncing to the operating system. Values are still
+	// written atomically, but the last ones may be lost on a crash.
+	SyncNone SyncPolicy = iota
+	// SyncFile syncs every value before it is renamed into place.
+	SyncFile
+	// SyncFull also syncs the directory of every value after it is renamed
+	// into place, so that the value is durable once Set returns.
+	SyncFull
+)
+
+// String returns the name of the sync policy.
+func (p SyncPolicy) String() string {
+	switch p {
+	case SyncNone:
+		return "none"
+	case SyncFile:
+		return "file"
+	case SyncFull:
+		return "full"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseSyncPolicy returns the sync policy of the given name.
+func ParseSyncPolicy(name string) (SyncPolicy, error) {
+	for _, p := range []SyncPolicy{SyncNone, SyncFile, SyncFull} {
+		if p.String() == name {
+			return p, nil
+		}
+	}
+	return 0, errors.Wrapf(ErrInvalidSyncPolicy, "%q", name)
+}
+
 // WithAferoFS sets the filesystem for the database.
 // NOTE: Should only be used for testing.
 func WithAferoFS(fs afero.Fs) Option {
@@ -54,6 +93,16 @@ func WithFileExtension(extension string) Option {
 	}
 }
 
+// WithLegacyValidator sets the function values written before values
+// carried a header are validated with. Legacy values failing it are
+// corrupted, and legacy values are only migrated once they pass it.
+func WithLegacyValidator(validate func([]byte) error) Option {
+	return func(db *DB) error {
+		db.validateLegacy = validate
+		return nil
+	}
+}
+
 // WithLogger sets the logger for the database.
 func WithLogger(logger log.Logger[any]) Option {
 	return func(db *DB) error {
@@ -62,6 +111,32 @@ func WithLogger(logger log.Logger[any]) Option {
 	}
 }
 
+// WithSyncPolicy sets the policy for syncing written values to disk.
+func WithSyncPolicy(policy SyncPolicy) Option {
+	return func(db *DB) error {
+		db.syncPolicy = policy
+		return nil
+	}
+}
+
+// WithTelemetrySink sets the sink the metrics of the database are reported
+// to.
+func WithTelemetrySink(sink TelemetrySink) Option {
+	return func(db *DB) error {
+		db.metrics = newDBMetrics(sink)
+		return nil
+	}
+}
+
+// WithReadOnly opens the database read-only: writes fail and corrupted
+// values are left in place.
+func WithReadOnly() Option {
+	return func(db *DB) error {
+		db.readOnly = true
+		return nil
+	}
+}
+
 // WithRootDirectory sets the root directory for the database.
 func WithRootDirectory(rootDir string) Option {
 	return func(db *DB) error {
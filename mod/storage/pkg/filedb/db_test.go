@@ -21,7 +21,11 @@
 package filedb_test
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/errors"
@@ -293,3 +297,243 @@ func TestDB_Set_MkDirError(t *testing.T) {
 		}
 	})
 }
+
+// counterSink counts the increments of every counter.
+type counterSink map[string]int
+
+func (s counterSink) IncrementCounter(key string, _ ...string) {
+	s[key]++
+}
+
+func (counterSink) SetGauge(string, int64, ...string) {}
+
+func TestDB_Corruption(t *testing.T) {
+	root := t.TempDir()
+	sink := counterSink{}
+	db := file.NewDB(
+		file.WithRootDirectory(root),
+		file.WithFileExtension("txt"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+		file.WithSyncPolicy(file.SyncFull),
+		file.WithTelemetrySink(sink),
+	)
+	require.NoError(t, db.Set([]byte("a/truncated"), []byte("value")))
+	require.NoError(t, db.Set([]byte("a/flipped"), []byte("value")))
+	require.NoError(t, db.Set([]byte("a/intact"), []byte("value")))
+
+	// No temporary file is left behind.
+	entries, err := os.ReadDir(filepath.Join(root, "a"))
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	path := filepath.Join(root, "a", "truncated.txt")
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bz[:len(bz)-1], 0600))
+
+	path = filepath.Join(root, "a", "flipped.txt")
+	bz, err = os.ReadFile(path)
+	require.NoError(t, err)
+	bz[len(bz)-1] ^= 0xff
+	require.NoError(t, os.WriteFile(path, bz, 0600))
+
+	// Has does not read the value, a truncated value is only reported
+	// when read and quarantined then.
+	exists, err := db.Has([]byte("a/truncated"))
+	require.NoError(t, err)
+	require.True(t, exists)
+	_, err = db.Get([]byte("a/truncated"))
+	require.ErrorIs(t, err, file.ErrCorruptedValue)
+	exists, err = db.Has([]byte("a/truncated"))
+	require.NoError(t, err)
+	require.False(t, exists)
+	require.FileExists(
+		t, filepath.Join(root, ".quarantine", "a", "truncated.txt"),
+	)
+	require.NoFileExists(t, filepath.Join(root, "a", "truncated.txt"))
+
+	// A value failing its checksum is not returned.
+	_, err = db.Get([]byte("a/flipped"))
+	require.ErrorIs(t, err, file.ErrCorruptedValue)
+	require.FileExists(
+		t, filepath.Join(root, ".quarantine", "a", "flipped.txt"),
+	)
+	require.Equal(t, 2, sink["beacon_kit.storage.filedb.corrupted"])
+	require.Equal(t, 2, sink["beacon_kit.storage.filedb.quarantined"])
+
+	value, err := db.Get([]byte("a/intact"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+}
+
+func TestDB_ReadOnly(t *testing.T) {
+	root := t.TempDir()
+	newDB := func(opts ...file.Option) *file.DB {
+		return file.NewDB(append([]file.Option{
+			file.WithRootDirectory(root),
+			file.WithFileExtension("txt"),
+			file.WithDirectoryPermissions(0700),
+			file.WithLogger(log.NewNopLogger()),
+		}, opts...)...)
+	}
+	require.NoError(t, newDB().Set([]byte("a/intact"), []byte("value")))
+	require.NoError(t, newDB().Set([]byte("a/flipped"), []byte("value")))
+
+	path := filepath.Join(root, "a", "flipped.txt")
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	bz[len(bz)-1] ^= 0xff
+	require.NoError(t, os.WriteFile(path, bz, 0600))
+
+	db := newDB(file.WithReadOnly())
+	value, err := db.Get([]byte("a/intact"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	require.Error(t, db.Set([]byte("a/new"), []byte("value")))
+	require.Error(t, db.Delete([]byte("a/intact")))
+
+	// A corrupted value is left in place.
+	_, err = db.Get([]byte("a/flipped"))
+	require.ErrorIs(t, err, file.ErrCorruptedValue)
+	require.FileExists(t, path)
+}
+
+// validateLegacy accepts the legacy values spelling "value".
+func validateLegacy(value []byte) error {
+	if string(value) != "value" {
+		return errors.New("invalid value")
+	}
+	return nil
+}
+
+func TestDB_Scrub(t *testing.T) {
+	root := t.TempDir()
+	db := file.NewDB(
+		file.WithRootDirectory(root),
+		file.WithFileExtension("txt"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+		file.WithLegacyValidator(validateLegacy),
+	)
+	require.NoError(t, db.Set([]byte("1/intact"), []byte("value")))
+	require.NoError(t, db.Set([]byte("2/corrupted"), []byte("value")))
+	path := filepath.Join(root, "2", "corrupted.txt")
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bz[:len(bz)-1], 0600))
+
+	// A value written before values carried a header is rewritten with one.
+	legacy := filepath.Join(root, "3", "legacy.txt")
+	require.NoError(t, os.MkdirAll(filepath.Dir(legacy), 0700))
+	require.NoError(t, os.WriteFile(legacy, []byte("value"), 0600))
+
+	// Legacy values failing validation, or too short to be told apart from
+	// a value cut short by a crash, are corrupted rather than migrated.
+	for name, bz := range map[string][]byte{
+		"invalid.txt": []byte("other"),
+		"empty.txt":   {},
+		"short.txt":   []byte("BK"),
+	} {
+		require.NoError(t, os.WriteFile(
+			filepath.Join(root, "3", name), bz, 0600,
+		))
+	}
+
+	// A temporary file is only removed once it is old enough not to be in
+	// use.
+	stale := filepath.Join(root, "1", "stale.txt.tmp-123")
+	fresh := filepath.Join(root, "1", "fresh.txt.tmp-456")
+	require.NoError(t, os.WriteFile(stale, []byte("val"), 0600))
+	require.NoError(t, os.WriteFile(fresh, []byte("val"), 0600))
+	old := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(stale, old, old))
+
+	report, err := db.Scrub(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, &file.ScrubReport{
+		Checked:          6,
+		Corrupted:        4,
+		Migrated:         1,
+		RemovedTempFiles: 1,
+	}, report)
+	require.NoFileExists(t, stale)
+	require.FileExists(t, fresh)
+	for _, path := range []string{
+		"2/corrupted.txt", "3/invalid.txt", "3/empty.txt", "3/short.txt",
+	} {
+		require.FileExists(t, filepath.Join(root, ".quarantine", path))
+	}
+
+	bz, err = os.ReadFile(legacy)
+	require.NoError(t, err)
+	require.Greater(t, len(bz), len("value"))
+	value, err := db.Get([]byte("3/legacy"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	// Quarantined values are not scrubbed again.
+	report, err = db.Scrub(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, &file.ScrubReport{Checked: 2}, report)
+}
+
+func TestDB_LegacyValues(t *testing.T) {
+	root := t.TempDir()
+	db := file.NewDB(
+		file.WithRootDirectory(root),
+		file.WithFileExtension("txt"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+	)
+
+	// Values written before values carried a header are read as is.
+	path := filepath.Join(root, "a", "legacy.txt")
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	require.NoError(t, os.WriteFile(path, []byte("value"), 0600))
+
+	exists, err := db.Has([]byte("a/legacy"))
+	require.NoError(t, err)
+	require.True(t, exists)
+	value, err := db.Get([]byte("a/legacy"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	require.FileExists(t, path)
+}
+
+func TestDB_ScrubDoesNotRestorePrunedValues(t *testing.T) {
+	root := t.TempDir()
+	var db *file.DB
+	db = file.NewDB(
+		file.WithRootDirectory(root),
+		file.WithFileExtension("txt"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+		// The value is pruned right after it is read by the scrub.
+		file.WithLegacyValidator(func(value []byte) error {
+			require.NoError(t, file.NewRangeDB(db).Prune(0, 2))
+			return validateLegacy(value)
+		}),
+	)
+	legacy := filepath.Join(root, "1", "legacy.txt")
+	require.NoError(t, os.MkdirAll(filepath.Dir(legacy), 0700))
+	require.NoError(t, os.WriteFile(legacy, []byte("value"), 0600))
+
+	report, err := db.Scrub(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, &file.ScrubReport{Checked: 1}, report)
+	require.NoDirExists(t, filepath.Dir(legacy))
+}
+
+func TestParseSyncPolicy(t *testing.T) {
+	for _, policy := range []file.SyncPolicy{
+		file.SyncNone, file.SyncFile, file.SyncFull,
+	} {
+		parsed, err := file.ParseSyncPolicy(policy.String())
+		require.NoError(t, err)
+		require.Equal(t, policy, parsed)
+	}
+	_, err := file.ParseSyncPolicy("always")
+	require.ErrorIs(t, err, file.ErrInvalidSyncPolicy)
+	require.NoError(t, file.DefaultConfig().Validate())
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+const (
+	// headerSize is the size of the header prefixing every value: the magic
+	// bytes, the CRC-32C checksum of the value and its length.
+	headerSize = 4 + 4 + 8
+	// tempFileInfix separates the name of a file from the random suffix of
+	// the temporary files it is written to.
+	tempFileInfix = ".tmp-"
+	// quarantineDir is the directory corrupted values are moved to,
+	// relative to the root directory.
+	quarantineDir = ".quarantine"
+)
+
+var (
+	// magic identifies the files written by the DB.
+	//
+	//nolint:gochecknoglobals // constant byte slice.
+	magic = []byte("BKFD")
+	// crcTable is the table of the CRC-32C checksums.
+	//
+	//nolint:gochecknoglobals // table computed once.
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// encodeValue prefixes the given value with its header.
+func encodeValue(value []byte) []byte {
+	bz := make([]byte, 0, headerSize+len(value))
+	bz = append(bz, magic...)
+	bz = binary.BigEndian.AppendUint32(bz, crc32.Checksum(value, crcTable))
+	bz = binary.BigEndian.AppendUint64(bz, uint64(len(value)))
+	return append(bz, value...)
+}
+
+// decodeValue verifies the header of the given encoded value and returns
+// the value, or ErrCorruptedValue. Files written before values carried a
+// header do not start with the magic bytes: they are returned as is, with
+// legacy set, unless they are too short to hold the magic bytes, as empty
+// and truncated files are left by crashes in the middle of a write.
+//
+//nolint:mnd // offsets of the header.
+func decodeValue(bz []byte) ([]byte, bool, error) {
+	if len(bz) < len(magic) {
+		return nil, false, errors.Wrapf(
+			ErrCorruptedValue, "truncated value of %d bytes", len(bz),
+		)
+	}
+	if !bytes.HasPrefix(bz, magic) {
+		return bz, true, nil
+	}
+	if len(bz) < headerSize {
+		return nil, false, errors.Wrap(ErrCorruptedValue, "truncated header")
+	}
+	value := bz[headerSize:]
+	length := binary.BigEndian.Uint64(bz[8:16])
+	if length != uint64(len(value)) {
+		return nil, false, errors.Wrapf(
+			ErrCorruptedValue, "expected %d bytes, got %d", length, len(value),
+		)
+	}
+	if binary.BigEndian.Uint32(bz[4:8]) != crc32.Checksum(value, crcTable) {
+		return nil, false, errors.Wrap(ErrCorruptedValue, "checksum mismatch")
+	}
+	return value, false, nil
+}
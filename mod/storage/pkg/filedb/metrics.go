@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments the counter identified by
+	// the provided key.
+	IncrementCounter(key string, args ...string)
+	// SetGauge sets the gauge identified by the provided key to the given
+	// value.
+	SetGauge(key string, value int64, args ...string)
+}
+
+// dbMetrics is a struct that contains metrics for the DB.
+type dbMetrics struct {
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+}
+
+// newDBMetrics creates a new dbMetrics reporting to the given sink, or
+// nowhere if nil.
+func newDBMetrics(sink TelemetrySink) *dbMetrics {
+	return &dbMetrics{sink: sink}
+}
+
+// markCorrupted increments the number of values failing verification.
+func (m *dbMetrics) markCorrupted() {
+	if m.sink == nil {
+		return
+	}
+	m.sink.IncrementCounter("beacon_kit.storage.filedb.corrupted")
+}
+
+// markQuarantined increments the number of values quarantined.
+func (m *dbMetrics) markQuarantined() {
+	if m.sink == nil {
+		return
+	}
+	m.sink.IncrementCounter("beacon_kit.storage.filedb.quarantined")
+}
+
+// reportScrub reports the outcome of a scrub.
+func (m *dbMetrics) reportScrub(report *ScrubReport) {
+	if m.sink == nil {
+		return
+	}
+	m.sink.IncrementCounter("beacon_kit.storage.filedb.scrub")
+	m.sink.SetGauge(
+		"beacon_kit.storage.filedb.scrub_checked", int64(report.Checked),
+	)
+	m.sink.SetGauge(
+		"beacon_kit.storage.filedb.scrub_corrupted", int64(report.Corrupted),
+	)
+	m.sink.SetGauge(
+		"beacon_kit.storage.filedb.scrub_migrated", int64(report.Migrated),
+	)
+}
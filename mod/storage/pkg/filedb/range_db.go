@@ -23,12 +23,16 @@ package filedb
 import (
 	"bytes"
 	"fmt"
+	"io/fs"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/hex"
 	db "github.com/berachain/beacon-kit/mod/storage/pkg/interfaces"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/spf13/afero"
 )
 
 // two is a constant for the number 2.
@@ -94,13 +98,70 @@ func (db *RangeDB) DeleteRange(from, to uint64) error {
 		return errors.New("rangedb: delete range not supported for this db")
 	}
 	for ; from < to; from++ {
-		if err := f.fs.RemoveAll(fmt.Sprintf("%d/", from)); err != nil {
+		if err := f.removeAll(fmt.Sprintf("%d/", from)); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// Keys returns the keys stored at the given index, in lexical order of
+// their hex encoding.
+func (db *RangeDB) Keys(index uint64) ([][]byte, error) {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return nil, errors.New("rangedb: keys not supported for this db")
+	}
+	infos, err := afero.ReadDir(f.fs, strconv.FormatUint(index, 10))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	keys := make([][]byte, 0, len(infos))
+	for _, info := range infos {
+		name, found := strings.CutSuffix(info.Name(), "."+f.extension)
+		if info.IsDir() || !found {
+			continue
+		}
+		key, err := hex.String(name).ToBytes()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Indexes returns the indexes holding values, in ascending order.
+func (db *RangeDB) Indexes() ([]uint64, error) {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return nil, errors.New("rangedb: indexes not supported for this db")
+	}
+	infos, err := afero.ReadDir(f.fs, ".")
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	indexes := make([]uint64, 0, len(infos))
+	for _, info := range infos {
+		if !info.IsDir() {
+			continue
+		}
+		index, err := strconv.ParseUint(info.Name(), 10, 64)
+		if err != nil {
+			continue
+		}
+		indexes = append(indexes, index)
+	}
+	slices.Sort(indexes)
+	return indexes, nil
+}
+
 // Prune removes all values in the given range [start, end) from the db.
 func (db *RangeDB) Prune(start, end uint64) error {
 	start = max(start, db.firstNonNilIndex)
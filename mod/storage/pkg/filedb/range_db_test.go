@@ -128,6 +128,29 @@ func TestRangeDB(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "Keys",
+			setupFunc: func(rdb *file.RangeDB) error {
+				for _, key := range []string{"b", "a"} {
+					if err := rdb.Set(
+						7, []byte(key), []byte("testValue"),
+					); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			testFunc: func(t *testing.T, rdb *file.RangeDB) {
+				t.Helper()
+				keys, err := rdb.Keys(7)
+				require.NoError(t, err)
+				require.Equal(t, [][]byte{[]byte("a"), []byte("b")}, keys)
+
+				keys, err = rdb.Keys(8)
+				require.NoError(t, err)
+				require.Empty(t, keys)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -277,6 +300,22 @@ func TestRangeDB_Prune(t *testing.T) {
 	}
 }
 
+func TestRangeDB_Indexes(t *testing.T) {
+	rdb := file.NewRangeDB(newTestFDB(t.TempDir()))
+
+	indexes, err := rdb.Indexes()
+	require.NoError(t, err)
+	require.Empty(t, indexes)
+
+	require.NoError(t, populateTestDB(rdb, 8, 12))
+	require.NoError(t, populateTestDB(rdb, 0, 3))
+	require.NoError(t, rdb.DeleteRange(1, 2))
+
+	indexes, err = rdb.Indexes()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 2, 3, 8, 9, 10, 11, 12}, indexes)
+}
+
 // =========================== INVARIANTS ================================.
 
 // invariant: all indexes up to the firstNonNilIndex should be nil.
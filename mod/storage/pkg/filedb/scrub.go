@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/spf13/afero"
+)
+
+// staleTempFileAge is the age past which a temporary file is assumed to be
+// left over from an interrupted write rather than being written.
+const staleTempFileAge = time.Minute
+
+// ScrubReport is the outcome of a scrub of the DB.
+type ScrubReport struct {
+	// Checked is the number of values verified.
+	Checked int
+	// Corrupted is the number of values failing verification, which were
+	// quarantined.
+	Corrupted int
+	// Migrated is the number of values written before values carried a
+	// header, which were validated and rewritten with one.
+	Migrated int
+	// RemovedTempFiles is the number of temporary files left over from
+	// interrupted writes that were removed.
+	RemovedTempFiles int
+}
+
+// Scrub verifies every value of the DB, quarantining those that fail
+// verification, and removes the temporary files left over from interrupted
+// writes. Values written before values carried a header are rewritten with
+// one if they pass the legacy validator of the DB, unless the DB is
+// read-only. Values removed while the scrub runs are not written back.
+func (db *DB) Scrub(ctx context.Context) (*ScrubReport, error) {
+	report := new(ScrubReport)
+	err := afero.Walk(db.fs, ".", func(
+		path string, info os.FileInfo, err error,
+	) error {
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			return nil
+		case err != nil:
+			return err
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case info.IsDir() && path == quarantineDir:
+			return filepath.SkipDir
+		case info.IsDir():
+			return nil
+		case strings.Contains(info.Name(), tempFileInfix):
+			if time.Since(info.ModTime()) < staleTempFileAge {
+				return nil
+			}
+			if err = db.fs.Remove(path); err != nil {
+				return err
+			}
+			report.RemovedTempFiles++
+			return nil
+		case !strings.HasSuffix(info.Name(), "."+db.extension):
+			return nil
+		}
+
+		bz, err := afero.ReadFile(db.fs, path)
+		if err != nil {
+			return err
+		}
+		report.Checked++
+		value, legacy, err := db.decode(bz)
+		switch {
+		case err != nil:
+			report.Corrupted++
+			db.quarantine(path, bz, err)
+		case legacy && !db.readOnly && db.validateLegacy != nil:
+			var migrated bool
+			if migrated, err = db.migrate(path, bz, value); err != nil {
+				return err
+			} else if migrated {
+				report.Migrated++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	db.metrics.reportScrub(report)
+	return report, nil
+}
+
+// migrate rewrites the file at the given path, whose content bz holds the
+// given legacy value, with a header. The file is left alone if it was
+// rewritten or removed, say by the pruner, since it was read.
+func (db *DB) migrate(path string, bz, value []byte) (bool, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	current, err := afero.ReadFile(db.fs, path)
+	if errors.Is(err, fs.ErrNotExist) || !bytes.Equal(current, bz) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, db.writeFile(path, encodeValue(value))
+}
+
+// ScrubService is a service scrubbing a set of DBs once on start.
+type ScrubService struct {
+	// logger is used to report the outcome of the scrubs.
+	logger log.Logger[any]
+	// dbs are the DBs to scrub.
+	dbs []*DB
+}
+
+// NewScrubService creates a new service scrubbing the given DBs.
+func NewScrubService(logger log.Logger[any], dbs ...*DB) *ScrubService {
+	return &ScrubService{
+		logger: logger,
+		dbs:    dbs,
+	}
+}
+
+// Name returns the name of the service.
+func (*ScrubService) Name() string {
+	return "filedb-scrub"
+}
+
+// Start scrubs the DBs in the background.
+func (s *ScrubService) Start(ctx context.Context) error {
+	go func() {
+		for _, db := range s.dbs {
+			start := time.Now()
+			report, err := db.Scrub(ctx)
+			if err != nil {
+				s.logger.Error(
+					"Failed to scrub database",
+					"root", db.rootDir, "error", err,
+				)
+				continue
+			}
+			s.logger.Info(
+				"Scrubbed database 🧽",
+				"root", db.rootDir,
+				"checked", report.Checked,
+				"corrupted", report.Corrupted,
+				"migrated", report.Migrated,
+				"removed_temp_files", report.RemovedTempFiles,
+				"duration", time.Since(start),
+			)
+		}
+	}()
+	return nil
+}
@@ -28,6 +28,7 @@ package manager
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
@@ -36,6 +37,7 @@ import (
 type DBManager[
 	BeaconBlockT BeaconBlock,
 	BlockEventT BlockEvent[BeaconBlockT],
+	BeaconStateT BeaconState,
 	SubscriptionT Subscription,
 ] struct {
 	pruners []pruner.Pruner[pruner.Prunable]
@@ -45,13 +47,16 @@ type DBManager[
 func NewDBManager[
 	BeaconBlockT BeaconBlock,
 	BlockEventT BlockEvent[BeaconBlockT],
+	BeaconStateT BeaconState,
 	SubscriptionT Subscription,
 ](
 	logger log.Logger[any],
 	pruners ...pruner.Pruner[pruner.Prunable],
-) (*DBManager[BeaconBlockT, BlockEventT, SubscriptionT], error) {
+) (*DBManager[
+	BeaconBlockT, BlockEventT, BeaconStateT, SubscriptionT,
+], error) {
 	return &DBManager[
-		BeaconBlockT, BlockEventT, SubscriptionT,
+		BeaconBlockT, BlockEventT, BeaconStateT, SubscriptionT,
 	]{
 		logger:  logger,
 		pruners: pruners,
@@ -60,17 +65,47 @@ func NewDBManager[
 
 // Name returns the name of the Basic Service.
 func (m *DBManager[
-	BeaconBlockT, BlockEventT, SubscriptionT,
+	BeaconBlockT, BlockEventT, BeaconStateT, SubscriptionT,
 ]) Name() string {
 	return "db-manager"
 }
 
 // Start starts all pruners.
 func (m *DBManager[
-	BeaconBlockT, BlockEventT, SubscriptionT,
+	BeaconBlockT, BlockEventT, BeaconStateT, SubscriptionT,
 ]) Start(ctx context.Context) error {
 	for _, pruner := range m.pruners {
 		pruner.Start(ctx)
 	}
 	return nil
 }
+
+// CanRewind returns an error if any of the stores cannot be rewound to the
+// given beacon state.
+func (m *DBManager[
+	BeaconBlockT, BlockEventT, BeaconStateT, SubscriptionT,
+]) CanRewind(st BeaconStateT) error {
+	for _, pruner := range m.pruners {
+		if err := pruner.CanRewind(st); err != nil {
+			return errors.Wrapf(err, "cannot rewind %s", pruner.Name())
+		}
+	}
+	return nil
+}
+
+// Rewind rewinds all stores to the given beacon state. No store is rewound
+// unless all of them can be.
+func (m *DBManager[
+	BeaconBlockT, BlockEventT, BeaconStateT, SubscriptionT,
+]) Rewind(st BeaconStateT) error {
+	if err := m.CanRewind(st); err != nil {
+		return err
+	}
+	for _, pruner := range m.pruners {
+		if err := pruner.Rewind(st); err != nil {
+			return errors.Wrapf(err, "failed to rewind %s", pruner.Name())
+		}
+		m.logger.Info("Rewound store", "store", pruner.Name())
+	}
+	return nil
+}
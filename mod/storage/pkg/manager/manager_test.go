@@ -27,6 +27,7 @@ package manager_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -35,6 +36,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager/mocks"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	prunerMocks "github.com/berachain/beacon-kit/mod/storage/pkg/pruner/mocks"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -61,17 +63,18 @@ func TestDBManager_Start(t *testing.T) {
 		manager.BlockEvent[manager.BeaconBlock],
 		*interfaceMocks.Prunable,
 		manager.Subscription,
-	](logger, mockPrunable, "pruner1", &feed, pruneParamsFn)
+	](logger, mockPrunable, "pruner1", &feed, pruneParamsFn, nil)
 	p2 := pruner.NewPruner[
 		manager.BeaconBlock,
 		manager.BlockEvent[manager.BeaconBlock],
 		*interfaceMocks.Prunable,
 		manager.Subscription,
-	](logger, mockPrunable, "pruner2", &feed, pruneParamsFn)
+	](logger, mockPrunable, "pruner2", &feed, pruneParamsFn, nil)
 
 	m, err := manager.NewDBManager[
 		manager.BeaconBlock,
 		manager.BlockEvent[manager.BeaconBlock],
+		manager.BeaconState,
 		manager.Subscription,
 	](logger, p1, p2)
 	require.NoError(t, err)
@@ -86,3 +89,54 @@ func TestDBManager_Start(t *testing.T) {
 	feed.AssertNumberOfCalls(t, "Subscribe", 2)
 	mockPrunable.AssertNotCalled(t, "PruneFromInclusive")
 }
+
+func TestDBManager_Rewind(t *testing.T) {
+	st := new(prunerMocks.BeaconState)
+	newManager := func(
+		pruners ...pruner.Pruner[pruner.Prunable],
+	) *manager.DBManager[
+		manager.BeaconBlock,
+		manager.BlockEvent[manager.BeaconBlock],
+		manager.BeaconState,
+		manager.Subscription,
+	] {
+		m, err := manager.NewDBManager[
+			manager.BeaconBlock,
+			manager.BlockEvent[manager.BeaconBlock],
+			manager.BeaconState,
+			manager.Subscription,
+		](log.NewNopLogger(), pruners...)
+		require.NoError(t, err)
+		return m
+	}
+
+	t.Run("AllStoresRewound", func(t *testing.T) {
+		p1 := prunerMocks.NewPruner[pruner.Prunable](t)
+		p1.EXPECT().CanRewind(st).Return(nil).Twice()
+		p1.EXPECT().Rewind(st).Return(nil).Once()
+		p1.EXPECT().Name().Return("pruner1").Maybe()
+		p2 := prunerMocks.NewPruner[pruner.Prunable](t)
+		p2.EXPECT().CanRewind(st).Return(nil).Twice()
+		p2.EXPECT().Rewind(st).Return(nil).Once()
+		p2.EXPECT().Name().Return("pruner2").Maybe()
+
+		m := newManager(p1, p2)
+		require.NoError(t, m.CanRewind(st))
+		require.NoError(t, m.Rewind(st))
+	})
+
+	t.Run("NoStoreRewoundIfOneCannotBe", func(t *testing.T) {
+		errPruned := errors.New("pruned")
+		p1 := prunerMocks.NewPruner[pruner.Prunable](t)
+		p1.EXPECT().CanRewind(st).Return(nil).Once()
+		p2 := prunerMocks.NewPruner[pruner.Prunable](t)
+		p2.EXPECT().CanRewind(st).Return(errPruned).Once()
+		p2.EXPECT().Name().Return("pruner2").Once()
+
+		err := newManager(p1, p2).Rewind(st)
+		require.ErrorIs(t, err, errPruned)
+		require.ErrorContains(t, err, "cannot rewind pruner2")
+		p1.AssertNotCalled(t, "Rewind", mock.Anything)
+		p2.AssertNotCalled(t, "Rewind", mock.Anything)
+	})
+}
@@ -35,6 +35,12 @@ type BeaconBlock interface {
 	GetSlot() math.U64
 }
 
+// BeaconState is an interface for the beacon state stores are rewound to.
+type BeaconState interface {
+	GetSlot() (math.Slot, error)
+	GetEth1DepositIndex() (uint64, error)
+}
+
 // BlockEvent is an interface for block events.
 type BlockEvent[BeaconBlockT BeaconBlock] interface {
 	Is(asynctypes.EventID) bool
@@ -32,8 +32,23 @@ type Prunable interface {
 	Prune(start, end uint64) error
 }
 
+// Rewindable is a store that can be rewound to an earlier index, dropping
+// what was stored after it.
+type Rewindable interface {
+	// CanRewind returns ErrPruned if data needed once rewound to the given
+	// index has already been pruned.
+	CanRewind(index uint64) error
+	// Rewind removes the data stored after the given index.
+	Rewind(index uint64) error
+}
+
 // Pruner is an interface for pruning the store.
 type Pruner[PrunableT Prunable] interface {
 	Name() string
 	Start(ctx context.Context)
+	// CanRewind returns an error if the store cannot be rewound to the
+	// given beacon state.
+	CanRewind(st BeaconState) error
+	// Rewind rewinds the store to the given beacon state.
+	Rewind(st BeaconState) error
 }
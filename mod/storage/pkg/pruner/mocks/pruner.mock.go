@@ -22,6 +22,52 @@ func (_m *Pruner[PrunableT]) EXPECT() *Pruner_Expecter[PrunableT] {
 	return &Pruner_Expecter[PrunableT]{mock: &_m.Mock}
 }
 
+// CanRewind provides a mock function with given fields: st
+func (_m *Pruner[PrunableT]) CanRewind(st pruner.BeaconState) error {
+	ret := _m.Called(st)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CanRewind")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(pruner.BeaconState) error); ok {
+		r0 = rf(st)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Pruner_CanRewind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CanRewind'
+type Pruner_CanRewind_Call[PrunableT pruner.Prunable] struct {
+	*mock.Call
+}
+
+// CanRewind is a helper method to define mock.On call
+//   - st pruner.BeaconState
+func (_e *Pruner_Expecter[PrunableT]) CanRewind(st interface{}) *Pruner_CanRewind_Call[PrunableT] {
+	return &Pruner_CanRewind_Call[PrunableT]{Call: _e.mock.On("CanRewind", st)}
+}
+
+func (_c *Pruner_CanRewind_Call[PrunableT]) Run(run func(st pruner.BeaconState)) *Pruner_CanRewind_Call[PrunableT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(pruner.BeaconState))
+	})
+	return _c
+}
+
+func (_c *Pruner_CanRewind_Call[PrunableT]) Return(_a0 error) *Pruner_CanRewind_Call[PrunableT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Pruner_CanRewind_Call[PrunableT]) RunAndReturn(run func(pruner.BeaconState) error) *Pruner_CanRewind_Call[PrunableT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Name provides a mock function with given fields:
 func (_m *Pruner[PrunableT]) Name() string {
 	ret := _m.Called()
@@ -67,6 +113,52 @@ func (_c *Pruner_Name_Call[PrunableT]) RunAndReturn(run func() string) *Pruner_N
 	return _c
 }
 
+// Rewind provides a mock function with given fields: st
+func (_m *Pruner[PrunableT]) Rewind(st pruner.BeaconState) error {
+	ret := _m.Called(st)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rewind")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(pruner.BeaconState) error); ok {
+		r0 = rf(st)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Pruner_Rewind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rewind'
+type Pruner_Rewind_Call[PrunableT pruner.Prunable] struct {
+	*mock.Call
+}
+
+// Rewind is a helper method to define mock.On call
+//   - st pruner.BeaconState
+func (_e *Pruner_Expecter[PrunableT]) Rewind(st interface{}) *Pruner_Rewind_Call[PrunableT] {
+	return &Pruner_Rewind_Call[PrunableT]{Call: _e.mock.On("Rewind", st)}
+}
+
+func (_c *Pruner_Rewind_Call[PrunableT]) Run(run func(st pruner.BeaconState)) *Pruner_Rewind_Call[PrunableT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(pruner.BeaconState))
+	})
+	return _c
+}
+
+func (_c *Pruner_Rewind_Call[PrunableT]) Return(_a0 error) *Pruner_Rewind_Call[PrunableT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Pruner_Rewind_Call[PrunableT]) RunAndReturn(run func(pruner.BeaconState) error) *Pruner_Rewind_Call[PrunableT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Pruner[PrunableT]) Start(ctx context.Context) {
 	_m.Called(ctx)
@@ -29,6 +29,7 @@ package pruner
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 )
@@ -46,6 +47,8 @@ type DBPruner[
 	name         string
 	feed         BlockFeed[BeaconBlockT, BlockEventT, SubscriptionT]
 	pruneRangeFn func(BlockEventT) (uint64, uint64)
+	// rewindIndexFn returns the index of the store matching a beacon state.
+	rewindIndexFn func(BeaconState) (uint64, error)
 }
 
 func NewPruner[
@@ -59,13 +62,15 @@ func NewPruner[
 	name string,
 	feed BlockFeed[BeaconBlockT, BlockEventT, SubscriptionT],
 	pruneRangeFn func(BlockEventT) (uint64, uint64),
+	rewindIndexFn func(BeaconState) (uint64, error),
 ) *DBPruner[BeaconBlockT, BlockEventT, PrunableT, SubscriptionT] {
 	return &DBPruner[BeaconBlockT, BlockEventT, PrunableT, SubscriptionT]{
-		logger:       logger,
-		prunable:     prunable,
-		name:         name,
-		feed:         feed,
-		pruneRangeFn: pruneRangeFn,
+		logger:        logger,
+		prunable:      prunable,
+		name:          name,
+		feed:          feed,
+		pruneRangeFn:  pruneRangeFn,
+		rewindIndexFn: rewindIndexFn,
 	}
 }
 
@@ -102,3 +107,42 @@ func (p *DBPruner[
 ]) Name() string {
 	return p.name
 }
+
+// CanRewind returns an error if the store cannot be rewound to the given
+// beacon state.
+func (p *DBPruner[
+	BeaconBlockT, BlockEventT, PrunableT, SubscriptionT,
+]) CanRewind(st BeaconState) error {
+	rewindable, index, err := p.rewindTarget(st)
+	if err != nil {
+		return err
+	}
+	return rewindable.CanRewind(index)
+}
+
+// Rewind rewinds the store to the given beacon state.
+func (p *DBPruner[
+	BeaconBlockT, BlockEventT, PrunableT, SubscriptionT,
+]) Rewind(st BeaconState) error {
+	rewindable, index, err := p.rewindTarget(st)
+	if err != nil {
+		return err
+	}
+	return rewindable.Rewind(index)
+}
+
+// rewindTarget returns the store as a Rewindable along with its index
+// matching the given beacon state.
+func (p *DBPruner[
+	BeaconBlockT, BlockEventT, PrunableT, SubscriptionT,
+]) rewindTarget(st BeaconState) (Rewindable, uint64, error) {
+	rewindable, ok := p.prunable.(Rewindable)
+	if !ok || p.rewindIndexFn == nil {
+		return nil, 0, errors.Wrap(ErrNotRewindable, p.name)
+	}
+	index, err := p.rewindIndexFn(st)
+	if err != nil {
+		return nil, 0, err
+	}
+	return rewindable, index, nil
+}
@@ -102,7 +102,7 @@ func TestPruner(t *testing.T) {
 				pruner.BlockEvent[pruner.BeaconBlock],
 				pruner.Prunable,
 				pruner.Subscription,
-			](logger, mockPrunable, "TestPruner", &feed, pruneRangeFn)
+			](logger, mockPrunable, "TestPruner", &feed, pruneRangeFn, nil)
 
 			ctx, cancel := context.WithCancel(context.Background())
 			// need to ensure goroutine is stopped
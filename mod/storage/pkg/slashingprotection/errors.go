@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashingprotection
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrDoubleProposal is returned when signing a block at a slot a
+	// different block was already signed at.
+	ErrDoubleProposal = errors.New("block already signed at slot")
+
+	// ErrSlotBelowWatermark is returned when signing a block at a slot
+	// below the lowest slot a block was signed at, which may have been
+	// pruned from the history.
+	ErrSlotBelowWatermark = errors.New(
+		"slot below the lowest signed block slot",
+	)
+
+	// ErrGenesisValidatorsRootMismatch is returned when the genesis
+	// validators root of a signing request or interchange does not match
+	// the one of the store.
+	ErrGenesisValidatorsRootMismatch = errors.New(
+		"genesis validators root mismatch",
+	)
+
+	// ErrUnsupportedInterchangeVersion is returned when importing an
+	// interchange of an unsupported format version.
+	ErrUnsupportedInterchangeVersion = errors.New(
+		"unsupported interchange format version",
+	)
+)
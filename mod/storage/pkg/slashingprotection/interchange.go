@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashingprotection
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+// InterchangeFormatVersion is the version of the EIP-3076 interchange
+// format that is imported and exported.
+const InterchangeFormatVersion = "5"
+
+// Interchange is the slashing protection history of a set of validators, in
+// the interchange format of EIP-3076.
+// https://eips.ethereum.org/EIPS/eip-3076
+type Interchange struct {
+	// Metadata identifies the format and the chain of the history.
+	Metadata InterchangeMetadata `json:"metadata"`
+	// Data is the history of each validator.
+	Data []*ValidatorHistory `json:"data"`
+}
+
+// InterchangeMetadata identifies the format and the chain of an
+// interchange.
+type InterchangeMetadata struct {
+	// InterchangeFormatVersion is the version of the interchange format.
+	InterchangeFormatVersion string `json:"interchange_format_version"`
+	// GenesisValidatorsRoot is the genesis validators root of the chain.
+	GenesisValidatorsRoot common.Root `json:"genesis_validators_root"`
+}
+
+// ValidatorHistory is the slashing protection history of a validator.
+type ValidatorHistory struct {
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+	// SignedBlocks are the blocks signed by the validator.
+	SignedBlocks []*SignedBlock `json:"signed_blocks"`
+	// SignedAttestations are the attestations signed by the validator.
+	// Validators do not sign attestations on chains finalized by CometBFT,
+	// so they are ignored on import and never exported.
+	SignedAttestations []*SignedAttestation `json:"signed_attestations"`
+}
+
+// SignedBlock is a block signed by a validator.
+type SignedBlock struct {
+	// Slot is the slot of the block.
+	Slot uint64 `json:"slot,string"`
+	// SigningRoot is the signing root of the block. It is nil if unknown,
+	// in which case no block may be signed at the slot.
+	SigningRoot *common.Root `json:"signing_root,omitempty"`
+}
+
+// SignedAttestation is an attestation signed by a validator.
+type SignedAttestation struct {
+	// SourceEpoch is the source epoch of the attestation.
+	SourceEpoch uint64 `json:"source_epoch,string"`
+	// TargetEpoch is the target epoch of the attestation.
+	TargetEpoch uint64 `json:"target_epoch,string"`
+	// SigningRoot is the signing root of the attestation.
+	SigningRoot *common.Root `json:"signing_root,omitempty"`
+}
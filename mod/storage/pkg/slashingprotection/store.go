@@ -0,0 +1,324 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashingprotection
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	KeySignedBlocksPrefix          = "signed_blocks"
+	KeyGenesisValidatorsRootPrefix = "genesis_validators_root"
+)
+
+// unknownSigningRoot is recorded in place of the signing root of a block
+// whose signing root is unknown. Empty values cannot be told apart from
+// missing ones by every database.
+//
+//nolint:gochecknoglobals // constant.
+var unknownSigningRoot = []byte{0x00}
+
+// kvStoreProvider serves the database of the store as a KV store service.
+type kvStoreProvider struct {
+	store.KVStoreWithBatch
+}
+
+// OpenKVStore opens a new KV store.
+func (p *kvStoreProvider) OpenKVStore(context.Context) store.KVStore {
+	return syncedKVStore{p.KVStoreWithBatch}
+}
+
+// syncedKVStore is a KV store whose writes are flushed to disk before they
+// return, so that a crash right after signing cannot lose the record of the
+// signed block.
+type syncedKVStore struct {
+	store.KVStoreWithBatch
+}
+
+// Set sets the value of the key and flushes it to disk.
+func (s syncedKVStore) Set(key, value []byte) error {
+	return s.writeSync(func(batch store.Batch) error {
+		return batch.Set(key, value)
+	})
+}
+
+// Delete deletes the key and flushes the deletion to disk.
+func (s syncedKVStore) Delete(key []byte) error {
+	return s.writeSync(func(batch store.Batch) error {
+		return batch.Delete(key)
+	})
+}
+
+// writeSync applies the given write to a batch and writes it synchronously.
+func (s syncedKVStore) writeSync(write func(store.Batch) error) error {
+	batch := s.NewBatch()
+	if err := write(batch); err != nil {
+		return errors.Join(err, batch.Close())
+	}
+	return errors.Join(batch.WriteSync(), batch.Close())
+}
+
+// Store is the slashing protection database of the validators the node
+// signs for. It records the signing root of every block signed by each
+// validator and refuses to let a validator sign two different blocks at
+// the same slot, so that a failover or a restore from backup cannot lead
+// to a double proposal.
+type Store struct {
+	db store.KVStoreWithBatch
+	// blocks maps the pubkey and slot of each signed block to its signing
+	// root. The signing root is unknownSigningRoot if unknown, which
+	// happens when importing a history that omits it.
+	blocks sdkcollections.Map[
+		sdkcollections.Pair[[]byte, uint64], []byte,
+	]
+	// genesisValidatorsRoot is the root of the chain the history belongs
+	// to, set by the first signing request or import that carries it.
+	genesisValidatorsRoot sdkcollections.Item[[]byte]
+	mu                    sync.Mutex
+}
+
+// NewStore creates a new slashing protection store backed by the given
+// database.
+func NewStore(db store.KVStoreWithBatch) *Store {
+	schemaBuilder := sdkcollections.NewSchemaBuilder(
+		&kvStoreProvider{KVStoreWithBatch: db},
+	)
+	return &Store{
+		db: db,
+		blocks: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{uint8(0)}),
+			KeySignedBlocksPrefix,
+			sdkcollections.PairKeyCodec(
+				sdkcollections.BytesKey, sdkcollections.Uint64Key,
+			),
+			sdkcollections.BytesValue,
+		),
+		genesisValidatorsRoot: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{uint8(1)}),
+			KeyGenesisValidatorsRootPrefix,
+			sdkcollections.BytesValue,
+		),
+	}
+}
+
+// Close closes the database of the store.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// CheckGenesisValidatorsRoot checks that the given genesis validators root
+// is the one of the history, recording it if the history has none yet.
+func (s *Store) CheckGenesisValidatorsRoot(root common.Root) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.checkGenesisValidatorsRoot(context.TODO(), root)
+}
+
+// CheckAndRecordBlock checks that the validator of the given pubkey may
+// sign the block of the given signing root at the given slot and records
+// it if so. Signing the same block twice is allowed, signing a different
+// block at a slot already signed at or below the lowest slot signed at is
+// not, as required by EIP-3076. The record is flushed to disk before it
+// returns.
+func (s *Store) CheckAndRecordBlock(
+	pubkey crypto.BLSPubkey,
+	slot math.Slot,
+	signingRoot common.Root,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ctx := context.TODO()
+	key := sdkcollections.Join(pubkey[:], slot.Unwrap())
+
+	recorded, err := s.blocks.Get(ctx, key)
+	switch {
+	case err == nil:
+		if bytes.Equal(recorded, signingRoot[:]) {
+			return nil
+		}
+		return errors.Wrapf(ErrDoubleProposal, "%s at slot %d", pubkey, slot)
+	case !errors.Is(err, sdkcollections.ErrNotFound):
+		return err
+	}
+
+	lowest, found, err := s.lowestSlot(ctx, pubkey)
+	if err != nil {
+		return err
+	} else if found && slot.Unwrap() < lowest {
+		return errors.Wrapf(
+			ErrSlotBelowWatermark, "%s at slot %d, lowest %d",
+			pubkey, slot, lowest,
+		)
+	}
+	return s.blocks.Set(ctx, key, signingRoot[:])
+}
+
+// Import merges the given interchange into the history. A block that
+// conflicts with the recorded one at the same slot is recorded with an
+// unknown signing root, so that no block may be signed at that slot.
+func (s *Store) Import(interchange *Interchange) error {
+	if v := interchange.Metadata.InterchangeFormatVersion; v !=
+		InterchangeFormatVersion {
+		return errors.Wrapf(ErrUnsupportedInterchangeVersion, "%q", v)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ctx := context.TODO()
+	if err := s.checkGenesisValidatorsRoot(
+		ctx, interchange.Metadata.GenesisValidatorsRoot,
+	); err != nil {
+		return err
+	}
+
+	for _, validator := range interchange.Data {
+		for _, block := range validator.SignedBlocks {
+			key := sdkcollections.Join(validator.Pubkey[:], block.Slot)
+			root := unknownSigningRoot
+			if block.SigningRoot != nil {
+				root = block.SigningRoot[:]
+			}
+
+			recorded, err := s.blocks.Get(ctx, key)
+			switch {
+			case errors.Is(err, sdkcollections.ErrNotFound):
+			case err != nil:
+				return err
+			case bytes.Equal(recorded, root):
+				continue
+			default:
+				root = unknownSigningRoot
+			}
+			if err = s.blocks.Set(ctx, key, root); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Export returns the whole history in the interchange format.
+func (s *Store) Export() (*Interchange, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ctx := context.TODO()
+
+	interchange := &Interchange{
+		Metadata: InterchangeMetadata{
+			InterchangeFormatVersion: InterchangeFormatVersion,
+		},
+		Data: []*ValidatorHistory{},
+	}
+	gvr, err := s.genesisValidatorsRoot.Get(ctx)
+	switch {
+	case errors.Is(err, sdkcollections.ErrNotFound):
+	case err != nil:
+		return nil, err
+	default:
+		interchange.Metadata.GenesisValidatorsRoot = common.Root(gvr)
+	}
+
+	iter, err := s.blocks.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var history *ValidatorHistory
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		pubkey := crypto.BLSPubkey(kv.Key.K1())
+		if history == nil || history.Pubkey != pubkey {
+			history = &ValidatorHistory{
+				Pubkey:             pubkey,
+				SignedBlocks:       []*SignedBlock{},
+				SignedAttestations: []*SignedAttestation{},
+			}
+			interchange.Data = append(interchange.Data, history)
+		}
+
+		block := &SignedBlock{Slot: kv.Key.K2()}
+		if !bytes.Equal(kv.Value, unknownSigningRoot) {
+			root := common.Root(kv.Value)
+			block.SigningRoot = &root
+		}
+		history.SignedBlocks = append(history.SignedBlocks, block)
+	}
+	return interchange, nil
+}
+
+// checkGenesisValidatorsRoot checks the given root against the recorded
+// one, recording it if there is none.
+func (s *Store) checkGenesisValidatorsRoot(
+	ctx context.Context,
+	root common.Root,
+) error {
+	recorded, err := s.genesisValidatorsRoot.Get(ctx)
+	switch {
+	case errors.Is(err, sdkcollections.ErrNotFound):
+		return s.genesisValidatorsRoot.Set(ctx, root[:])
+	case err != nil:
+		return err
+	case !bytes.Equal(recorded, root[:]):
+		return errors.Wrapf(
+			ErrGenesisValidatorsRootMismatch, "got %s, recorded %s",
+			root, common.Root(recorded),
+		)
+	}
+	return nil
+}
+
+// lowestSlot returns the lowest slot the validator of the given pubkey
+// signed a block at, if any.
+func (s *Store) lowestSlot(
+	ctx context.Context,
+	pubkey crypto.BLSPubkey,
+) (uint64, bool, error) {
+	iter, err := s.blocks.Iterate(
+		ctx, sdkcollections.NewPrefixedPairRange[[]byte, uint64](pubkey[:]),
+	)
+	if err != nil {
+		return 0, false, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, false, nil
+	}
+	key, err := iter.Key()
+	if err != nil {
+		return 0, false, err
+	}
+	return key.K2(), true, nil
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+import (
+	"encoding/json"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// Engine API error codes, see
+// https://github.com/ethereum/execution-apis/blob/main/src/engine/common.md
+const (
+	codeInternalError          = -32603
+	codeUnknownPayload         = -38001
+	codeInvalidForkchoiceState = -38002
+	codeInvalidAttributes      = -38003
+)
+
+// clientVersion identifies the mock execution client.
+//
+//nolint:gochecknoglobals // constant.
+var clientVersion = engineprimitives.ClientVersionV1{
+	Code:    "MK",
+	Name:    "mockengine",
+	Version: "v0.0.0",
+	Commit:  "0x00000000",
+}
+
+// rpcError is an error carrying a JSON-RPC error code.
+type rpcError struct {
+	code int
+	err  error
+}
+
+// Error returns the error message.
+func (e *rpcError) Error() string {
+	return e.err.Error()
+}
+
+// ErrorCode returns the JSON-RPC error code.
+func (e *rpcError) ErrorCode() int {
+	return e.code
+}
+
+// toRPCError attaches the Engine API error code matching err.
+func toRPCError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, engineerrors.ErrUnknownPayload):
+		return &rpcError{code: codeUnknownPayload, err: err}
+	case errors.Is(err, engineerrors.ErrInvalidForkchoiceState):
+		return &rpcError{code: codeInvalidForkchoiceState, err: err}
+	case errors.Is(err, engineerrors.ErrInvalidPayloadAttributes):
+		return &rpcError{code: codeInvalidAttributes, err: err}
+	default:
+		return &rpcError{code: codeInternalError, err: err}
+	}
+}
+
+// engineAPI serves the engine namespace.
+type engineAPI struct {
+	chain *Chain
+}
+
+// NewPayloadV3 implements engine_newPayloadV3.
+func (api *engineAPI) NewPayloadV3(
+	raw json.RawMessage,
+	versionedHashes []common.ExecutionHash,
+	parentBeaconBlockRoot *common.ExecutionHash,
+) (*engineprimitives.PayloadStatusV1, error) {
+	payload := (&types.ExecutionPayload{}).Empty(version.Deneb)
+	if err := json.Unmarshal(raw, payload); err != nil {
+		return nil, err
+	}
+	status, err := api.chain.NewPayload(
+		payload, versionedHashes, (*common.Root)(parentBeaconBlockRoot),
+	)
+	return status, toRPCError(err)
+}
+
+// ForkchoiceUpdatedV3 implements engine_forkchoiceUpdatedV3.
+func (api *engineAPI) ForkchoiceUpdatedV3(
+	state *engineprimitives.ForkchoiceStateV1,
+	attrs *PayloadAttributes,
+) (*engineprimitives.ForkchoiceResponseV1, error) {
+	if state == nil {
+		return nil, toRPCError(engineerrors.ErrInvalidForkchoiceState)
+	}
+	resp, err := api.chain.ForkchoiceUpdated(state, attrs)
+	return resp, toRPCError(err)
+}
+
+// GetPayloadV3 implements engine_getPayloadV3.
+func (api *engineAPI) GetPayloadV3(
+	id engineprimitives.PayloadID,
+) (*Envelope, error) {
+	envelope, err := api.chain.GetPayload(id)
+	return envelope, toRPCError(err)
+}
+
+// ExchangeCapabilities implements engine_exchangeCapabilities. It
+// reports the requested capabilities it supports.
+func (api *engineAPI) ExchangeCapabilities(requested []string) []string {
+	supported := make(map[string]struct{})
+	for _, capability := range ethclient.BeaconKitSupportedCapabilities() {
+		supported[capability] = struct{}{}
+	}
+	capabilities := make([]string, 0, len(requested))
+	for _, capability := range requested {
+		if _, ok := supported[capability]; ok {
+			capabilities = append(capabilities, capability)
+		}
+	}
+	return capabilities
+}
+
+// GetClientVersionV1 implements engine_getClientVersionV1.
+func (api *engineAPI) GetClientVersionV1(
+	*engineprimitives.ClientVersionV1,
+) []engineprimitives.ClientVersionV1 {
+	return []engineprimitives.ClientVersionV1{clientVersion}
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+)
+
+const (
+	// blobTxGas is the execution gas used by every generated blob
+	// transaction.
+	blobTxGas = 21000
+	// blobTxFeeCap is the fee cap used by every generated blob
+	// transaction.
+	blobTxFeeCap = 1_000_000_000
+	// fieldElementSize is the size of a blob field element.
+	fieldElementSize = 32
+)
+
+// BlobTxFactory deterministically generates blob transactions with valid
+// KZG commitments and proofs.
+type BlobTxFactory struct {
+	kzg     *gokzg4844.Context
+	chainID *uint256.Int
+
+	mu    sync.Mutex
+	nonce uint64
+}
+
+// NewBlobTxFactory creates a new blob transaction factory from the given
+// trusted setup.
+func NewBlobTxFactory(
+	ts *gokzg4844.JSONTrustedSetup,
+	chainID uint64,
+) (*BlobTxFactory, error) {
+	ctx, err := gokzg4844.NewContext4096(ts)
+	if err != nil {
+		return nil, err
+	}
+	return &BlobTxFactory{
+		kzg:     ctx,
+		chainID: uint256.NewInt(chainID),
+	}, nil
+}
+
+// New returns a new blob transaction carrying numBlobs blobs. Every call
+// uses the next nonce, so two factories built from the same setup produce
+// the same sequence of transactions.
+func (f *BlobTxFactory) New(numBlobs int) (*BlobTx, error) {
+	f.mu.Lock()
+	nonce := f.nonce
+	f.nonce++
+	f.mu.Unlock()
+
+	tx := &BlobTx{
+		Blobs:       make([]*eip4844.Blob, numBlobs),
+		Commitments: make([]eip4844.KZGCommitment, numBlobs),
+		Proofs:      make([]eip4844.KZGProof, numBlobs),
+	}
+	for i := range numBlobs {
+		blob := deterministicBlob(nonce, uint64(i))
+		commitment, err := f.kzg.BlobToKZGCommitment(
+			(*gokzg4844.Blob)(blob), 0,
+		)
+		if err != nil {
+			return nil, err
+		}
+		proof, err := f.kzg.ComputeBlobKZGProof(
+			(*gokzg4844.Blob)(blob), commitment, 0,
+		)
+		if err != nil {
+			return nil, err
+		}
+		tx.Blobs[i] = blob
+		tx.Commitments[i] = eip4844.KZGCommitment(commitment)
+		tx.Proofs[i] = eip4844.KZGProof(proof)
+	}
+
+	gethTx := gethtypes.NewTx(&gethtypes.BlobTx{
+		ChainID:    f.chainID,
+		Nonce:      nonce,
+		GasTipCap:  uint256.NewInt(blobTxFeeCap),
+		GasFeeCap:  uint256.NewInt(blobTxFeeCap),
+		Gas:        blobTxGas,
+		Value:      new(uint256.Int),
+		BlobFeeCap: uint256.NewInt(blobTxFeeCap),
+		BlobHashes: tx.VersionedHashes(),
+		V:          new(uint256.Int),
+		R:          new(uint256.Int),
+		S:          new(uint256.Int),
+	})
+	raw, err := gethTx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	tx.Hash = common.ExecutionHash(gethTx.Hash())
+	tx.Raw = raw
+	return tx, nil
+}
+
+// deterministicBlob fills a blob from a hash chain seeded by the nonce and
+// index. The first byte of every field element is cleared so that each
+// element is canonical, i.e. smaller than the BLS modulus.
+func deterministicBlob(nonce, index uint64) *eip4844.Blob {
+	var (
+		blob eip4844.Blob
+		seed [16]byte
+	)
+	binary.BigEndian.PutUint64(seed[:8], nonce)
+	binary.BigEndian.PutUint64(seed[8:], index)
+	digest := sha256.Sum256(seed[:])
+	for i := 0; i < len(blob); i += fieldElementSize {
+		digest = sha256.Sum256(digest[:])
+		copy(blob[i+1:i+fieldElementSize], digest[1:])
+	}
+	return &blob
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+import (
+	"math/big"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// block is an execution block known to the chain.
+type block struct {
+	hash      common.ExecutionHash
+	number    uint64
+	timestamp uint64
+	stateRoot common.Bytes32
+	txHashes  []common.ExecutionHash
+}
+
+// newBlock creates a block record from an execution payload.
+func newBlock(
+	payload *types.ExecutionPayload,
+	txs []*gethtypes.Transaction,
+) *block {
+	txHashes := make([]common.ExecutionHash, len(txs))
+	for i, tx := range txs {
+		txHashes[i] = tx.Hash()
+	}
+	return &block{
+		hash:      payload.GetBlockHash(),
+		number:    payload.GetNumber().Unwrap(),
+		timestamp: payload.GetTimestamp().Unwrap(),
+		stateRoot: payload.GetStateRoot(),
+		txHashes:  txHashes,
+	}
+}
+
+// decodeTransactions decodes the binary encoded transactions of a payload.
+func decodeTransactions(raw [][]byte) ([]*gethtypes.Transaction, error) {
+	txs := make([]*gethtypes.Transaction, len(raw))
+	for i, bz := range raw {
+		txs[i] = new(gethtypes.Transaction)
+		if err := txs[i].UnmarshalBinary(bz); err != nil {
+			return nil, err
+		}
+	}
+	return txs, nil
+}
+
+// computeBlockHash computes the execution block hash of a payload the same
+// way an execution client does.
+func computeBlockHash(
+	payload *types.ExecutableDataDeneb,
+	txs []*gethtypes.Transaction,
+	parentBeaconBlockRoot common.Root,
+) common.ExecutionHash {
+	withdrawals := make([]*gethtypes.Withdrawal, len(payload.Withdrawals))
+	for i, wd := range payload.Withdrawals {
+		withdrawals[i] = &gethtypes.Withdrawal{
+			Index:     wd.GetIndex().Unwrap(),
+			Validator: wd.GetValidatorIndex().Unwrap(),
+			Address:   wd.GetAddress(),
+			Amount:    wd.GetAmount().Unwrap(),
+		}
+	}
+	withdrawalsHash := gethtypes.DeriveSha(
+		gethtypes.Withdrawals(withdrawals), trie.NewStackTrie(nil),
+	)
+	beaconRoot := common.ExecutionHash(parentBeaconBlockRoot)
+
+	return gethtypes.NewBlockWithHeader(&gethtypes.Header{
+		ParentHash: payload.ParentHash,
+		UncleHash:  gethtypes.EmptyUncleHash,
+		Coinbase:   payload.FeeRecipient,
+		Root:       common.ExecutionHash(payload.StateRoot),
+		TxHash: gethtypes.DeriveSha(
+			gethtypes.Transactions(txs), trie.NewStackTrie(nil),
+		),
+		ReceiptHash:      common.ExecutionHash(payload.ReceiptsRoot),
+		Bloom:            gethtypes.BytesToBloom(payload.LogsBloom),
+		Difficulty:       big.NewInt(0),
+		Number:           new(big.Int).SetUint64(payload.Number.Unwrap()),
+		GasLimit:         payload.GasLimit.Unwrap(),
+		GasUsed:          payload.GasUsed.Unwrap(),
+		Time:             payload.Timestamp.Unwrap(),
+		BaseFee:          payload.BaseFeePerGas.UnwrapBig(),
+		Extra:            payload.ExtraData,
+		MixDigest:        common.ExecutionHash(payload.Random),
+		WithdrawalsHash:  &withdrawalsHash,
+		ExcessBlobGas:    payload.ExcessBlobGas.UnwrapPtr(),
+		BlobGasUsed:      payload.BlobGasUsed.UnwrapPtr(),
+		ParentBeaconRoot: &beaconRoot,
+	}).WithBody(gethtypes.Body{
+		Transactions: txs,
+		Withdrawals:  withdrawals,
+	}).Hash()
+}
+
+// newPayloadRequest wraps a payload in a new payload request so that the
+// block and versioned hashes can be verified.
+func newPayloadRequest(
+	payload *types.ExecutionPayload,
+	versionedHashes []common.ExecutionHash,
+	parentBeaconBlockRoot *common.Root,
+) *engineprimitives.NewPayloadRequest[
+	*types.ExecutionPayload, *engineprimitives.Withdrawal,
+] {
+	return &engineprimitives.NewPayloadRequest[
+		*types.ExecutionPayload, *engineprimitives.Withdrawal,
+	]{
+		ExecutionPayload:      payload,
+		VersionedHashes:       versionedHashes,
+		ParentBeaconBlockRoot: parentBeaconBlockRoot,
+	}
+}
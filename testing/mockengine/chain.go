@@ -0,0 +1,444 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Envelope is the payload envelope returned by getPayload.
+type Envelope = engineprimitives.ExecutionPayloadEnvelope[
+	*types.ExecutionPayload,
+	*engineprimitives.BlobsBundleV1[
+		eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+	],
+]
+
+// PayloadAttributes are the payload attributes accepted by the chain.
+type PayloadAttributes = engineprimitives.PayloadAttributes[
+	*engineprimitives.Withdrawal,
+]
+
+// job is a payload build process started by a forkchoice update.
+type job struct {
+	parent   *block
+	attrs    *PayloadAttributes
+	envelope *Envelope
+}
+
+// Chain is a deterministic, in-memory model of an execution client. It
+// tracks the blocks it has been given, follows forkchoice updates and
+// builds payloads out of the blob transactions found in its mempool.
+//
+// Chain speaks the execution client side of the Engine API: it reports
+// payload statuses and leaves their interpretation to the caller.
+type Chain struct {
+	cfg     Config
+	mempool *Mempool
+
+	mu     sync.Mutex
+	fault  Fault
+	blocks map[common.ExecutionHash]*block
+	head   *block
+	jobs   map[engineprimitives.PayloadID]*job
+	// lastForkchoice is the head of the most recent forkchoice update.
+	lastForkchoice common.ExecutionHash
+	// forkchoiceCh is closed and replaced on every forkchoice update.
+	forkchoiceCh chan struct{}
+}
+
+// NewChain creates a new chain starting from the given genesis header and
+// drawing transactions from the given mempool.
+func NewChain(
+	cfg Config,
+	genesis *types.ExecutionPayloadHeader,
+	mempool *Mempool,
+) *Chain {
+	head := &block{
+		hash:      genesis.GetBlockHash(),
+		number:    genesis.GetNumber().Unwrap(),
+		timestamp: genesis.GetTimestamp().Unwrap(),
+		stateRoot: genesis.GetStateRoot(),
+	}
+	return &Chain{
+		cfg:          cfg,
+		mempool:      mempool,
+		blocks:       map[common.ExecutionHash]*block{head.hash: head},
+		head:         head,
+		jobs:         make(map[engineprimitives.PayloadID]*job),
+		forkchoiceCh: make(chan struct{}),
+	}
+}
+
+// SetFault scripts the behaviour of the chain for all subsequent calls.
+func (c *Chain) SetFault(fault Fault) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.fault = fault
+}
+
+// Fault returns the currently scripted fault.
+func (c *Chain) Fault() Fault {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.fault
+}
+
+// Head returns the hash and number of the current head block.
+func (c *Chain) Head() (common.ExecutionHash, uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.head.hash, c.head.number
+}
+
+// HasBlock reports whether the chain knows the block with the given hash.
+func (c *Chain) HasBlock(hash common.ExecutionHash) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.blocks[hash]
+	return ok
+}
+
+// WaitForForkchoice blocks until a forkchoice update pointing at the given
+// head has been received or the context is done.
+func (c *Chain) WaitForForkchoice(
+	ctx context.Context,
+	head common.ExecutionHash,
+) error {
+	for {
+		c.mu.Lock()
+		seen, ch := c.lastForkchoice == head, c.forkchoiceCh
+		c.mu.Unlock()
+		if seen {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ch:
+		}
+	}
+}
+
+// NewPayload validates the payload and inserts it into the chain.
+func (c *Chain) NewPayload(
+	payload *types.ExecutionPayload,
+	versionedHashes []common.ExecutionHash,
+	parentBeaconBlockRoot *common.Root,
+) (*engineprimitives.PayloadStatusV1, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch c.fault {
+	case FaultOffline:
+		return nil, engineerrors.ErrEngineAPITimeout
+	case FaultSyncing:
+		return syncingStatus(), nil
+	case FaultInvalid:
+		return invalidStatus(
+			c.head.hash, ErrScriptedInvalid,
+		), nil
+	case FaultNone:
+	}
+
+	if err := newPayloadRequest(
+		payload, versionedHashes, parentBeaconBlockRoot,
+	).HasValidVersionedAndBlockHashes(); err != nil {
+		return invalidStatus(c.head.hash, err), nil
+	}
+
+	// Blocks we already know about are trivially valid.
+	if blk, ok := c.blocks[payload.GetBlockHash()]; ok {
+		return validStatus(blk.hash), nil
+	}
+
+	// Without the parent we cannot execute the payload.
+	parent, ok := c.blocks[payload.GetParentHash()]
+	if !ok {
+		return syncingStatus(), nil
+	}
+
+	if err := validateAgainstParent(payload, parent); err != nil {
+		return invalidStatus(parent.hash, err), nil
+	}
+
+	txs, err := decodeTransactions(payload.GetTransactions())
+	if err != nil {
+		return invalidStatus(parent.hash, err), nil
+	}
+	blk := newBlock(payload, txs)
+	c.blocks[blk.hash] = blk
+	return validStatus(blk.hash), nil
+}
+
+// ForkchoiceUpdated moves the head of the chain and, if attributes are
+// given, starts building a payload on top of it.
+func (c *Chain) ForkchoiceUpdated(
+	state *engineprimitives.ForkchoiceStateV1,
+	attrs *PayloadAttributes,
+) (*engineprimitives.ForkchoiceResponseV1, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lastForkchoice = state.HeadBlockHash
+	close(c.forkchoiceCh)
+	c.forkchoiceCh = make(chan struct{})
+
+	switch c.fault {
+	case FaultOffline:
+		return nil, engineerrors.ErrEngineAPITimeout
+	case FaultSyncing:
+		return &engineprimitives.ForkchoiceResponseV1{
+			PayloadStatus: *syncingStatus(),
+		}, nil
+	case FaultInvalid:
+		return &engineprimitives.ForkchoiceResponseV1{
+			PayloadStatus: *invalidStatus(c.head.hash, ErrScriptedInvalid),
+		}, nil
+	case FaultNone:
+	}
+
+	head, ok := c.blocks[state.HeadBlockHash]
+	if !ok {
+		return &engineprimitives.ForkchoiceResponseV1{
+			PayloadStatus: *syncingStatus(),
+		}, nil
+	}
+	c.head = head
+	c.mempool.Remove(head.txHashes...)
+
+	resp := &engineprimitives.ForkchoiceResponseV1{
+		PayloadStatus: *validStatus(head.hash),
+	}
+	if attrs == nil || attrs.IsNil() {
+		return resp, nil
+	}
+
+	if attrs.Timestamp.Unwrap() <= head.timestamp {
+		return nil, errors.Wrapf(
+			engineerrors.ErrInvalidPayloadAttributes,
+			"timestamp %d is not after parent timestamp %d",
+			attrs.Timestamp, head.timestamp,
+		)
+	}
+
+	id := computePayloadID(head.hash, attrs)
+	if _, ok = c.jobs[id]; !ok {
+		c.jobs[id] = &job{parent: head, attrs: attrs}
+	}
+	resp.PayloadID = &id
+	return resp, nil
+}
+
+// GetPayload returns the payload built for the given payload ID. The
+// payload is sealed on the first call, picking up whatever blob
+// transactions are pending at that time.
+func (c *Chain) GetPayload(
+	id engineprimitives.PayloadID,
+) (*Envelope, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.fault == FaultOffline {
+		return nil, engineerrors.ErrEngineAPITimeout
+	}
+
+	j, ok := c.jobs[id]
+	if !ok {
+		return nil, engineerrors.ErrUnknownPayload
+	}
+	if j.envelope == nil {
+		j.envelope = c.buildPayload(j.parent, j.attrs)
+	}
+	return j.envelope, nil
+}
+
+// buildPayload seals a new payload on top of the given parent.
+func (c *Chain) buildPayload(
+	parent *block,
+	attrs *PayloadAttributes,
+) *Envelope {
+	var (
+		pending = c.mempool.Pending(c.cfg.MaxBlobsPerBlock)
+		raw     = make([][]byte, 0, len(pending))
+		txs     = make([]*gethtypes.Transaction, 0, len(pending))
+		bundle  = &engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		]{
+			Commitments: make([]eip4844.KZGCommitment, 0),
+			Proofs:      make([]eip4844.KZGProof, 0),
+			Blobs:       make([]*eip4844.Blob, 0),
+		}
+	)
+	for _, tx := range pending {
+		gethTx := new(gethtypes.Transaction)
+		if err := gethTx.UnmarshalBinary(tx.Raw); err != nil {
+			continue
+		}
+		raw = append(raw, tx.Raw)
+		txs = append(txs, gethTx)
+		bundle.Commitments = append(bundle.Commitments, tx.Commitments...)
+		bundle.Proofs = append(bundle.Proofs, tx.Proofs...)
+		bundle.Blobs = append(bundle.Blobs, tx.Blobs...)
+	}
+
+	withdrawals := make(
+		[]*engineprimitives.Withdrawal, len(attrs.Withdrawals),
+	)
+	copy(withdrawals, attrs.Withdrawals)
+
+	payload := &types.ExecutableDataDeneb{
+		ParentHash:   parent.hash,
+		FeeRecipient: attrs.SuggestedFeeRecipient,
+		StateRoot:    nextStateRoot(parent, raw),
+		ReceiptsRoot: common.Bytes32(gethtypes.EmptyReceiptsHash),
+		LogsBloom:    make([]byte, constants.LogsBloomLength),
+		Random:       attrs.PrevRandao,
+		Number:       math.U64(parent.number + 1),
+		GasLimit:     math.U64(c.cfg.GasLimit),
+		GasUsed:      math.U64(uint64(len(txs)) * blobTxGas),
+		Timestamp:    attrs.Timestamp,
+		ExtraData:    []byte{},
+		BaseFeePerGas: math.MustNewU256LFromBigInt(
+			new(big.Int).SetUint64(c.cfg.BaseFeePerGas),
+		),
+		Transactions: raw,
+		Withdrawals:  withdrawals,
+		BlobGasUsed: math.U64(
+			uint64(len(bundle.Blobs)) * params.BlobTxBlobGasPerBlob,
+		),
+		ExcessBlobGas: 0,
+	}
+	payload.BlockHash = computeBlockHash(
+		payload, txs, attrs.ParentBeaconBlockRoot,
+	)
+
+	return &Envelope{
+		ExecutionPayload: &types.ExecutionPayload{
+			InnerExecutionPayload: payload,
+		},
+		BlockValue:  math.Wei{},
+		BlobsBundle: bundle,
+	}
+}
+
+// validateAgainstParent performs the header checks an execution client
+// runs before executing a payload.
+func validateAgainstParent(
+	payload *types.ExecutionPayload,
+	parent *block,
+) error {
+	if number := payload.GetNumber().Unwrap(); number != parent.number+1 {
+		return errors.Wrapf(
+			ErrInvalidBlockNumber,
+			"expected %d, got %d", parent.number+1, number,
+		)
+	}
+	if ts := payload.GetTimestamp().Unwrap(); ts <= parent.timestamp {
+		return errors.Wrapf(
+			ErrInvalidTimestamp,
+			"timestamp %d is not after parent timestamp %d",
+			ts, parent.timestamp,
+		)
+	}
+	return nil
+}
+
+// nextStateRoot derives a deterministic stand-in for the post-execution
+// state root.
+func nextStateRoot(parent *block, txs [][]byte) common.Bytes32 {
+	h := sha256.New()
+	h.Write(parent.stateRoot[:])
+	for _, tx := range txs {
+		h.Write(tx)
+	}
+	return common.Bytes32(h.Sum(nil))
+}
+
+// computePayloadID derives the payload ID from the build parameters, so
+// that identical forkchoice updates resume the same build process.
+func computePayloadID(
+	head common.ExecutionHash,
+	attrs *PayloadAttributes,
+) engineprimitives.PayloadID {
+	var ts [8]byte
+	binary.BigEndian.PutUint64(ts[:], attrs.Timestamp.Unwrap())
+
+	h := sha256.New()
+	h.Write(head[:])
+	h.Write(ts[:])
+	h.Write(attrs.PrevRandao[:])
+	h.Write(attrs.SuggestedFeeRecipient[:])
+	h.Write(attrs.ParentBeaconBlockRoot[:])
+	for _, wd := range attrs.Withdrawals {
+		binary.BigEndian.PutUint64(ts[:], wd.GetIndex().Unwrap())
+		h.Write(ts[:])
+	}
+
+	var id engineprimitives.PayloadID
+	copy(id[:], h.Sum(nil))
+	return id
+}
+
+// validStatus returns a VALID payload status.
+func validStatus(
+	latestValidHash common.ExecutionHash,
+) *engineprimitives.PayloadStatusV1 {
+	return &engineprimitives.PayloadStatusV1{
+		Status:          engineprimitives.PayloadStatusValid,
+		LatestValidHash: &latestValidHash,
+	}
+}
+
+// syncingStatus returns a SYNCING payload status.
+func syncingStatus() *engineprimitives.PayloadStatusV1 {
+	return &engineprimitives.PayloadStatusV1{
+		Status: engineprimitives.PayloadStatusSyncing,
+	}
+}
+
+// invalidStatus returns an INVALID payload status.
+func invalidStatus(
+	latestValidHash common.ExecutionHash,
+	err error,
+) *engineprimitives.PayloadStatusV1 {
+	msg := err.Error()
+	return &engineprimitives.PayloadStatusV1{
+		Status:          engineprimitives.PayloadStatusInvalid,
+		LatestValidHash: &latestValidHash,
+		ValidationError: &msg,
+	}
+}
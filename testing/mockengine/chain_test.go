@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/genesis"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/testing/mockengine"
+	"github.com/stretchr/testify/require"
+)
+
+func newChain(t *testing.T) *mockengine.Chain {
+	t.Helper()
+	return mockengine.NewChain(
+		mockengine.DefaultConfig(),
+		genesis.DefaultGenesisDeneb().ExecutionPayloadHeader,
+		mockengine.NewMempool(),
+	)
+}
+
+// buildPayload builds a payload on top of the head of the given chain.
+func buildPayload(t *testing.T, chain *mockengine.Chain) *mockengine.Envelope {
+	t.Helper()
+	head, _ := chain.Head()
+	attrs, err := engineprimitives.NewPayloadAttributes[
+		*engineprimitives.Withdrawal,
+	](
+		version.Deneb, 1, common.Bytes32{0x01}, common.ExecutionAddress{},
+		[]*engineprimitives.Withdrawal{}, common.Root{0x02},
+	)
+	require.NoError(t, err)
+
+	resp, err := chain.ForkchoiceUpdated(
+		&engineprimitives.ForkchoiceStateV1{HeadBlockHash: head}, attrs,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, resp.PayloadID)
+
+	envelope, err := chain.GetPayload(*resp.PayloadID)
+	require.NoError(t, err)
+	return envelope
+}
+
+func TestChainImportsBuiltPayload(t *testing.T) {
+	builder, follower := newChain(t), newChain(t)
+	payload := buildPayload(t, builder).ExecutionPayload
+
+	status, err := follower.NewPayload(payload, nil, &common.Root{0x02})
+	require.NoError(t, err)
+	require.Equal(t, engineprimitives.PayloadStatusValid, status.Status)
+	require.True(t, follower.HasBlock(payload.GetBlockHash()))
+
+	_, err = follower.ForkchoiceUpdated(
+		&engineprimitives.ForkchoiceStateV1{
+			HeadBlockHash: payload.GetBlockHash(),
+		}, nil,
+	)
+	require.NoError(t, err)
+	head, number := follower.Head()
+	require.Equal(t, payload.GetBlockHash(), head)
+	require.Equal(t, uint64(1), number)
+}
+
+func TestChainRejectsWrongParentRoot(t *testing.T) {
+	builder, follower := newChain(t), newChain(t)
+	payload := buildPayload(t, builder).ExecutionPayload
+
+	status, err := follower.NewPayload(payload, nil, &common.Root{0x03})
+	require.NoError(t, err)
+	require.Equal(t, engineprimitives.PayloadStatusInvalid, status.Status)
+	require.False(t, follower.HasBlock(payload.GetBlockHash()))
+}
+
+func TestChainFaults(t *testing.T) {
+	builder, follower := newChain(t), newChain(t)
+	payload := buildPayload(t, builder).ExecutionPayload
+
+	follower.SetFault(mockengine.FaultSyncing)
+	status, err := follower.NewPayload(payload, nil, &common.Root{0x02})
+	require.NoError(t, err)
+	require.Equal(t, engineprimitives.PayloadStatusSyncing, status.Status)
+
+	follower.SetFault(mockengine.FaultOffline)
+	_, err = follower.NewPayload(payload, nil, &common.Root{0x02})
+	require.Error(t, err)
+
+	follower.SetFault(mockengine.FaultNone)
+	status, err = follower.NewPayload(payload, nil, &common.Root{0x02})
+	require.NoError(t, err)
+	require.Equal(t, engineprimitives.PayloadStatusValid, status.Status)
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/genesis"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/testing/mockengine"
+)
+
+// main runs a mock execution client serving the Engine API, so that a
+// beacon node can be started without geth.
+func main() {
+	chainSpec := spec.DevnetChainSpec()
+	var (
+		addr = flag.String(
+			"addr", "127.0.0.1:8551", "address to serve the Engine API on",
+		)
+		jwtSecretPath = flag.String(
+			"jwt-secret", "", "path to the hex encoded JWT secret",
+		)
+		chainID = flag.Uint64(
+			"chain-id", chainSpec.DepositEth1ChainID(),
+			"chain ID reported by eth_chainId",
+		)
+		depositContract = flag.String(
+			"deposit-contract", chainSpec.DepositContractAddress().Hex(),
+			"address of the deposit contract",
+		)
+		fault = flag.String(
+			"fault", mockengine.FaultNone.String(),
+			"scripted fault: none, syncing, invalid or offline",
+		)
+		delay = flag.Duration(
+			"delay", 0, "delay added to every request",
+		)
+	)
+	flag.Parse()
+
+	secret, err := components.LoadJWTFromFile(*jwtSecretPath)
+	if err != nil {
+		log.Fatalf("failed to load JWT secret: %v", err)
+	}
+	f, err := mockengine.ParseFault(*fault)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	chain := mockengine.NewChain(
+		mockengine.DefaultConfig(),
+		genesis.DefaultGenesisDeneb().ExecutionPayloadHeader,
+		mockengine.NewMempool(),
+	)
+	chain.SetFault(f)
+
+	server, err := mockengine.NewServer(
+		chain, secret,
+		mockengine.WithChainID(*chainID),
+		mockengine.WithDepositContract(
+			common.HexToAddress(*depositContract),
+		),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+	server.SetDelay(*delay)
+	if err = server.Start(*addr); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("serving the Engine API on %s", server.URL())
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	<-sigCh
+	if err = server.Stop(); err != nil {
+		log.Fatal(err)
+	}
+}
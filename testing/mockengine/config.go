@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+const (
+	// defaultGasLimit is the default gas limit of built payloads.
+	defaultGasLimit = 30_000_000
+	// defaultBaseFeePerGas is the default base fee of built payloads.
+	defaultBaseFeePerGas = 7
+	// defaultMaxBlobsPerBlock is the default number of blobs a built
+	// payload may carry.
+	defaultMaxBlobsPerBlock = 6
+)
+
+// Config is the configuration of the mock execution chain.
+type Config struct {
+	// GasLimit is the gas limit of built payloads.
+	GasLimit uint64
+	// BaseFeePerGas is the base fee of built payloads.
+	BaseFeePerGas uint64
+	// MaxBlobsPerBlock bounds the number of blobs included in a payload.
+	MaxBlobsPerBlock uint64
+}
+
+// DefaultConfig returns the default configuration of the mock chain.
+func DefaultConfig() Config {
+	return Config{
+		GasLimit:         defaultGasLimit,
+		BaseFeePerGas:    defaultBaseFeePerGas,
+		MaxBlobsPerBlock: defaultMaxBlobsPerBlock,
+	}
+}
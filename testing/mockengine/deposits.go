@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+import (
+	"context"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// depositEvent is the name of the deposit contract event.
+const depositEvent = "Deposit"
+
+// filterQuery is the filter accepted by eth_getLogs.
+type filterQuery struct {
+	FromBlock *rpc.BlockNumber          `json:"fromBlock"`
+	ToBlock   *rpc.BlockNumber          `json:"toBlock"`
+	Addresses []common.ExecutionAddress `json:"address"`
+	Topics    [][]common.ExecutionHash  `json:"topics"`
+}
+
+// ethAPI serves the subset of the eth namespace used by the node.
+type ethAPI struct {
+	server *Server
+}
+
+// ChainId implements eth_chainId.
+//
+//nolint:revive,stylecheck // the method name is dictated by the RPC.
+func (api *ethAPI) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(api.server.chainID)
+}
+
+// GetLogs implements eth_getLogs over the deposit contract logs.
+func (api *ethAPI) GetLogs(
+	_ context.Context,
+	query filterQuery,
+) ([]gethtypes.Log, error) {
+	_, head := api.server.chain.Head()
+	from, to := resolveBlockNumber(query.FromBlock, head),
+		resolveBlockNumber(query.ToBlock, head)
+
+	api.server.mu.Lock()
+	defer api.server.mu.Unlock()
+	logs := make([]gethtypes.Log, 0)
+	for _, log := range api.server.logs {
+		if log.BlockNumber < from || log.BlockNumber > to {
+			continue
+		}
+		if len(query.Addresses) > 0 &&
+			!slices.Contains(query.Addresses, log.Address) {
+			continue
+		}
+		if !matchTopics(log.Topics, query.Topics) {
+			continue
+		}
+		logs = append(logs, log)
+	}
+	return logs, nil
+}
+
+// AddDeposit makes the deposit contract emit the given deposit in the
+// block with the given number.
+func (s *Server) AddDeposit(blockNumber uint64, d *types.Deposit) error {
+	contractABI, err := deposit.BeaconDepositContractMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	event := contractABI.Events[depositEvent]
+	data, err := event.Inputs.NonIndexed().Pack(
+		d.Pubkey[:], d.Credentials[:], d.Amount.Unwrap(), d.Signature[:],
+		d.Index,
+	)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.logs = append(s.logs, gethtypes.Log{
+		Address:     s.depositContract,
+		Topics:      []common.ExecutionHash{event.ID},
+		Data:        data,
+		BlockNumber: blockNumber,
+		Index:       uint(len(s.logs)),
+	})
+	return nil
+}
+
+// resolveBlockNumber turns a block tag into a block number, defaulting
+// to the head.
+func resolveBlockNumber(number *rpc.BlockNumber, head uint64) uint64 {
+	switch {
+	case number == nil:
+		return head
+	case *number == rpc.EarliestBlockNumber:
+		return 0
+	case *number < 0:
+		return head
+	default:
+		return uint64(*number)
+	}
+}
+
+// matchTopics reports whether the topics of a log match the filter. Each
+// position of the filter matches any of its hashes, or anything if empty.
+func matchTopics(
+	topics []common.ExecutionHash,
+	filter [][]common.ExecutionHash,
+) bool {
+	if len(filter) > len(topics) {
+		return false
+	}
+	for i, alternatives := range filter {
+		if len(alternatives) > 0 && !slices.Contains(alternatives, topics[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	execution "github.com/berachain/beacon-kit/mod/execution/pkg/engine"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// Engine is a drop-in replacement for the execution engine used by the
+// beacon node. It interprets the payload statuses reported by a Chain
+// exactly like the real execution engine interprets the statuses reported
+// by an execution client.
+type Engine struct {
+	chain *Chain
+}
+
+// NewEngine creates a new engine backed by the given chain.
+func NewEngine(chain *Chain) *Engine {
+	return &Engine{chain: chain}
+}
+
+// Chain returns the chain backing the engine.
+func (e *Engine) Chain() *Chain {
+	return e.chain
+}
+
+// GetPayload returns the payload and blobs bundle for the given payload ID.
+func (e *Engine) GetPayload(
+	_ context.Context,
+	req *engineprimitives.GetPayloadRequest[engineprimitives.PayloadID],
+) (engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload], error) {
+	envelope, err := e.chain.GetPayload(req.PayloadID)
+	if err != nil {
+		return nil, err
+	}
+	return envelope, nil
+}
+
+// NotifyForkchoiceUpdate notifies the chain of a forkchoice update.
+func (e *Engine) NotifyForkchoiceUpdate(
+	_ context.Context,
+	req *engineprimitives.ForkchoiceUpdateRequest[*PayloadAttributes],
+) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
+	resp, err := e.chain.ForkchoiceUpdated(req.State, req.PayloadAttributes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch resp.PayloadStatus.Status {
+	case engineprimitives.PayloadStatusAccepted,
+		engineprimitives.PayloadStatusSyncing:
+		return resp.PayloadID, nil, nil
+	case engineprimitives.PayloadStatusInvalid:
+		return resp.PayloadID, resp.PayloadStatus.LatestValidHash,
+			execution.ErrBadBlockProduced
+	}
+
+	if resp.PayloadID == nil && !req.PayloadAttributes.IsNil() {
+		return nil, resp.PayloadStatus.LatestValidHash,
+			execution.ErrNilPayloadOnValidResponse
+	}
+	return resp.PayloadID, resp.PayloadStatus.LatestValidHash, nil
+}
+
+// VerifyAndNotifyNewPayload verifies the new payload and hands it to the
+// chain. Errors are swallowed for optimistic requests.
+func (e *Engine) VerifyAndNotifyNewPayload(
+	_ context.Context,
+	req *engineprimitives.NewPayloadRequest[
+		*types.ExecutionPayload, *engineprimitives.Withdrawal,
+	],
+) error {
+	if err := req.HasValidVersionedAndBlockHashes(); err != nil {
+		return err
+	}
+
+	status, err := e.chain.NewPayload(
+		req.ExecutionPayload,
+		req.VersionedHashes,
+		req.ParentBeaconBlockRoot,
+	)
+	if err == nil {
+		switch status.Status {
+		case engineprimitives.PayloadStatusAccepted:
+			err = engineerrors.ErrAcceptedPayloadStatus
+		case engineprimitives.PayloadStatusSyncing:
+			err = engineerrors.ErrSyncingPayloadStatus
+		case engineprimitives.PayloadStatusInvalid:
+			// Bad blocks are reported even for optimistic requests.
+			return execution.ErrBadBlockProduced
+		}
+	}
+
+	if req.Optimistic {
+		return nil
+	}
+	return err
+}
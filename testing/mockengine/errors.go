@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrScriptedInvalid is the validation error reported while the chain
+	// is scripted to reject payloads.
+	ErrScriptedInvalid = errors.New("payload rejected by scripted fault")
+
+	// ErrInvalidBlockNumber is returned when a payload does not extend its
+	// parent by exactly one block.
+	ErrInvalidBlockNumber = errors.New("invalid block number")
+
+	// ErrInvalidTimestamp is returned when a payload is not newer than its
+	// parent.
+	ErrInvalidTimestamp = errors.New("invalid timestamp")
+
+	// ErrMissingToken is returned when a request does not carry a bearer
+	// token.
+	ErrMissingToken = errors.New("missing bearer token")
+)
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+import "github.com/berachain/beacon-kit/testing/mockserver"
+
+// Fault is a scripted misbehaviour of the mock execution client.
+type Fault uint8
+
+const (
+	// FaultNone makes the execution client behave honestly.
+	FaultNone Fault = iota
+	// FaultSyncing makes the execution client report SYNCING for every
+	// newPayload and forkchoiceUpdated call.
+	FaultSyncing
+	// FaultInvalid makes the execution client report INVALID for every
+	// newPayload and forkchoiceUpdated call.
+	FaultInvalid
+	// FaultOffline makes every call fail as if the request timed out.
+	FaultOffline
+)
+
+// faultNames are the names of the faults, indexed by fault.
+var faultNames = mockserver.FaultNames[Fault]{
+	"none", "syncing", "invalid", "offline",
+}
+
+// String returns the name of the fault.
+func (f Fault) String() string {
+	return faultNames.Name(f)
+}
+
+// ParseFault returns the fault with the given name.
+func ParseFault(name string) (Fault, error) {
+	return faultNames.Parse(name)
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+)
+
+// BlobTx is a blob carrying transaction along with its sidecar.
+type BlobTx struct {
+	// Hash is the hash of the transaction.
+	Hash common.ExecutionHash
+	// Raw is the binary encoding of the transaction.
+	Raw []byte
+	// Blobs are the blobs carried by the transaction.
+	Blobs []*eip4844.Blob
+	// Commitments are the KZG commitments to the blobs.
+	Commitments []eip4844.KZGCommitment
+	// Proofs are the KZG proofs of the blobs.
+	Proofs []eip4844.KZGProof
+}
+
+// VersionedHashes returns the versioned hashes of the blob commitments.
+func (tx *BlobTx) VersionedHashes() []common.ExecutionHash {
+	hashes := make([]common.ExecutionHash, len(tx.Commitments))
+	for i, c := range tx.Commitments {
+		hashes[i] = c.ToVersionedHash()
+	}
+	return hashes
+}
+
+// Mempool is a FIFO pool of pending blob transactions. A single mempool is
+// typically shared between several chains to emulate transaction gossip
+// on the execution layer.
+type Mempool struct {
+	mu  sync.Mutex
+	txs []*BlobTx
+}
+
+// NewMempool creates a new, empty mempool.
+func NewMempool() *Mempool {
+	return &Mempool{}
+}
+
+// Add appends the given transactions to the pool.
+func (m *Mempool) Add(txs ...*BlobTx) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.txs = append(m.txs, txs...)
+}
+
+// Pending returns the oldest transactions whose blobs fit in maxBlobs.
+func (m *Mempool) Pending(maxBlobs uint64) []*BlobTx {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var (
+		pending []*BlobTx
+		blobs   uint64
+	)
+	for _, tx := range m.txs {
+		if blobs+uint64(len(tx.Blobs)) > maxBlobs {
+			break
+		}
+		blobs += uint64(len(tx.Blobs))
+		pending = append(pending, tx)
+	}
+	return pending
+}
+
+// Remove drops the transactions with the given hashes from the pool.
+func (m *Mempool) Remove(hashes ...common.ExecutionHash) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.txs = slices.DeleteFunc(m.txs, func(tx *BlobTx) bool {
+		return slices.Contains(hashes, tx.Hash)
+	})
+}
+
+// Len returns the number of pending transactions.
+func (m *Mempool) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.txs)
+}
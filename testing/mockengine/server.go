@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+import (
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/testing/mockserver"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// maxJWTDrift is the maximum distance between the issued-at claim of a
+// token and the server clock, as allowed by the Engine API spec.
+const maxJWTDrift = 60 * time.Second
+
+// Server serves a Chain over the authenticated Engine API, speaking the
+// same JSON-RPC over HTTP as a real execution client. It lets the engine
+// client be exercised end to end without running geth.
+type Server struct {
+	mockserver.HTTP
+
+	chain           *Chain
+	secret          *jwt.Secret
+	chainID         *big.Int
+	depositContract common.ExecutionAddress
+
+	rpc *rpc.Server
+
+	mu sync.Mutex
+	// delay is added to every authenticated request.
+	delay time.Duration
+	// logs are the deposit contract logs served by eth_getLogs.
+	logs []gethtypes.Log
+	// rejected counts the requests that failed authentication.
+	rejected int
+}
+
+// ServerOption is a functional option for the Server.
+type ServerOption func(*Server) error
+
+// WithChainID sets the chain ID reported by eth_chainId.
+func WithChainID(chainID uint64) ServerOption {
+	return func(s *Server) error {
+		s.chainID = new(big.Int).SetUint64(chainID)
+		return nil
+	}
+}
+
+// WithDepositContract sets the address of the deposit contract whose logs
+// are served by eth_getLogs.
+func WithDepositContract(address common.ExecutionAddress) ServerOption {
+	return func(s *Server) error {
+		s.depositContract = address
+		return nil
+	}
+}
+
+// NewServer creates a new server exposing the given chain to clients
+// authenticated with the given JWT secret.
+func NewServer(
+	chain *Chain,
+	secret *jwt.Secret,
+	opts ...ServerOption,
+) (*Server, error) {
+	s := &Server{
+		chain:   chain,
+		secret:  secret,
+		chainID: big.NewInt(1),
+		rpc:     rpc.NewServer(),
+	}
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.rpc.RegisterName(
+		"engine", &engineAPI{chain: s.chain},
+	); err != nil {
+		return nil, err
+	}
+	if err := s.rpc.RegisterName("eth", &ethAPI{server: s}); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Start starts serving on the given address. An address with port 0
+// picks a free port, see URL.
+func (s *Server) Start(addr string) error {
+	return s.Serve(addr, s)
+}
+
+// Stop stops the server and drops every open connection.
+func (s *Server) Stop() error {
+	s.rpc.Stop()
+	return s.HTTP.Stop()
+}
+
+// Chain returns the chain behind the server.
+func (s *Server) Chain() *Chain {
+	return s.chain
+}
+
+// SetDelay delays every subsequent authenticated request by d, to push
+// clients against their timeouts.
+func (s *Server) SetDelay(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.delay = d
+}
+
+// Rejected returns the number of requests that failed authentication.
+func (s *Server) Rejected() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rejected
+}
+
+// ServeHTTP authenticates the request and hands it to the JSON-RPC
+// server. While the chain is scripted offline requests are held until the
+// client gives up, as if the execution client had stopped responding.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := s.authenticate(r); err != nil {
+		s.mu.Lock()
+		s.rejected++
+		s.mu.Unlock()
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	s.mu.Lock()
+	delay := s.delay
+	s.mu.Unlock()
+	if s.chain.Fault() == FaultOffline {
+		mockserver.Hang(r)
+		return
+	}
+	if delay > 0 && !mockserver.Sleep(r.Context(), delay) {
+		return
+	}
+	s.rpc.ServeHTTP(w, r)
+}
+
+// authenticate verifies the bearer token of the request against the
+// server secret.
+func (s *Server) authenticate(r *http.Request) error {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return ErrMissingToken
+	}
+	return jwt.VerifySignedJWT(token, s.secret, maxJWTDrift)
+}
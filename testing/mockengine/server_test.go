@@ -0,0 +1,250 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/http"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/testing/mockengine"
+	"github.com/berachain/beacon-kit/testing/mockserver"
+	"github.com/stretchr/testify/require"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+const testChainID = 80087
+
+type engineClient = client.EngineClient[
+	*types.ExecutionPayload,
+	*engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal],
+]
+
+// nopSink discards the metrics of the engine client.
+type nopSink struct{}
+
+func (nopSink) IncrementCounter(string, ...string)        {}
+func (nopSink) SetGauge(string, int64, ...string)         {}
+func (nopSink) MeasureSince(string, time.Time, ...string) {}
+
+// startServer starts a server on a free port, backed by a fresh chain.
+func startServer(
+	t *testing.T,
+	secret *jwt.Secret,
+	opts ...mockengine.ServerOption,
+) *mockengine.Server {
+	t.Helper()
+	server, err := mockengine.NewServer(
+		newChain(t), secret,
+		append([]mockengine.ServerOption{
+			mockengine.WithChainID(testChainID),
+		}, opts...)...,
+	)
+	require.NoError(t, err)
+	mockserver.StartForTest(t, server)
+	return server
+}
+
+// newClient creates an engine client dialing the given server.
+func newClient(
+	t *testing.T,
+	server *mockengine.Server,
+	secret *jwt.Secret,
+) *engineClient {
+	t.Helper()
+	dialURL, err := url.NewFromRaw(server.URL())
+	require.NoError(t, err)
+	cfg := client.DefaultConfig()
+	cfg.RPCDialURL = dialURL
+	cfg.RPCTimeout = 200 * time.Millisecond
+	cfg.RPCStartupCheckInterval = 50 * time.Millisecond
+	return client.New[
+		*types.ExecutionPayload,
+		*engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal],
+	](
+		&cfg, noop.NewLogger(), secret, nopSink{},
+		tracenoop.NewTracerProvider().Tracer("mockengine"),
+		big.NewInt(testChainID),
+	)
+}
+
+// startClient starts an engine client connected to the given server.
+func startClient(
+	t *testing.T,
+	server *mockengine.Server,
+	secret *jwt.Secret,
+) *engineClient {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	c := newClient(t, server, secret)
+	require.NoError(t, c.Start(ctx))
+	return c
+}
+
+// requestPayload starts building a payload on top of the head of the
+// server chain and returns it.
+func requestPayload(
+	t *testing.T,
+	server *mockengine.Server,
+	c *engineClient,
+) *types.ExecutionPayload {
+	t.Helper()
+	head, _ := server.Chain().Head()
+	attrs, err := engineprimitives.NewPayloadAttributes[
+		*engineprimitives.Withdrawal,
+	](
+		version.Deneb, 1, common.Bytes32{0x01}, common.ExecutionAddress{},
+		[]*engineprimitives.Withdrawal{}, common.Root{0x02},
+	)
+	require.NoError(t, err)
+
+	id, _, err := c.ForkchoiceUpdated(
+		context.Background(),
+		&engineprimitives.ForkchoiceStateV1{HeadBlockHash: head},
+		attrs, version.Deneb,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, id)
+
+	envelope, err := c.GetPayload(context.Background(), *id, version.Deneb)
+	require.NoError(t, err)
+	return envelope.GetExecutionPayload()
+}
+
+func TestServerRoundTrip(t *testing.T) {
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	server := startServer(t, secret)
+	c := startClient(t, server, secret)
+
+	payload := requestPayload(t, server, c)
+	latestValidHash, err := c.NewPayload(
+		context.Background(), payload, []common.ExecutionHash{},
+		&common.Root{0x02},
+	)
+	require.NoError(t, err)
+	require.Equal(t, payload.GetBlockHash(), *latestValidHash)
+
+	_, _, err = c.ForkchoiceUpdated(
+		context.Background(),
+		&engineprimitives.ForkchoiceStateV1{
+			HeadBlockHash: payload.GetBlockHash(),
+		},
+		nil, version.Deneb,
+	)
+	require.NoError(t, err)
+	head, number := server.Chain().Head()
+	require.Equal(t, payload.GetBlockHash(), head)
+	require.Equal(t, uint64(1), number)
+}
+
+func TestServerScriptedResponses(t *testing.T) {
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	server := startServer(t, secret)
+	c := startClient(t, server, secret)
+	payload := requestPayload(t, server, c)
+
+	server.Chain().SetFault(mockengine.FaultSyncing)
+	_, err = c.NewPayload(
+		context.Background(), payload, []common.ExecutionHash{},
+		&common.Root{0x02},
+	)
+	require.ErrorIs(t, err, engineerrors.ErrSyncingPayloadStatus)
+
+	server.Chain().SetFault(mockengine.FaultInvalid)
+	_, err = c.NewPayload(
+		context.Background(), payload, []common.ExecutionHash{},
+		&common.Root{0x02},
+	)
+	require.ErrorIs(t, err, engineerrors.ErrInvalidPayloadStatus)
+
+	server.Chain().SetFault(mockengine.FaultNone)
+	_, err = c.GetPayload(
+		context.Background(), engineprimitives.PayloadID{0xff}, version.Deneb,
+	)
+	require.ErrorIs(t, err, engineerrors.ErrUnknownPayload)
+
+	server.SetDelay(time.Second)
+	_, err = c.GetPayload(
+		context.Background(), engineprimitives.PayloadID{0xff}, version.Deneb,
+	)
+	require.ErrorIs(t, err, http.ErrTimeout)
+}
+
+func TestServerRejectsUnauthenticatedClients(t *testing.T) {
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	other, err := jwt.NewRandom()
+	require.NoError(t, err)
+	server := startServer(t, secret)
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 300*time.Millisecond,
+	)
+	defer cancel()
+	require.Error(t, newClient(t, server, other).Start(ctx))
+	require.Positive(t, server.Rejected())
+}
+
+func TestServerDepositLogs(t *testing.T) {
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	contract := common.ExecutionAddress{0x42}
+	server := startServer(
+		t, secret, mockengine.WithDepositContract(contract),
+	)
+	c := startClient(t, server, secret)
+
+	want := types.NewDeposit(
+		crypto.BLSPubkey{0x01}, types.WithdrawalCredentials{0x02},
+		math.Gwei(32e9), crypto.BLSSignature{0x03}, 7,
+	)
+	require.NoError(t, server.AddDeposit(5, want))
+
+	dc, err := deposit.NewWrappedBeaconDepositContract[
+		*types.Deposit, types.WithdrawalCredentials,
+	](contract, c.Client)
+	require.NoError(t, err)
+
+	deposits, err := dc.ReadDeposits(context.Background(), 5)
+	require.NoError(t, err)
+	require.Equal(t, []*types.Deposit{want}, deposits)
+
+	deposits, err = dc.ReadDeposits(context.Background(), 6)
+	require.NoError(t, err)
+	require.Empty(t, deposits)
+}
@@ -0,0 +1,304 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockrelay
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/testing/mockserver"
+)
+
+// denebVersion is the version string of the Deneb fork.
+const denebVersion = "deneb"
+
+// BlobsBundle is the blobs bundle revealed along with the payload.
+type BlobsBundle = engineprimitives.BlobsBundleV1[
+	eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+]
+
+// Server serves the builder API of a relay that bids with a scripted
+// payload. It lets the relay client and the validator fallback logic be
+// exercised end to end without running mev-boost.
+type Server struct {
+	mockserver.HTTP
+
+	chainSpec common.ChainSpec
+	signer    crypto.BLSSigner
+	// script is the scripted misbehaviour of the relay.
+	script mockserver.Script[Fault]
+
+	mu sync.Mutex
+	// payload, bundle and value make up the next bid.
+	payload *types.ExecutableDataDeneb
+	bundle  *BlobsBundle
+	value   math.Wei
+	// bid is the last bid handed out.
+	bid *types.BuilderBid
+	// registrations are the validator registrations received.
+	registrations []*types.SignedValidatorRegistration
+	// blindedBlocks are the blinded blocks received.
+	blindedBlocks []*types.SignedBlindedBeaconBlockDeneb
+}
+
+// ServerOption is a functional option for the Server.
+type ServerOption func(*Server) error
+
+// WithFault sets the initial fault of the relay.
+func WithFault(fault Fault) ServerOption {
+	return func(s *Server) error {
+		s.script.Set(fault)
+		return nil
+	}
+}
+
+// NewServer creates a new relay signing its bids with the given signer.
+func NewServer(
+	chainSpec common.ChainSpec,
+	signer crypto.BLSSigner,
+	opts ...ServerOption,
+) (*Server, error) {
+	s := &Server{
+		chainSpec: chainSpec,
+		signer:    signer,
+	}
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Start starts serving on the given address. An address with port 0
+// picks a free port, see URL.
+func (s *Server) Start(addr string) error {
+	return s.Serve(addr, s.routes())
+}
+
+// SetFault scripts the behaviour of the relay for subsequent requests.
+func (s *Server) SetFault(fault Fault) {
+	s.script.Set(fault)
+}
+
+// SetPayload sets the payload the relay bids with, along with its blobs
+// and the value of the bid.
+func (s *Server) SetPayload(
+	payload *types.ExecutableDataDeneb,
+	bundle *BlobsBundle,
+	value math.Wei,
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.payload = payload
+	s.bundle = bundle
+	s.value = value
+}
+
+// Registrations returns the validator registrations received so far.
+func (s *Server) Registrations() []*types.SignedValidatorRegistration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append(
+		[]*types.SignedValidatorRegistration(nil), s.registrations...,
+	)
+}
+
+// BlindedBlocks returns the blinded blocks received so far.
+func (s *Server) BlindedBlocks() []*types.SignedBlindedBeaconBlockDeneb {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append(
+		[]*types.SignedBlindedBeaconBlockDeneb(nil), s.blindedBlocks...,
+	)
+}
+
+// routes returns the handler of the builder API.
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+relay.StatusPath, s.handleStatus)
+	mux.HandleFunc("POST "+relay.RegisterValidatorPath, s.handleRegister)
+	mux.HandleFunc(
+		"GET "+relay.GetHeaderPath+"/{slot}/{parent_hash}/{pubkey}",
+		s.handleGetHeader,
+	)
+	mux.HandleFunc("POST "+relay.GetPayloadPath, s.handleGetPayload)
+	return s.script.Middleware(mux, FaultOffline, FaultError)
+}
+
+// handleStatus reports the relay as up.
+func (s *Server) handleStatus(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+// handleRegister records the validator registrations.
+func (s *Server) handleRegister(w http.ResponseWriter, r *http.Request) {
+	var registrations []*types.SignedValidatorRegistration
+	if err := json.NewDecoder(r.Body).Decode(&registrations); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	s.registrations = append(s.registrations, registrations...)
+	s.mu.Unlock()
+	w.WriteHeader(http.StatusOK)
+}
+
+// handleGetHeader bids with the scripted payload.
+func (s *Server) handleGetHeader(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.script.Fault() == FaultNoBid || s.payload == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	bid, err := s.signBid()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s.bid = bid.Message
+	writeJSON(w, bid)
+}
+
+// handleGetPayload reveals the scripted payload in exchange for a blinded
+// block committing to the last bid.
+func (s *Server) handleGetPayload(w http.ResponseWriter, r *http.Request) {
+	var blk types.SignedBlindedBeaconBlockDeneb
+	if err := json.NewDecoder(r.Body).Decode(&blk); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blindedBlocks = append(s.blindedBlocks, &blk)
+	if err := s.matchesBid(&blk); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	payload := s.payload
+	if s.script.Fault() == FaultWrongPayload {
+		wrong := *payload
+		wrong.BlockHash = common.ExecutionHash{0xba, 0xd}
+		payload = &wrong
+	}
+	writeJSON(w, &types.ExecutionPayloadAndBlobsBundle{
+		ExecutionPayload: payload,
+		BlobsBundle:      s.bundle,
+	})
+}
+
+// signBid builds and signs the bid for the scripted payload.
+func (s *Server) signBid() (*types.SignedBuilderBid, error) {
+	header, err := (&types.ExecutionPayload{
+		InnerExecutionPayload: s.payload,
+	}).ToHeader()
+	if err != nil {
+		return nil, err
+	}
+	denebHeader, ok := header.
+		InnerExecutionPayloadHeader.(*types.ExecutionPayloadHeaderDeneb)
+	if !ok {
+		return nil, types.ErrForkVersionNotSupported
+	}
+
+	bid := &types.BuilderBid{
+		Header: denebHeader,
+		Value:  s.value,
+		Pubkey: s.signer.PublicKey(),
+	}
+	if s.bundle != nil {
+		bid.BlobKzgCommitments = s.bundle.GetCommitments()
+	}
+
+	domainType := s.chainSpec.DomainTypeApplicationMask()
+	if s.script.Fault() == FaultBadSignature {
+		domainType = s.chainSpec.DomainTypeProposer()
+	}
+	domain, err := types.NewForkData(
+		version.FromUint32[common.Version](
+			s.chainSpec.ActiveForkVersionForSlot(0),
+		), common.Root{},
+	).ComputeDomain(domainType)
+	if err != nil {
+		return nil, err
+	}
+	signingRoot, err := ssz.ComputeSigningRoot(bid, domain)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := s.signer.Sign(signingRoot[:])
+	if err != nil {
+		return nil, err
+	}
+	return &types.SignedBuilderBid{Message: bid, Signature: signature}, nil
+}
+
+// matchesBid checks that the blinded block commits to the header of the
+// last bid.
+func (s *Server) matchesBid(blk *types.SignedBlindedBeaconBlockDeneb) error {
+	if s.bid == nil || s.payload == nil {
+		return ErrNoPayload
+	}
+	if blk.Message == nil || blk.Message.Body == nil ||
+		blk.Message.Body.ExecutionPayloadHeader == nil {
+		return ErrUnknownHeader
+	}
+
+	want, err := s.bid.Header.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	got, err := blk.Message.Body.ExecutionPayloadHeader.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if want != got {
+		return errors.Wrapf(
+			ErrUnknownHeader, "header root %x, bid %x", got, want,
+		)
+	}
+	return nil
+}
+
+// writeJSON writes data in a Deneb versioned response.
+func writeJSON[T any](w http.ResponseWriter, data T) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(relay.VersionedResponse[T]{
+		Version: denebVersion,
+		Data:    data,
+	})
+}
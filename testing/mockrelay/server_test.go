@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockrelay_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/proposer"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/testing/mockrelay"
+	"github.com/berachain/beacon-kit/testing/mockserver"
+	"github.com/stretchr/testify/require"
+)
+
+var parentHash = common.ExecutionHash{0x01}
+
+// wei returns the given amount of wei.
+func wei(amount uint64) math.Wei {
+	return math.MustNewU256LFromBigInt(new(big.Int).SetUint64(amount))
+}
+
+// newSigner returns the BLS signer of the given secret scalar.
+func newSigner(t *testing.T, scalar byte) *signer.LegacySigner {
+	t.Helper()
+	s, err := signer.NewLegacySigner(signer.LegacyKey{31: scalar})
+	require.NoError(t, err)
+	return s
+}
+
+// newPayload returns a payload on top of parentHash with the given block
+// hash.
+func newPayload(blockHash common.ExecutionHash) *types.ExecutableDataDeneb {
+	return &types.ExecutableDataDeneb{
+		ParentHash:   parentHash,
+		Random:       common.Bytes32{0x02},
+		BlockHash:    blockHash,
+		LogsBloom:    make([]byte, 256),
+		ExtraData:    []byte{},
+		Transactions: [][]byte{blockHash[:1]},
+		Withdrawals:  []*engineprimitives.Withdrawal{},
+	}
+}
+
+// newBlock returns a block for the given slot carrying a local payload.
+func newBlock(slot math.Slot) *types.BeaconBlock {
+	return &types.BeaconBlock{RawBeaconBlock: &types.BeaconBlockDeneb{
+		BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{Slot: slot.Unwrap()},
+		Body: &types.BeaconBlockBodyDeneb{
+			ExecutionPayload:   newPayload(common.ExecutionHash{0x0a}),
+			BlobKzgCommitments: []eip4844.KZGCommitment{},
+		},
+	}}
+}
+
+// setup starts a relay bidding 2 wei and a builder talking to it.
+func setup(
+	t *testing.T,
+) (*mockrelay.Server, *proposer.Store, *relay.Builder) {
+	t.Helper()
+	cs := spec.DevnetChainSpec()
+
+	server, err := mockrelay.NewServer(cs, newSigner(t, 1))
+	require.NoError(t, err)
+	mockserver.StartForTest(t, server)
+	server.SetPayload(
+		newPayload(common.ExecutionHash{0x0b}),
+		&mockrelay.BlobsBundle{
+			Commitments: []eip4844.KZGCommitment{{0x03}},
+			Proofs:      []eip4844.KZGProof{{0x04}},
+			Blobs:       []*eip4844.Blob{{0x05}},
+		},
+		wei(2),
+	)
+
+	cfg := relay.DefaultConfig()
+	cfg.Enabled = true
+	cfg.URL = server.URL()
+	cfg.Timeout = 200 * time.Millisecond
+	cfg.FallbackSlots = 4
+	proposerConfig, err := proposer.NewStore(
+		"", proposer.Settings{
+			FeeRecipient: common.ExecutionAddress{0x06},
+			GasLimit:     math.U64(cfg.GasLimit),
+		}, noop.NewLogger(),
+	)
+	require.NoError(t, err)
+	builder, err := relay.NewBuilder(
+		&cfg, cs, noop.NewLogger(), newSigner(t, 2), proposerConfig,
+	)
+	require.NoError(t, err)
+	return server, proposerConfig, builder
+}
+
+func retrieve(
+	builder *relay.Builder,
+	slot math.Slot,
+	minValue uint64,
+) (engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload], error) {
+	return builder.RetrievePayload(
+		context.Background(), newBlock(slot), parentHash,
+		common.Root{0x07}, wei(minValue),
+	)
+}
+
+func TestBuilder_RetrievePayload(t *testing.T) {
+	server, proposerConfig, builder := setup(t)
+
+	envelope, err := retrieve(builder, 1, 1)
+	require.NoError(t, err)
+	require.Equal(
+		t, common.ExecutionHash{0x0b},
+		envelope.GetExecutionPayload().GetBlockHash(),
+	)
+	require.Equal(t, wei(2), envelope.GetValue())
+	require.Equal(
+		t, []eip4844.KZGCommitment{{0x03}},
+		envelope.GetBlobsBundle().GetCommitments(),
+	)
+
+	// The validator registered once and signed the blinded block.
+	registrations := server.Registrations()
+	require.Len(t, registrations, 1)
+	require.Equal(
+		t, common.ExecutionAddress{0x06},
+		registrations[0].Message.FeeRecipient,
+	)
+	blinded := server.BlindedBlocks()
+	require.Len(t, blinded, 1)
+	require.Equal(t, math.Slot(1), blinded[0].Message.GetSlot())
+
+	// Registrations are not repeated within an epoch, unless the fee
+	// recipient or gas limit changes.
+	_, err = retrieve(builder, 2, 1)
+	require.NoError(t, err)
+	require.Len(t, server.Registrations(), 1)
+
+	pubkey := newSigner(t, 2).PublicKey()
+	require.NoError(t, proposerConfig.SetGasLimit(pubkey, 36_000_000))
+	_, err = retrieve(builder, 3, 1)
+	require.NoError(t, err)
+	registrations = server.Registrations()
+	require.Len(t, registrations, 2)
+	require.Equal(
+		t, math.U64(36_000_000), registrations[1].Message.GasLimit,
+	)
+}
+
+func TestBuilder_RejectsBids(t *testing.T) {
+	server, _, builder := setup(t)
+
+	_, err := retrieve(builder, 1, 2)
+	require.ErrorIs(t, err, relay.ErrBidTooLow)
+
+	server.SetFault(mockrelay.FaultNoBid)
+	_, err = retrieve(builder, 1, 1)
+	require.ErrorIs(t, err, relay.ErrNoBid)
+
+	server.SetFault(mockrelay.FaultBadSignature)
+	_, err = retrieve(builder, 1, 1)
+	require.ErrorIs(t, err, relay.ErrInvalidBid)
+
+	server.SetFault(mockrelay.FaultWrongPayload)
+	_, err = retrieve(builder, 1, 1)
+	require.ErrorIs(t, err, relay.ErrInvalidPayload)
+}
+
+func TestBuilder_CircuitBreaker(t *testing.T) {
+	server, _, builder := setup(t)
+
+	// Every fault up to the threshold is reported as is.
+	server.SetFault(mockrelay.FaultOffline)
+	for range 2 {
+		_, err := retrieve(builder, 1, 1)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	}
+	server.SetFault(mockrelay.FaultError)
+	_, err := retrieve(builder, 1, 1)
+	require.ErrorIs(t, err, relay.ErrUnexpectedStatus)
+	require.True(t, builder.Breaker().IsOpen())
+
+	// The relay is cut off for the fallback slots, even once it recovers.
+	server.SetFault(mockrelay.FaultNone)
+	_, err = retrieve(builder, 4, 1)
+	require.ErrorIs(t, err, relay.ErrCircuitOpen)
+
+	_, err = retrieve(builder, 5, 1)
+	require.NoError(t, err)
+	require.False(t, builder.Breaker().IsOpen())
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockserver
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// FaultNames names the faults of a mock, indexed by fault.
+type FaultNames[F ~uint8] []string
+
+// Name returns the name of the fault.
+func (n FaultNames[F]) Name(f F) string {
+	if int(f) >= len(n) {
+		return "unknown"
+	}
+	return n[f]
+}
+
+// Parse returns the fault with the given name.
+func (n FaultNames[F]) Parse(name string) (F, error) {
+	for i, candidate := range n {
+		if candidate == name {
+			//#nosec:G701 // a mock has far fewer than 256 faults.
+			return F(i), nil
+		}
+	}
+	return 0, errors.Wrapf(ErrUnknownFault, "%q", name)
+}
+
+// Script holds the scripted misbehaviour of a mock: the fault it is set
+// to and the number of upcoming requests failing before it recovers.
+type Script[F comparable] struct {
+	mu       sync.Mutex
+	fault    F
+	failures uint64
+}
+
+// Set scripts the fault for subsequent requests.
+func (s *Script[F]) Set(fault F) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fault = fault
+}
+
+// Fault returns the scripted fault.
+func (s *Script[F]) Fault() F {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.fault
+}
+
+// FailNext makes the next n requests fail with an internal server error.
+func (s *Script[F]) FailNext(n uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = n
+}
+
+// Middleware applies the faults that hit every endpoint alike. While
+// scripted offline requests are held until the client gives up, and while
+// scripted to fail, or with failures pending, they fail with an internal
+// server error.
+func (s *Script[F]) Middleware(
+	next http.Handler,
+	offline, failure F,
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		fault := s.fault
+		failing := s.failures > 0
+		if failing {
+			s.failures--
+		}
+		s.mu.Unlock()
+		switch {
+		case fault == offline:
+			Hang(r)
+		case fault == failure || failing:
+			http.Error(
+				w, "scripted fault", http.StatusInternalServerError,
+			)
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockserver_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/testing/mockserver"
+	"github.com/stretchr/testify/require"
+)
+
+type fault uint8
+
+const (
+	faultNone fault = iota
+	faultError
+	faultOffline
+)
+
+var names = mockserver.FaultNames[fault]{"none", "error", "offline"}
+
+func TestFaultNames(t *testing.T) {
+	for _, f := range []fault{faultNone, faultError, faultOffline} {
+		parsed, err := names.Parse(names.Name(f))
+		require.NoError(t, err)
+		require.Equal(t, f, parsed)
+	}
+	require.Equal(t, "unknown", names.Name(fault(7)))
+
+	_, err := names.Parse("flaky")
+	require.ErrorIs(t, err, mockserver.ErrUnknownFault)
+}
+
+// serve sends a request through the middleware of the script and returns
+// the response status.
+func serve(
+	ctx context.Context,
+	script *mockserver.Script[fault],
+) int {
+	handler := script.Middleware(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}),
+		faultOffline, faultError,
+	)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(
+		rec, httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx),
+	)
+	return rec.Code
+}
+
+func TestScript_Middleware(t *testing.T) {
+	var script mockserver.Script[fault]
+	require.Equal(t, http.StatusNoContent, serve(context.Background(), &script))
+
+	script.FailNext(2)
+	for range 2 {
+		require.Equal(
+			t, http.StatusInternalServerError,
+			serve(context.Background(), &script),
+		)
+	}
+	require.Equal(t, http.StatusNoContent, serve(context.Background(), &script))
+
+	script.Set(faultError)
+	require.Equal(t, faultError, script.Fault())
+	require.Equal(
+		t, http.StatusInternalServerError,
+		serve(context.Background(), &script),
+	)
+
+	// Offline requests are held until the client gives up.
+	script.Set(faultOffline)
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 20*time.Millisecond,
+	)
+	defer cancel()
+	start := time.Now()
+	serve(ctx, &script)
+	require.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+}
+
+func TestSleep(t *testing.T) {
+	require.True(t, mockserver.Sleep(context.Background(), time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.False(t, mockserver.Sleep(ctx, time.Minute))
+}
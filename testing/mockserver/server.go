@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package mockserver holds the plumbing shared by the mock servers that
+// stand in for external services in tests: serving over HTTP and scripting
+// faults.
+package mockserver
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+)
+
+// readHeaderTimeout bounds the time spent reading request headers.
+const readHeaderTimeout = 5 * time.Second
+
+// HTTP serves the handler of a mock over HTTP.
+type HTTP struct {
+	http     *http.Server
+	listener net.Listener
+}
+
+// Serve starts serving the handler on the given address. An address with
+// port 0 picks a free port, see URL.
+func (s *HTTP) Serve(addr string, handler http.Handler) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	s.listener = listener
+	s.http = &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() { _ = s.http.Serve(listener) }()
+	return nil
+}
+
+// Stop stops the server and drops every open connection.
+func (s *HTTP) Stop() error {
+	if s.http == nil {
+		return nil
+	}
+	return s.http.Close()
+}
+
+// URL returns the HTTP URL the server listens on.
+func (s *HTTP) URL() string {
+	return "http://" + s.listener.Addr().String()
+}
+
+// Hang holds the request until the client gives up, as if the service
+// had stopped responding.
+func Hang(r *http.Request) {
+	<-r.Context().Done()
+}
+
+// Sleep waits for d and reports whether the context outlived it.
+func Sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mocksigner
+
+import "github.com/berachain/beacon-kit/testing/mockserver"
+
+// Fault is a scripted misbehaviour of the mock signer.
+type Fault uint8
+
+const (
+	// FaultNone makes the signer behave honestly.
+	FaultNone Fault = iota
+	// FaultError makes every request fail with an internal server error.
+	FaultError
+	// FaultRefuse makes the signer refuse every signing request, as it
+	// does when a request violates its slashing protection.
+	FaultRefuse
+	// FaultBadSignature makes the signer sign something else than the
+	// signing root of the request.
+	FaultBadSignature
+	// FaultOffline makes every request hang until the client gives up.
+	FaultOffline
+)
+
+// faultNames are the names of the faults, indexed by fault.
+var faultNames = mockserver.FaultNames[Fault]{
+	"none", "error", "refuse", "bad-signature", "offline",
+}
+
+// String returns the name of the fault.
+func (f Fault) String() string {
+	return faultNames.Name(f)
+}
+
+// ParseFault returns the fault with the given name.
+func ParseFault(name string) (Fault, error) {
+	return faultNames.Parse(name)
+}
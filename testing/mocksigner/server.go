@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mocksigner
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/beacon/remotesigner"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/testing/mockserver"
+)
+
+// Server serves the eth2 signing API of Web3Signer with keys held in
+// memory. It stands in for a remote signer so that the remote signer
+// client can be exercised end to end without running Web3Signer.
+type Server struct {
+	mockserver.HTTP
+
+	signers map[crypto.BLSPubkey]crypto.BLSSigner
+	// script is the scripted misbehaviour of the signer.
+	script mockserver.Script[Fault]
+
+	mu sync.Mutex
+	// requests are the signing requests received.
+	requests []*remotesigner.SignBody
+}
+
+// ServerOption is a functional option for the Server.
+type ServerOption func(*Server) error
+
+// WithFault sets the initial fault of the signer.
+func WithFault(fault Fault) ServerOption {
+	return func(s *Server) error {
+		s.script.Set(fault)
+		return nil
+	}
+}
+
+// NewServer creates a new signer holding the keys of the given signers.
+func NewServer(
+	signers []crypto.BLSSigner,
+	opts ...ServerOption,
+) (*Server, error) {
+	s := &Server{
+		signers: make(map[crypto.BLSPubkey]crypto.BLSSigner, len(signers)),
+	}
+	for _, signer := range signers {
+		s.signers[signer.PublicKey()] = signer
+	}
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Start starts serving on the given address. An address with port 0
+// picks a free port, see URL.
+func (s *Server) Start(addr string) error {
+	return s.Serve(addr, s.Handler())
+}
+
+// SetFault scripts the behaviour of the signer for subsequent requests.
+func (s *Server) SetFault(fault Fault) {
+	s.script.Set(fault)
+}
+
+// FailNext makes the next n requests fail with an internal server error.
+func (s *Server) FailNext(n uint64) {
+	s.script.FailNext(n)
+}
+
+// Requests returns the signing requests received so far.
+func (s *Server) Requests() []*remotesigner.SignBody {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*remotesigner.SignBody(nil), s.requests...)
+}
+
+// Handler returns the handler of the signing API, for it to be served
+// by other means than Start, such as over TLS.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+remotesigner.UpcheckPath, s.handleUpcheck)
+	mux.HandleFunc("GET "+remotesigner.PublicKeysPath, s.handlePublicKeys)
+	mux.HandleFunc("POST "+remotesigner.SignPath+"/{pubkey}", s.handleSign)
+	return s.script.Middleware(mux, FaultOffline, FaultError)
+}
+
+// handleUpcheck reports the signer as up.
+func (s *Server) handleUpcheck(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+// handlePublicKeys lists the public keys held by the signer.
+func (s *Server) handlePublicKeys(w http.ResponseWriter, _ *http.Request) {
+	pubkeys := make([]crypto.BLSPubkey, 0, len(s.signers))
+	for pubkey := range s.signers {
+		pubkeys = append(pubkeys, pubkey)
+	}
+	writeJSON(w, pubkeys)
+}
+
+// handleSign records the signing request and signs its signing root.
+func (s *Server) handleSign(w http.ResponseWriter, r *http.Request) {
+	var pubkey crypto.BLSPubkey
+	if err := pubkey.UnmarshalText([]byte(r.PathValue("pubkey"))); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	signer, ok := s.signers[pubkey]
+	if !ok {
+		http.Error(w, "unknown public key", http.StatusNotFound)
+		return
+	}
+
+	var body remotesigner.SignBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, &body)
+	s.mu.Unlock()
+
+	root := body.SigningRoot
+	switch s.script.Fault() {
+	case FaultRefuse:
+		http.Error(w, "signing refused", http.StatusPreconditionFailed)
+		return
+	case FaultBadSignature:
+		root[0] ^= 0xff
+	default:
+	}
+
+	sig, err := signer.Sign(root[:])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, remotesigner.SignResponse{Signature: sig})
+}
+
+// writeJSON writes data as a JSON response.
+func writeJSON[T any](w http.ResponseWriter, data T) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(data)
+}
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mocksigner_test
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/beacon/remotesigner"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/testing/mockserver"
+	"github.com/berachain/beacon-kit/testing/mocksigner"
+	"github.com/stretchr/testify/require"
+)
+
+// newSigner returns the BLS signer of the given secret scalar.
+func newSigner(t *testing.T, scalar byte) *signer.LegacySigner {
+	t.Helper()
+	s, err := signer.NewLegacySigner(signer.LegacyKey{31: scalar})
+	require.NoError(t, err)
+	return s
+}
+
+// newRequest returns a block signing request for the given slot.
+func newRequest(slot uint64) *crypto.SigningRequest {
+	return &crypto.SigningRequest{
+		Type:        crypto.SigningTypeBlock,
+		SigningRoot: bytes.B32{byte(slot), 0x01},
+		ForkInfo: crypto.NewForkInfo(
+			version.FromUint32[bytes.B4](version.Deneb), 0, bytes.B32{0x02},
+		),
+		Block: &crypto.BlockHeaderData{
+			Slot:          slot,
+			ProposerIndex: 3,
+			ParentRoot:    bytes.B32{0x04},
+			StateRoot:     bytes.B32{0x05},
+			BodyRoot:      bytes.B32{0x06},
+		},
+	}
+}
+
+// setup starts a signer holding the keys of the given signers and returns
+// the configuration of a remote signer talking to it.
+func setup(
+	t *testing.T,
+	signers ...crypto.BLSSigner,
+) (*mocksigner.Server, remotesigner.Config) {
+	t.Helper()
+	server, err := mocksigner.NewServer(signers)
+	require.NoError(t, err)
+	mockserver.StartForTest(t, server)
+
+	cfg := remotesigner.DefaultConfig()
+	cfg.Enabled = true
+	cfg.URL = server.URL()
+	cfg.Timeout = 200 * time.Millisecond
+	cfg.RetryInterval = time.Millisecond
+	return server, cfg
+}
+
+func TestSignRequest(t *testing.T) {
+	local := newSigner(t, 1)
+	server, cfg := setup(t, local)
+
+	remote, err := remotesigner.New(cfg)
+	require.NoError(t, err)
+	require.Equal(t, local.PublicKey(), remote.PublicKey())
+
+	req := newRequest(7)
+	sig, err := crypto.SignRequest(remote, req)
+	require.NoError(t, err)
+	require.NoError(t, local.VerifySignature(
+		local.PublicKey(), req.SigningRoot[:], sig,
+	))
+
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, crypto.SigningTypeBlock, requests[0].Type)
+	require.Equal(t, req.SigningRoot, requests[0].SigningRoot)
+	require.NotNil(t, requests[0].ForkInfo)
+	require.Equal(t, "DENEB", requests[0].BeaconBlock.Version)
+	require.Equal(t, uint64(7), requests[0].BeaconBlock.BlockHeader.Slot)
+
+	_, err = remote.Sign(req.SigningRoot[:])
+	require.ErrorIs(t, err, remotesigner.ErrUntypedSigningRequest)
+}
+
+func TestPublicKeyResolution(t *testing.T) {
+	first, second := newSigner(t, 1), newSigner(t, 2)
+	_, cfg := setup(t, first, second)
+
+	_, err := remotesigner.New(cfg)
+	require.ErrorIs(t, err, remotesigner.ErrAmbiguousPublicKey)
+
+	cfg.PublicKey = second.PublicKey().String()
+	remote, err := remotesigner.New(cfg)
+	require.NoError(t, err)
+	require.Equal(t, second.PublicKey(), remote.PublicKey())
+
+	cfg.PublicKey = newSigner(t, 3).PublicKey().String()
+	remote, err = remotesigner.New(cfg)
+	require.NoError(t, err)
+	_, err = remote.PublicKeys(context.Background())
+	require.ErrorIs(t, err, remotesigner.ErrPublicKeyNotFound)
+}
+
+func TestRetries(t *testing.T) {
+	server, cfg := setup(t, newSigner(t, 1))
+	cfg.MaxRetries = 2
+	remote, err := remotesigner.New(cfg)
+	require.NoError(t, err)
+
+	// Server errors are retried up to MaxRetries times.
+	server.FailNext(2)
+	_, err = remote.SignRequest(newRequest(1))
+	require.NoError(t, err)
+
+	server.FailNext(3)
+	_, err = remote.SignRequest(newRequest(2))
+	require.ErrorIs(t, err, remotesigner.ErrUnexpectedStatus)
+
+	// Refusals are final.
+	server.SetFault(mocksigner.FaultRefuse)
+	before := len(server.Requests())
+	_, err = remote.SignRequest(newRequest(3))
+	require.ErrorIs(t, err, remotesigner.ErrUnexpectedStatus)
+	require.Len(t, server.Requests(), before+1)
+}
+
+func TestFaults(t *testing.T) {
+	server, cfg := setup(t, newSigner(t, 1))
+	cfg.Timeout = 50 * time.Millisecond
+	cfg.MaxRetries = 1
+	remote, err := remotesigner.New(cfg)
+	require.NoError(t, err)
+
+	server.SetFault(mocksigner.FaultBadSignature)
+	_, err = remote.SignRequest(newRequest(1))
+	require.ErrorIs(t, err, remotesigner.ErrInvalidSignature)
+
+	server.SetFault(mocksigner.FaultOffline)
+	start := time.Now()
+	_, err = remote.SignRequest(newRequest(2))
+	require.Error(t, err)
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestTLS(t *testing.T) {
+	local := newSigner(t, 1)
+	server, err := mocksigner.NewServer([]crypto.BLSSigner{local})
+	require.NoError(t, err)
+	ts := httptest.NewTLSServer(server.Handler())
+	t.Cleanup(ts.Close)
+
+	cfg := remotesigner.DefaultConfig()
+	cfg.URL = ts.URL
+	cfg.MaxRetries = 0
+
+	// The certificate of the signer is not trusted by default.
+	_, err = remotesigner.New(cfg)
+	require.Error(t, err)
+
+	cfg.CACertFile = filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(cfg.CACertFile, pem.EncodeToMemory(
+		&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw},
+	), 0o600))
+	remote, err := remotesigner.New(cfg)
+	require.NoError(t, err)
+	_, err = remote.SignRequest(newRequest(1))
+	require.NoError(t, err)
+}
@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rollkit_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/genesis"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	nodebuilder "github.com/berachain/beacon-kit/mod/node-core/pkg/builder"
+	nodecomponents "github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	beacon "github.com/berachain/beacon-kit/mod/node-core/pkg/components/module"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/node-rollkit/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/node-rollkit/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/testing/mockengine"
+	"github.com/berachain/beacon-kit/testing/mockserver"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// trustedSetupPath is the location of the KZG trusted setup, relative
+	// to the package.
+	trustedSetupPath = "../files/kzg-trusted-setup.json"
+	// numBlocks is the number of blocks the node must produce, more than
+	// the post block tasks the chain service queues.
+	numBlocks = 12
+)
+
+// validatorKey is the key of the single validator of the chain.
+//
+//nolint:gochecknoglobals // test key.
+var validatorKey = signer.LegacyKey{31: 1}
+
+// ProvideValidatorKey provides the key of the validator to the node, in
+// place of the privval key file.
+func ProvideValidatorKey() nodecomponents.LegacyKey {
+	return validatorKey
+}
+
+// TestNode_ProducesBlocks runs a rollkit node against a mock execution
+// client and checks that its head keeps advancing.
+func TestNode_ProducesBlocks(t *testing.T) {
+	t.Setenv(
+		nodecomponents.ChainSpecTypeEnvVar,
+		nodecomponents.DevnetChainSpecType,
+	)
+	cs := spec.DevnetChainSpec()
+	home := t.TempDir()
+
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	jwtPath := filepath.Join(home, "jwt.hex")
+	require.NoError(t, os.WriteFile(jwtPath, []byte(secret.Hex()), 0o600))
+
+	gen := genesis.DefaultGenesisDeneb()
+	chain := mockengine.NewChain(
+		mockengine.DefaultConfig(),
+		gen.ExecutionPayloadHeader,
+		mockengine.NewMempool(),
+	)
+	engine, err := mockengine.NewServer(
+		chain, secret,
+		mockengine.WithChainID(cs.DepositEth1ChainID()),
+		mockengine.WithDepositContract(cs.DepositContractAddress()),
+	)
+	require.NoError(t, err)
+	mockserver.StartForTest(t, engine)
+
+	blsSigner, err := signer.NewLegacySigner(validatorKey)
+	require.NoError(t, err)
+	msg, signature, err := types.CreateAndSignDepositMessage(
+		types.NewForkData(gen.ForkVersion, common.Root{}),
+		cs.DomainTypeDeposit(),
+		blsSigner,
+		types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{}),
+		math.Gwei(cs.MaxEffectiveBalance()),
+	)
+	require.NoError(t, err)
+	gen.Deposits = append(gen.Deposits, types.NewDeposit(
+		msg.Pubkey, msg.Credentials, msg.Amount, signature, 0,
+	))
+	writeGenesis(t, home, gen)
+
+	nb := builder.New(
+		builder.WithDepInjectConfig(nodebuilder.DefaultDepInjectConfig()),
+		builder.WithComponents(append(
+			components.DefaultComponents(),
+			ProvideValidatorKey,
+		)),
+	)
+	n, err := nb.Build(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		newAppOpts(t, home, engine.URL(), jwtPath),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, n.Close()) })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, n.Start(ctx))
+
+	//#nosec:G701 // the chain spec duration will never overflow an int64.
+	slot := time.Duration(cs.TargetSecondsPerEth1Block()) * time.Second
+	require.Eventually(t, func() bool {
+		_, head := chain.Head()
+		return head >= numBlocks
+	}, 2*numBlocks*slot, slot/10)
+}
+
+// writeGenesis writes the genesis file holding the given beacon genesis.
+func writeGenesis(
+	t *testing.T,
+	home string,
+	gen *genesis.Genesis[*types.Deposit, *types.ExecutionPayloadHeader],
+) {
+	t.Helper()
+	bz, err := json.Marshal(gen)
+	require.NoError(t, err)
+	appState, err := json.Marshal(map[string]json.RawMessage{
+		beacon.ModuleName: bz,
+	})
+	require.NoError(t, err)
+
+	appGenesis := genutiltypes.NewAppGenesisWithVersion(
+		"rollkit-test", appState,
+	)
+	appGenesis.GenesisTime = time.Now()
+	require.NoError(t, appGenesis.ValidateAndComplete())
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o700))
+	require.NoError(t, appGenesis.SaveAs(
+		filepath.Join(home, "config", "genesis.json"),
+	))
+}
+
+// newAppOpts writes the default configuration files of a node to the
+// given home, pointing it at the given execution client, and returns the
+// options read from them.
+func newAppOpts(
+	t *testing.T,
+	home, engineURL, jwtPath string,
+) *viper.Viper {
+	t.Helper()
+	cmtConfig := nodebuilder.DefaultCometConfig()
+	cmtConfig.SetRoot(home)
+	cmtConfigPath := filepath.Join(home, "config", "config.toml")
+	cmtcfg.WriteConfigFile(cmtConfigPath, cmtConfig)
+
+	appConfigPath := filepath.Join(home, "config", "app.toml")
+	serverconfig.SetConfigTemplate(nodebuilder.DefaultAppConfigTemplate())
+	serverconfig.WriteConfigFile(appConfigPath, nodebuilder.DefaultAppConfig())
+
+	setupPath, err := filepath.Abs(trustedSetupPath)
+	require.NoError(t, err)
+
+	v := viper.New()
+	v.SetConfigFile(cmtConfigPath)
+	require.NoError(t, v.ReadInConfig())
+	v.SetConfigFile(appConfigPath)
+	require.NoError(t, v.MergeInConfig())
+	v.Set(flags.FlagHome, home)
+	v.Set("app-db-backend", string(dbm.MemDBBackend))
+	v.Set("beacon-kit.engine.rpc-dial-url", engineURL)
+	v.Set("beacon-kit.engine.jwt-secret-path", jwtPath)
+	v.Set("beacon-kit.kzg.trusted-setup-path", setupPath)
+	return v
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulation
+
+import (
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/testing/mockengine"
+)
+
+const (
+	// defaultNumNodes is the default size of the network.
+	defaultNumNodes = 4
+	// defaultTrustedSetupPath is the default location of the KZG trusted
+	// setup, relative to the simulation package.
+	defaultTrustedSetupPath = "../files/kzg-trusted-setup.json"
+	// defaultPayloadTimeout is the default time the payload builder waits
+	// before fetching a payload it requested synchronously.
+	defaultPayloadTimeout = 10 * time.Millisecond
+	// defaultStepTimeout is the default bound on a single ABCI call.
+	defaultStepTimeout = 30 * time.Second
+	// defaultForkchoiceTimeout is the default time a node waits for the
+	// forkchoice update that follows a finalized block.
+	defaultForkchoiceTimeout = 2 * time.Second
+	// defaultGenesisTime is the default genesis time of the network, in
+	// seconds since the unix epoch.
+	defaultGenesisTime = 1_700_000_000
+)
+
+// Config is the configuration of a simulated network.
+type Config struct {
+	// NumNodes is the number of beacon nodes in the network.
+	NumNodes int
+	// ChainSpec is the chain spec shared by every node.
+	ChainSpec common.ChainSpec
+	// TrustedSetupPath is the path to the KZG trusted setup.
+	TrustedSetupPath string
+	// RootDir is the directory under which nodes keep their blob stores.
+	RootDir string
+	// Engine is the configuration of the mock execution clients.
+	Engine mockengine.Config
+	// PayloadTimeout is the payload builder timeout used by every node.
+	PayloadTimeout time.Duration
+	// StepTimeout bounds a single ABCI call on a single node.
+	StepTimeout time.Duration
+	// ForkchoiceTimeout bounds the wait for the forkchoice update that
+	// follows a finalized block.
+	ForkchoiceTimeout time.Duration
+	// GenesisTime is the genesis time of the network. The block at a
+	// height is timed one slot duration after the block before it.
+	GenesisTime time.Time
+	// Logger is the logger handed to every node.
+	Logger log.Logger
+}
+
+// DefaultConfig returns the default configuration of a simulated network.
+func DefaultConfig() Config {
+	return Config{
+		NumNodes:          defaultNumNodes,
+		ChainSpec:         spec.DevnetChainSpec(),
+		TrustedSetupPath:  defaultTrustedSetupPath,
+		Engine:            mockengine.DefaultConfig(),
+		PayloadTimeout:    defaultPayloadTimeout,
+		StepTimeout:       defaultStepTimeout,
+		ForkchoiceTimeout: defaultForkchoiceTimeout,
+		GenesisTime:       time.Unix(defaultGenesisTime, 0),
+		Logger:            log.NewNopLogger(),
+	}
+}
+
+// Option is a functional option for the simulated network.
+type Option func(*Config) error
+
+// WithNumNodes sets the number of beacon nodes in the network.
+func WithNumNodes(n int) Option {
+	return func(cfg *Config) error {
+		if n <= 0 {
+			return ErrInvalidNumNodes
+		}
+		cfg.NumNodes = n
+		return nil
+	}
+}
+
+// WithChainSpec sets the chain spec shared by every node.
+func WithChainSpec(cs common.ChainSpec) Option {
+	return func(cfg *Config) error {
+		cfg.ChainSpec = cs
+		return nil
+	}
+}
+
+// WithTrustedSetupPath sets the path to the KZG trusted setup.
+func WithTrustedSetupPath(path string) Option {
+	return func(cfg *Config) error {
+		cfg.TrustedSetupPath = path
+		return nil
+	}
+}
+
+// WithRootDirectory sets the directory under which nodes keep their data.
+func WithRootDirectory(dir string) Option {
+	return func(cfg *Config) error {
+		cfg.RootDir = dir
+		return nil
+	}
+}
+
+// WithEngineConfig sets the configuration of the mock execution clients.
+func WithEngineConfig(engineCfg mockengine.Config) Option {
+	return func(cfg *Config) error {
+		cfg.Engine = engineCfg
+		return nil
+	}
+}
+
+// WithLogger sets the logger handed to every node.
+func WithLogger(logger log.Logger) Option {
+	return func(cfg *Config) error {
+		cfg.Logger = logger
+		return nil
+	}
+}
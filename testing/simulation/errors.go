@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulation
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidNumNodes is returned when a network is configured without
+	// any nodes.
+	ErrInvalidNumNodes = errors.New("number of nodes must be positive")
+
+	// ErrMissingRootDir is returned when a network is configured without a
+	// root directory.
+	ErrMissingRootDir = errors.New("root directory must be set")
+
+	// ErrNoQuorum is returned when no proposal for a height gathers more
+	// than two thirds of the votes.
+	ErrNoQuorum = errors.New("no proposal reached quorum")
+
+	// ErrNodeOffline is returned when an operation requires a node that is
+	// crashed or halted.
+	ErrNodeOffline = errors.New("node is offline")
+
+	// ErrNodeOnline is returned when restarting a node that is running.
+	ErrNodeOnline = errors.New("node is already online")
+
+	// ErrStateMismatch is returned when two online nodes disagree on the
+	// beacon state.
+	ErrStateMismatch = errors.New("beacon state mismatch between nodes")
+
+	// ErrEmptyBlock is returned when looking up the beacon block of a
+	// height that committed an empty proposal.
+	ErrEmptyBlock = errors.New("height committed an empty proposal")
+)
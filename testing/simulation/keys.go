@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulation
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// keyDomain separates simulation keys from any other derived secret.
+const keyDomain = "beacon-kit/simulation"
+
+// NewSigner returns the BLS signer derived from the given seed. The same
+// seed always yields the same key.
+func NewSigner(seed uint64) (*signer.LegacySigner, error) {
+	var index [8]byte
+	binary.BigEndian.PutUint64(index[:], seed)
+	key := sha256.Sum256(append([]byte(keyDomain), index[:]...))
+	// Clear the top bits so that the scalar is below the curve order.
+	key[0] &= 0x3f
+	return signer.NewLegacySigner(key)
+}
+
+// NewExecutionAddress returns the execution address derived from the
+// given seed, used as fee recipient and withdrawal address.
+func NewExecutionAddress(seed uint64) common.ExecutionAddress {
+	var index [8]byte
+	binary.BigEndian.PutUint64(index[:], seed)
+	digest := sha256.Sum256(append([]byte(keyDomain+"/address"), index[:]...))
+	return common.ExecutionAddress(digest[:20])
+}
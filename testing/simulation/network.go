@@ -0,0 +1,404 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulation
+
+import (
+	"encoding/json"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/genesis"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
+	"github.com/berachain/beacon-kit/testing/mockengine"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+)
+
+// ProposalTamper rewrites the proposal built by a node before the other
+// nodes get to see it.
+type ProposalTamper func(height int64, proposer int, txs [][]byte) [][]byte
+
+// Network is a set of beacon nodes running in a single process. It plays
+// the part of CometBFT: it picks proposers, gathers votes and commits
+// blocks, one height at a time and in a deterministic order.
+type Network struct {
+	cfg     Config
+	nodes   []*Node
+	mempool *mockengine.Mempool
+	blobTxs *mockengine.BlobTxFactory
+
+	// height is the last committed height.
+	height int64
+	// blocks holds the transactions committed at every height.
+	blocks map[int64][][]byte
+	// depositIndex is the index of the next deposit.
+	depositIndex uint64
+	// genesisValidatorsRoot is the root deposits are signed over.
+	genesisValidatorsRoot common.Root
+	// tamper, if set, rewrites every proposal.
+	tamper ProposalTamper
+}
+
+// NewNetwork boots a network whose genesis contains one validator per
+// node, each with the maximum effective balance.
+func NewNetwork(opts ...Option) (*Network, error) {
+	cfg := DefaultConfig()
+	for _, opt := range opts {
+		if err := opt(&cfg); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.RootDir == "" {
+		return nil, ErrMissingRootDir
+	}
+
+	ts, err := components.ReadTrustedSetup(cfg.TrustedSetupPath)
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := kzg.NewBlobProofVerifier(
+		kzg.DefaultConfig().Implementation, ts,
+	)
+	if err != nil {
+		return nil, err
+	}
+	blobTxs, err := mockengine.NewBlobTxFactory(
+		ts, cfg.ChainSpec.DepositEth1ChainID(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	nw := &Network{
+		cfg:     cfg,
+		nodes:   make([]*Node, cfg.NumNodes),
+		mempool: mockengine.NewMempool(),
+		blobTxs: blobTxs,
+		blocks:  make(map[int64][][]byte),
+	}
+
+	gen := genesis.DefaultGenesisDeneb()
+	for i := range nw.nodes {
+		if nw.nodes[i], err = newNode(
+			i, &nw.cfg, verifier, gen.ExecutionPayloadHeader, nw.mempool,
+		); err != nil {
+			return nil, err
+		}
+		var deposit *types.Deposit
+		if deposit, err = nw.newDeposit(
+			nw.nodes[i].Signer(),
+			types.NewCredentialsFromExecutionAddress(
+				NewExecutionAddress(uint64(i)),
+			),
+			math.Gwei(cfg.ChainSpec.MaxEffectiveBalance()),
+			gen.ForkVersion,
+		); err != nil {
+			return nil, err
+		}
+		gen.Deposits = append(gen.Deposits, deposit)
+	}
+
+	bz, err := json.Marshal(gen)
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range nw.nodes {
+		if err = node.start(); err != nil {
+			nw.Stop()
+			return nil, err
+		}
+		if err = node.initGenesis(bz); err != nil {
+			nw.Stop()
+			return nil, err
+		}
+	}
+
+	if nw.genesisValidatorsRoot, err = nw.nodes[0].State().
+		GetGenesisValidatorsRoot(); err != nil {
+		nw.Stop()
+		return nil, err
+	}
+	return nw, nil
+}
+
+// Stop stops the services of every node.
+func (nw *Network) Stop() {
+	for _, node := range nw.nodes {
+		if node != nil {
+			node.stop()
+		}
+	}
+}
+
+// Nodes returns the nodes of the network.
+func (nw *Network) Nodes() []*Node {
+	return nw.nodes
+}
+
+// Node returns the node with the given index.
+func (nw *Network) Node(i int) *Node {
+	return nw.nodes[i]
+}
+
+// Height returns the last committed height.
+func (nw *Network) Height() int64 {
+	return nw.height
+}
+
+// SetProposalTamper installs a function that rewrites every proposal
+// before it is voted on. A nil tamper restores honest proposals.
+func (nw *Network) SetProposalTamper(tamper ProposalTamper) {
+	nw.tamper = tamper
+}
+
+// AdvanceSlots commits the next n heights.
+func (nw *Network) AdvanceSlots(n int) error {
+	for range n {
+		if err := nw.advance(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// advance commits the next height. Proposers are picked round-robin and
+// skipped while offline; a proposal is committed once more than two
+// thirds of the nodes accept it.
+func (nw *Network) advance() error {
+	height := nw.height + 1
+	for round := range nw.nodes {
+		proposer := nw.nodes[(int(height)+round)%len(nw.nodes)]
+		if !proposer.Online() {
+			continue
+		}
+
+		txs, err := proposer.prepareProposal(height)
+		if err != nil {
+			// CometBFT proposes an empty block when the application fails
+			// to prepare one.
+			nw.cfg.Logger.Error(
+				"failed to prepare proposal",
+				"height", height, "node", proposer.index, "error", err,
+			)
+			txs = nil
+		}
+		if nw.tamper != nil {
+			txs = nw.tamper(height, proposer.index, txs)
+		}
+
+		var votes int
+		for _, node := range nw.nodes {
+			if node.Online() && node.processProposal(height, txs) {
+				votes++
+			}
+		}
+		if 3*votes <= 2*len(nw.nodes) {
+			continue
+		}
+
+		nw.commit(height, txs)
+		return nil
+	}
+	return errors.Wrapf(ErrNoQuorum, "height %d", height)
+}
+
+// commit finalizes the given block on every online node.
+func (nw *Network) commit(height int64, txs [][]byte) {
+	nw.blocks[height] = txs
+	nw.height = height
+	for _, node := range nw.nodes {
+		if !node.Online() {
+			continue
+		}
+		if err := node.finalizeBlock(height, txs); err != nil {
+			nw.cfg.Logger.Error("node halted", "error", err)
+		}
+	}
+}
+
+// Crash stops the services of the given node. Its stores and execution
+// client are kept for a later restart.
+func (nw *Network) Crash(i int) {
+	nw.nodes[i].stop()
+}
+
+// Restart restarts the services of a crashed or halted node and replays
+// the blocks it missed.
+func (nw *Network) Restart(i int) error {
+	node := nw.nodes[i]
+	if node.Online() {
+		return ErrNodeOnline
+	}
+
+	node.stop()
+	if err := node.start(); err != nil {
+		return err
+	}
+	for height := node.height + 1; height <= nw.height; height++ {
+		if err := node.finalizeBlock(height, nw.blocks[height]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Block returns the beacon block committed at the given height.
+func (nw *Network) Block(height int64) (*types.BeaconBlock, error) {
+	txs, ok := nw.blocks[height]
+	if !ok || len(txs) == 0 {
+		return nil, errors.Wrapf(ErrEmptyBlock, "height %d", height)
+	}
+	return nw.DecodeBlock(height, txs)
+}
+
+// DecodeBlock decodes the beacon block of a proposal for the given height.
+func (nw *Network) DecodeBlock(
+	height int64,
+	txs [][]byte,
+) (*types.BeaconBlock, error) {
+	return encoding.UnmarshalBeaconBlockFromABCIRequest[*types.BeaconBlock](
+		&cmtabci.FinalizeBlockRequest{Txs: txs},
+		nw.cfg.ChainSpec.ActiveForkVersionForSlot(math.Slot(height)),
+	)
+}
+
+// EncodeBlock encodes the beacon block of a proposal for the given height.
+func (nw *Network) EncodeBlock(
+	height int64,
+	blk *types.BeaconBlock,
+) ([]byte, error) {
+	bz, err := blk.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return encoding.NewEnvelopeAt(
+		nw.cfg.ChainSpec,
+		math.Slot(height),
+		encoding.TxTypeBeaconBlock,
+		bz,
+	).Marshal(), nil
+}
+
+// SubmitDeposit signs a deposit with the given signer and makes it
+// visible to every node, as if it had been emitted by the deposit
+// contract.
+func (nw *Network) SubmitDeposit(
+	depositor crypto.BLSSigner,
+	credentials types.WithdrawalCredentials,
+	amount math.Gwei,
+) (*types.Deposit, error) {
+	cs := nw.cfg.ChainSpec
+	deposit, err := nw.newDeposit(
+		depositor,
+		credentials,
+		amount,
+		version.FromUint32[common.Version](cs.ActiveForkVersionForEpoch(
+			cs.SlotToEpoch(math.Slot(nw.height+1)),
+		)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, node := range nw.nodes {
+		if err = node.depositStore.EnqueueDeposit(deposit); err != nil {
+			return nil, err
+		}
+	}
+	return deposit, nil
+}
+
+// newDeposit signs the next deposit over the given fork version.
+func (nw *Network) newDeposit(
+	depositor crypto.BLSSigner,
+	credentials types.WithdrawalCredentials,
+	amount math.Gwei,
+	forkVersion common.Version,
+) (*types.Deposit, error) {
+	msg, signature, err := types.CreateAndSignDepositMessage(
+		types.NewForkData(forkVersion, nw.genesisValidatorsRoot),
+		nw.cfg.ChainSpec.DomainTypeDeposit(),
+		depositor,
+		credentials,
+		amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	deposit := types.NewDeposit(
+		msg.Pubkey, msg.Credentials, msg.Amount, signature, nw.depositIndex,
+	)
+	nw.depositIndex++
+	return deposit, nil
+}
+
+// SubmitBlobTx adds a transaction carrying numBlobs blobs to the shared
+// execution mempool.
+func (nw *Network) SubmitBlobTx(numBlobs int) (*mockengine.BlobTx, error) {
+	tx, err := nw.blobTxs.New(numBlobs)
+	if err != nil {
+		return nil, err
+	}
+	nw.mempool.Add(tx)
+	return tx, nil
+}
+
+// PendingBlobTxs returns the number of blob transactions waiting in the
+// execution mempool.
+func (nw *Network) PendingBlobTxs() int {
+	return nw.mempool.Len()
+}
+
+// CheckConsistency verifies that every online node at the tip of the
+// network agrees on the beacon state.
+func (nw *Network) CheckConsistency() error {
+	var (
+		want [32]byte
+		seen bool
+	)
+	for _, node := range nw.nodes {
+		if !node.Online() || node.height != nw.height {
+			continue
+		}
+		root, err := node.State().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		if !seen {
+			want, seen = root, true
+			continue
+		}
+		if root != want {
+			return errors.Wrapf(
+				ErrStateMismatch,
+				"node %d has state root %s, expected %s",
+				node.index, common.Root(root), common.Root(want),
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,647 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulation
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	storev2db "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/async/pkg/event"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	"github.com/berachain/beacon-kit/mod/da/pkg/da"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/attributes"
+	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/cache"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/proposer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/clock"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/middleware"
+	rp2p "github.com/berachain/beacon-kit/mod/runtime/pkg/p2p"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/telemetry"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	beaconencoding "github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
+	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/berachain/beacon-kit/testing/mockengine"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const (
+	// probeEvent is the type of the events sent to check that the services
+	// of a node are subscribed to their feeds. Every service ignores it.
+	probeEvent asynctypes.EventID = "simulation.probe"
+	// probeInterval is the time between two probe events.
+	probeInterval = time.Millisecond
+)
+
+// Node is a single beacon node of a simulated network. Its stores and its
+// execution client survive crashes, its services do not.
+type Node struct {
+	index  int
+	cfg    *Config
+	logger log.Logger
+	signer *signer.LegacySigner
+	engine *mockengine.Engine
+
+	verifier          kzg.BlobProofVerifier
+	cms               storetypes.CommitMultiStore
+	storeKey          *storetypes.KVStoreKey
+	depositStore      *components.DepositStore
+	availabilityStore *components.AvailabilityStore
+	backend           components.StorageBackend
+
+	// middleware is the ABCI middleware of the running services, nil
+	// while the node is crashed.
+	middleware *components.ABCIMiddleware
+	// cancel stops the running services.
+	cancel context.CancelFunc
+	// chain is the chain service of the running services, waited for on
+	// stop so that its worker does not outlive them.
+	chain interface{ Wait() }
+	// height is the last height committed by the node.
+	height int64
+	// halted is the error the node halted on, if any.
+	halted error
+
+	// metricsMu protects metricErrs.
+	metricsMu sync.Mutex
+	// metricErrs are the errors of the dropped metric updates.
+	metricErrs []error
+}
+
+// newNode creates the stores, keys and execution client of a node. The
+// services are created by start.
+func newNode(
+	index int,
+	cfg *Config,
+	verifier kzg.BlobProofVerifier,
+	genesis *types.ExecutionPayloadHeader,
+	mempool *mockengine.Mempool,
+) (*Node, error) {
+	//#nosec:G701 // the number of nodes is positive.
+	blsSigner, err := NewSigner(uint64(index))
+	if err != nil {
+		return nil, err
+	}
+
+	logger := cfg.Logger.With("node", index)
+	storeKey := storetypes.NewKVStoreKey("beacon")
+	cms := store.NewCommitMultiStore(
+		dbm.NewMemDB(), logger, storemetrics.NewNoOpMetrics(),
+	)
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	if err = cms.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+
+	depositStore, err := depositstore.NewStore[*types.Deposit](
+		&depositstore.KVStoreProvider{
+			KVStoreWithBatch: storev2db.NewMemDB(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	availabilityStore := dastore.New[*types.BeaconBlockBody](
+		filedb.NewRangeDB(
+			filedb.NewDB(
+				filedb.WithRootDirectory(filepath.Join(
+					cfg.RootDir, "node"+strconv.Itoa(index), "blobs",
+				)),
+				filedb.WithFileExtension("ssz"),
+				filedb.WithDirectoryPermissions(os.ModePerm),
+				filedb.WithLogger(logger),
+			),
+		),
+		filedb.NewDB(
+			filedb.WithRootDirectory(filepath.Join(
+				cfg.RootDir, "node"+strconv.Itoa(index), "blobs-index",
+			)),
+			filedb.WithFileExtension("bin"),
+			filedb.WithDirectoryPermissions(os.ModePerm),
+			filedb.WithLogger(logger),
+		),
+		logger.With("service", "beacon-kit.da.store"),
+		cfg.ChainSpec,
+	)
+	backend := storage.NewBackend[
+		*components.AvailabilityStore,
+		*types.BeaconBlockBody,
+		components.BeaconState,
+		*components.BeaconStateMarshallable,
+		*components.DepositStore,
+	](
+		cfg.ChainSpec,
+		availabilityStore,
+		beacondb.New[
+			*types.BeaconBlockHeader,
+			*types.Eth1Data,
+			*types.ExecutionPayloadHeader,
+			*types.Fork,
+			*types.Validator,
+		](
+			runtime.NewKVStoreService(storeKey),
+			&beaconencoding.SSZInterfaceCodec[*types.ExecutionPayloadHeader]{},
+		),
+		depositStore,
+	)
+
+	return &Node{
+		index:  index,
+		cfg:    cfg,
+		logger: logger,
+		signer: blsSigner,
+		engine: mockengine.NewEngine(
+			mockengine.NewChain(cfg.Engine, genesis, mempool),
+		),
+		verifier:          verifier,
+		cms:               cms,
+		storeKey:          storeKey,
+		depositStore:      depositStore,
+		availabilityStore: availabilityStore,
+		backend:           backend,
+	}, nil
+}
+
+// Index returns the index of the node in the network.
+func (n *Node) Index() int {
+	return n.index
+}
+
+// Signer returns the BLS signer of the validator run by the node.
+func (n *Node) Signer() crypto.BLSSigner {
+	return n.signer
+}
+
+// Engine returns the mock execution client of the node.
+func (n *Node) Engine() *mockengine.Engine {
+	return n.engine
+}
+
+// Height returns the last height committed by the node.
+func (n *Node) Height() int64 {
+	return n.height
+}
+
+// Online reports whether the node is running and has not halted.
+func (n *Node) Online() bool {
+	return n.middleware != nil && n.halted == nil
+}
+
+// MetricErrors returns the errors of the metric updates the node dropped,
+// which are caused by undeclared metrics.
+func (n *Node) MetricErrors() []error {
+	n.metricsMu.Lock()
+	defer n.metricsMu.Unlock()
+	return append([]error(nil), n.metricErrs...)
+}
+
+// recordMetricError records the error of a dropped metric update.
+func (n *Node) recordMetricError(err error) {
+	n.metricsMu.Lock()
+	defer n.metricsMu.Unlock()
+	n.metricErrs = append(n.metricErrs, err)
+}
+
+// Halted returns the error the node halted on, if any.
+func (n *Node) Halted() error {
+	return n.halted
+}
+
+// State returns a read-only view of the last committed beacon state.
+func (n *Node) State() components.BeaconState {
+	return n.backend.StateFromContext(
+		sdk.NewContext(n.cms.CacheMultiStore(), false, n.logger),
+	)
+}
+
+// DepositStore returns the deposit store of the node.
+func (n *Node) DepositStore() *components.DepositStore {
+	return n.depositStore
+}
+
+// HasBlob reports whether the node stores the blob with the given
+// commitment for the given slot.
+func (n *Node) HasBlob(
+	slot math.Slot,
+	commitment eip4844.KZGCommitment,
+) bool {
+	ok, err := n.availabilityStore.Has(slot.Unwrap(), commitment[:])
+	return err == nil && ok
+}
+
+// start builds the services of the node and waits until they listen on
+// their feeds.
+func (n *Node) start() error {
+	var (
+		cs           = n.cfg.ChainSpec
+		tracer       = noop.NewTracerProvider().Tracer("simulation")
+		feeRecipient = NewExecutionAddress(uint64(n.index))
+	)
+
+	registry, err := telemetry.NewRegistry(
+		nil, telemetry.DefaultDescriptors()...,
+	)
+	if err != nil {
+		return err
+	}
+	registry.OnError(n.recordMetricError)
+	sink := metrics.NewTelemetrySink(registry)
+
+	proposerConfig, err := proposer.NewStore(
+		"", proposer.Settings{FeeRecipient: feeRecipient}, n.logger,
+	)
+	if err != nil {
+		return err
+	}
+
+	slotClock, err := clock.NewSlotClock(
+		n.slotDuration(),
+		//#nosec:G701 // the drift will never overflow an int64.
+		time.Duration(cs.MaxPayloadTimestampDrift())*time.Second,
+		clock.WithGenesisTime(n.cfg.GenesisTime),
+	)
+	if err != nil {
+		return err
+	}
+
+	var (
+		blkFeed      = &components.BlockFeed{}
+		sidecarsFeed = &components.BlobFeed{}
+		slotFeed     = &components.SlotFeed{}
+	)
+
+	stateProcessor := core.NewStateProcessor[
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*types.BeaconBlockHeader,
+		components.BeaconState,
+		*components.BlobSidecars,
+		*transition.Context,
+		*types.Deposit,
+		*types.Eth1Data,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.ForkData,
+		*types.Validator,
+		*engineprimitives.Withdrawal,
+		types.WithdrawalCredentials,
+	](cs, n.engine, n.signer, n.depositStore, slotClock, tracer)
+
+	localBuilder := payloadbuilder.New[
+		components.BeaconState,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+	](
+		&payloadbuilder.Config{
+			Enabled:               true,
+			SuggestedFeeRecipient: feeRecipient,
+			PayloadTimeout:        n.cfg.PayloadTimeout,
+		},
+		cs,
+		n.logger.With("service", "payload-builder"),
+		n.engine,
+		cache.NewPayloadIDCache[
+			engineprimitives.PayloadID, [32]byte, math.Slot,
+		](),
+		attributes.NewAttributesFactory[
+			components.BeaconState,
+			*engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal],
+			*engineprimitives.Withdrawal,
+		](cs, n.logger, proposerConfig, n.signer.PublicKey()),
+	)
+
+	blobProcessor := dablob.NewProcessor[
+		*components.AvailabilityStore,
+		*types.BeaconBlockBody,
+	](
+		n.logger.With("service", "blob-processor"),
+		cs,
+		dablob.NewVerifier(n.verifier, sink),
+		types.BlockBodyKZGOffset,
+		sink,
+	)
+
+	chainService := blockchain.NewService[
+		*components.AvailabilityStore,
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*types.BeaconBlockHeader,
+		components.BeaconState,
+		*components.BlobSidecars,
+		*types.Deposit,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*components.Genesis,
+		*engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal],
+		*engineprimitives.Withdrawal,
+	](
+		n.backend,
+		n.depositStore,
+		n.logger.With("service", "blockchain"),
+		cs,
+		n.engine,
+		localBuilder,
+		blobProcessor,
+		stateProcessor,
+		slotClock,
+		sink,
+		blkFeed,
+		false,
+	)
+
+	validatorService := validator.NewService[
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		components.BeaconState,
+		*components.BlobSidecars,
+		*types.Deposit,
+		*components.DepositStore,
+		*types.Eth1Data,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*types.ForkData,
+	](
+		&validator.Config{},
+		n.logger.With("service", "validator"),
+		cs,
+		n.backend,
+		stateProcessor,
+		n.signer,
+		proposerConfig,
+		dablob.NewSidecarFactory[*types.BeaconBlock, *types.BeaconBlockBody](
+			cs, types.KZGPositionDeneb, sink,
+		),
+		localBuilder,
+		nil,
+		slotClock,
+		sink,
+		tracer,
+		blkFeed,
+		sidecarsFeed,
+		slotFeed,
+	)
+
+	daService := da.NewService[
+		*components.AvailabilityStore,
+		*types.BeaconBlockBody,
+	](
+		n.availabilityStore,
+		blobProcessor,
+		n.logger.With("service", "da"),
+	)
+
+	abciMiddleware := middleware.NewABCIMiddleware[
+		*components.AvailabilityStore,
+		*types.BeaconBlock,
+		components.BeaconState,
+		*components.BlobSidecars,
+		*types.Deposit,
+		*types.ExecutionPayload,
+		*components.Genesis,
+	](
+		cs,
+		chainService,
+		daService,
+		rp2p.NewNoopBlobHandler[
+			*components.BlobSidecars, encoding.ABCIRequest,
+		](),
+		slotClock,
+		n.logger,
+		sink,
+		tracer,
+		blkFeed,
+		sidecarsFeed,
+		slotFeed,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	for _, svc := range []interface {
+		Start(context.Context) error
+	}{chainService, validatorService, daService, abciMiddleware} {
+		if err = svc.Start(ctx); err != nil {
+			cancel()
+			return err
+		}
+	}
+
+	// The services subscribe to their feeds asynchronously, wait for them
+	// so that no event of the first block is dropped.
+	waitForSubscriber(ctx, slotFeed, math.Slot(0))
+	waitForSubscriber(ctx, blkFeed, &types.BeaconBlock{})
+	waitForSubscriber(ctx, sidecarsFeed, &components.BlobSidecars{})
+
+	n.middleware = abciMiddleware
+	n.cancel = cancel
+	n.chain = chainService
+	n.halted = nil
+	return nil
+}
+
+// stop stops the services of the node.
+func (n *Node) stop() {
+	if n.cancel != nil {
+		n.cancel()
+	}
+	if n.chain != nil {
+		n.chain.Wait()
+	}
+	n.middleware = nil
+	n.cancel = nil
+	n.chain = nil
+}
+
+// initGenesis initializes and commits the genesis state.
+func (n *Node) initGenesis(bz []byte) error {
+	ms := n.cms.CacheMultiStore()
+	ctx, cancel := n.newContext(ms, 0)
+	defer cancel()
+
+	if _, err := n.middleware.InitGenesis(ctx, bz); err != nil {
+		return err
+	}
+	ms.Write()
+	n.cms.Commit()
+	return nil
+}
+
+// prepareProposal asks the validator of the node to build the proposal
+// for the given height.
+func (n *Node) prepareProposal(height int64) ([][]byte, error) {
+	ctx, cancel := n.newContext(n.cms.CacheMultiStore(), height)
+	defer cancel()
+
+	resp, err := n.middleware.PrepareProposal(
+		ctx, &cmtabci.PrepareProposalRequest{
+			Height: height, Time: n.blockTime(height),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetTxs(), nil
+}
+
+// processProposal reports whether the node votes for the given proposal.
+func (n *Node) processProposal(height int64, txs [][]byte) bool {
+	ctx, cancel := n.newContext(n.cms.CacheMultiStore(), height)
+	defer cancel()
+
+	resp, err := n.middleware.ProcessProposal(
+		ctx, &cmtabci.ProcessProposalRequest{
+			Txs: txs, Height: height, Time: n.blockTime(height),
+		},
+	)
+	if err != nil {
+		n.logger.Error("rejected proposal", "height", height, "error", err)
+		return false
+	}
+	return resp.GetStatus() == cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT
+}
+
+// finalizeBlock executes and commits the given block. A failure halts
+// the node, just like an AppHash mismatch would.
+func (n *Node) finalizeBlock(height int64, txs [][]byte) error {
+	ms := n.cms.CacheMultiStore()
+	ctx, cancel := n.newContext(ms, height)
+	defer cancel()
+
+	req := &cmtabci.FinalizeBlockRequest{
+		Txs: txs, Height: height, Time: n.blockTime(height),
+	}
+	if err := n.middleware.PreBlock(ctx, req); err != nil {
+		return n.halt(height, err)
+	}
+	if _, err := n.middleware.EndBlock(ctx); err != nil {
+		return n.halt(height, err)
+	}
+
+	// The chain service notifies the execution client in the background
+	// from the uncommitted state, wait for it before committing.
+	if blk, err := encoding.UnmarshalBeaconBlockFromABCIRequest[*types.BeaconBlock](
+		req,
+		n.cfg.ChainSpec.ActiveForkVersionForSlot(math.Slot(height)),
+	); err == nil {
+		n.waitForForkchoice(
+			blk.GetBody().GetExecutionPayload().GetBlockHash(),
+		)
+	}
+
+	ms.Write()
+	n.cms.Commit()
+	n.height = height
+	return nil
+}
+
+// waitForForkchoice waits for the forkchoice update following a block,
+// giving up after the configured timeout.
+func (n *Node) waitForForkchoice(head [32]byte) {
+	ctx, cancel := context.WithTimeout(
+		context.Background(), n.cfg.ForkchoiceTimeout,
+	)
+	defer cancel()
+	if err := n.engine.Chain().WaitForForkchoice(ctx, head); err != nil {
+		n.logger.Warn("no forkchoice update after block", "error", err)
+	}
+}
+
+// halt marks the node as halted at the given height.
+func (n *Node) halt(height int64, err error) error {
+	n.halted = errors.Wrapf(err, "node %d halted at height %d", n.index, height)
+	return n.halted
+}
+
+// newContext returns an SDK context over the given store branch, bounded
+// by the configured step timeout.
+func (n *Node) newContext(
+	ms storetypes.MultiStore,
+	height int64,
+) (sdk.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(
+		context.Background(), n.cfg.StepTimeout,
+	)
+	return sdk.NewContext(ms, false, n.logger).
+		WithBlockHeight(height).
+		WithHeaderInfo(header.Info{
+			Height: height, Time: n.blockTime(height),
+		}).
+		WithContext(ctx), cancel
+}
+
+// slotDuration returns the target duration of a slot.
+func (n *Node) slotDuration() time.Duration {
+	//#nosec:G701 // the slot duration will never overflow an int64.
+	return time.Duration(
+		n.cfg.ChainSpec.TargetSecondsPerEth1Block(),
+	) * time.Second
+}
+
+// blockTime returns the CometBFT time of the block at the given height.
+func (n *Node) blockTime(height int64) time.Time {
+	return n.cfg.GenesisTime.Add(time.Duration(height) * n.slotDuration())
+}
+
+// waitForSubscriber sends probe events on the feed until a subscriber
+// receives one.
+func waitForSubscriber[T any](
+	ctx context.Context,
+	feed *event.FeedOf[asynctypes.EventID, *asynctypes.Event[T]],
+	data T,
+) {
+	for feed.Send(asynctypes.NewEvent(ctx, probeEvent, data)) == 0 {
+		if ctx.Err() != nil {
+			return
+		}
+		time.Sleep(probeInterval)
+	}
+}
@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulation_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/testing/mockengine"
+	"github.com/berachain/beacon-kit/testing/simulation"
+	"github.com/stretchr/testify/require"
+)
+
+func newNetwork(t *testing.T) *simulation.Network {
+	t.Helper()
+	nw, err := simulation.NewNetwork(
+		simulation.WithRootDirectory(t.TempDir()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(nw.Stop)
+	t.Cleanup(func() {
+		for _, node := range nw.Nodes() {
+			require.Empty(t, node.MetricErrors(), "node %d", node.Index())
+		}
+	})
+	return nw
+}
+
+func TestGenesis(t *testing.T) {
+	nw := newNetwork(t)
+
+	for _, node := range nw.Nodes() {
+		validators, err := node.State().GetValidators()
+		require.NoError(t, err)
+		require.Len(t, validators, len(nw.Nodes()))
+	}
+	require.NoError(t, nw.AdvanceSlots(4))
+	require.NoError(t, nw.CheckConsistency())
+
+	// Payloads are timestamped with the CometBFT time of their block.
+	cfg := simulation.DefaultConfig()
+	slotTime := func(height int64) uint64 {
+		//#nosec:G701 // heights are positive.
+		return uint64(cfg.GenesisTime.Unix()) +
+			uint64(height)*cfg.ChainSpec.TargetSecondsPerEth1Block()
+	}
+	for height := range int64(4) {
+		blk, err := nw.Block(height + 1)
+		require.NoError(t, err)
+		require.Equal(t, math.Slot(height+1), blk.GetSlot())
+		require.Equal(
+			t, slotTime(height+1),
+			blk.GetBody().GetExecutionPayload().GetTimestamp().Unwrap(),
+		)
+	}
+}
+
+func TestDepositRobustness(t *testing.T) {
+	nw := newNetwork(t)
+	require.NoError(t, nw.AdvanceSlots(1))
+
+	depositor, err := simulation.NewSigner(1000)
+	require.NoError(t, err)
+	credentials := types.NewCredentialsFromExecutionAddress(
+		simulation.NewExecutionAddress(1000),
+	)
+
+	// Create a validator with the minimum balance and top it up with more
+	// deposits than fit in a single block.
+	_, err = nw.SubmitDeposit(depositor, credentials, math.Gwei(1e9))
+	require.NoError(t, err)
+	for range 20 {
+		_, err = nw.SubmitDeposit(depositor, credentials, math.Gwei(1e9))
+		require.NoError(t, err)
+	}
+
+	// A node misses some of the deposits and catches up on restart.
+	nw.Crash(3)
+	require.NoError(t, nw.AdvanceSlots(3))
+	require.NoError(t, nw.Restart(3))
+	require.NoError(t, nw.AdvanceSlots(1))
+	require.NoError(t, nw.CheckConsistency())
+
+	for _, node := range nw.Nodes() {
+		st := node.State()
+		idx, err := st.ValidatorIndexByPubkey(depositor.PublicKey())
+		require.NoError(t, err)
+		val, err := st.ValidatorByIndex(idx)
+		require.NoError(t, err)
+		require.Equal(t, math.Gwei(21e9), val.GetEffectiveBalance())
+
+		depositIndex, err := st.GetEth1DepositIndex()
+		require.NoError(t, err)
+		require.Equal(t, uint64(len(nw.Nodes())+21), depositIndex)
+
+		// The eth1 data votes for the local deposit tree, which has been
+		// finalized up to the processed deposits.
+		eth1Data, err := st.GetEth1Data()
+		require.NoError(t, err)
+		require.Equal(t, depositIndex, eth1Data.DepositCount)
+		root, ok := node.DepositStore().DepositRoot(depositIndex)
+		require.True(t, ok)
+		require.Equal(t, root, eth1Data.GetDepositRoot())
+		snapshot := node.DepositStore().Snapshot()
+		require.Equal(t, depositIndex, snapshot.DepositCount.Unwrap())
+		require.Equal(t, root, snapshot.DepositRoot)
+	}
+}
+
+func TestBlobs(t *testing.T) {
+	nw := newNetwork(t)
+
+	var versionedHashes []common.ExecutionHash
+	for _, numBlobs := range []int{1, 2, 3} {
+		tx, err := nw.SubmitBlobTx(numBlobs)
+		require.NoError(t, err)
+		versionedHashes = append(versionedHashes, tx.VersionedHashes()...)
+	}
+	require.NoError(t, nw.AdvanceSlots(2))
+	require.NoError(t, nw.CheckConsistency())
+	require.Zero(t, nw.PendingBlobTxs())
+
+	var found int
+	for height := range nw.Height() {
+		blk, err := nw.Block(height + 1)
+		require.NoError(t, err)
+		for _, c := range blk.GetBody().GetBlobKzgCommitments() {
+			require.Contains(
+				t, versionedHashes, common.ExecutionHash(c.ToVersionedHash()),
+			)
+			for _, node := range nw.Nodes() {
+				require.True(t, node.HasBlob(blk.GetSlot(), c))
+			}
+			found++
+		}
+	}
+	require.Equal(t, len(versionedHashes), found)
+}
+
+func TestSyncingExecutionClient(t *testing.T) {
+	nw := newNetwork(t)
+
+	nw.Node(1).Engine().Chain().SetFault(mockengine.FaultSyncing)
+	require.NoError(t, nw.AdvanceSlots(4))
+	require.True(t, nw.Node(1).Online())
+	require.NoError(t, nw.CheckConsistency())
+
+	nw.Node(1).Engine().Chain().SetFault(mockengine.FaultNone)
+	require.NoError(t, nw.AdvanceSlots(4))
+	require.NoError(t, nw.CheckConsistency())
+}
+
+func TestInvalidExecutionClient(t *testing.T) {
+	nw := newNetwork(t)
+	require.NoError(t, nw.AdvanceSlots(1))
+
+	nw.Node(2).Engine().Chain().SetFault(mockengine.FaultInvalid)
+	require.NoError(t, nw.AdvanceSlots(2))
+	require.Error(t, nw.Node(2).Halted())
+	require.NoError(t, nw.CheckConsistency())
+
+	nw.Node(2).Engine().Chain().SetFault(mockengine.FaultNone)
+	require.NoError(t, nw.Restart(2))
+	require.Equal(t, nw.Height(), nw.Node(2).Height())
+	require.NoError(t, nw.AdvanceSlots(2))
+	require.NoError(t, nw.CheckConsistency())
+}
+
+func TestTamperedProposal(t *testing.T) {
+	nw := newNetwork(t)
+	badRoot := common.Root{0x01}
+
+	// Corrupt the state root of every first-round proposal.
+	nw.SetProposalTamper(func(
+		height int64, proposer int, txs [][]byte,
+	) [][]byte {
+		if proposer != int(height)%len(nw.Nodes()) || len(txs) == 0 {
+			return txs
+		}
+		blk, err := nw.DecodeBlock(height, txs)
+		require.NoError(t, err)
+		blk.SetStateRoot(badRoot)
+		bz, err := nw.EncodeBlock(height, blk)
+		require.NoError(t, err)
+		return [][]byte{bz, txs[1]}
+	})
+	require.NoError(t, nw.AdvanceSlots(2))
+	require.NoError(t, nw.CheckConsistency())
+
+	blk, err := nw.Block(nw.Height())
+	require.NoError(t, err)
+	require.NotEqual(t, badRoot, blk.GetStateRoot())
+
+	// Empty proposals are committed without a beacon block.
+	nw.SetProposalTamper(func(int64, int, [][]byte) [][]byte {
+		return nil
+	})
+	require.NoError(t, nw.AdvanceSlots(1))
+	_, err = nw.Block(nw.Height())
+	require.ErrorIs(t, err, simulation.ErrEmptyBlock)
+}
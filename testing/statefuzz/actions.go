@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package statefuzz
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// maxSkippedSlots is the maximum number of empty slots before a block.
+const maxSkippedSlots = 3
+
+// Kind is the kind of an action.
+type Kind uint8
+
+const (
+	// KindBlock applies a valid block.
+	KindBlock Kind = iota
+	// KindInvalidBlock applies a block broken by a mutation, which must be
+	// rejected.
+	KindInvalidBlock
+	// KindDeposit enqueues a deposit, which creates or tops up the
+	// validator of its key once included in a block.
+	KindDeposit
+	// numKinds is the number of kinds of actions.
+	numKinds
+)
+
+// Action is a single step of a generated sequence.
+type Action struct {
+	// Kind is the kind of the action.
+	Kind Kind
+	// Skip is the number of empty slots before the block of a block
+	// action.
+	Skip uint64
+	// Mutation breaks the block of an invalid block action.
+	Mutation Mutation
+	// Key is the index of the key making a deposit.
+	Key int
+	// Amount is the amount of a deposit.
+	Amount math.Gwei
+}
+
+// String returns a description of the action.
+func (a Action) String() string {
+	switch a.Kind {
+	case KindBlock:
+		return fmt.Sprintf("block after %d empty slots", a.Skip)
+	case KindInvalidBlock:
+		return fmt.Sprintf(
+			"invalid block after %d empty slots, %s", a.Skip, a.Mutation,
+		)
+	case KindDeposit:
+		return fmt.Sprintf("deposit of %d gwei from key %d", a.Amount, a.Key)
+	default:
+		return fmt.Sprintf("unknown action %d", a.Kind)
+	}
+}
+
+// DecodeActions decodes a sequence of actions from arbitrary bytes, as
+// given by the fuzzing engine. Each action is decoded from two bytes: the
+// first selects its kind and the second its parameters. Every input
+// decodes to a sequence of at most cfg.MaxActions actions.
+func DecodeActions(cfg Config, data []byte) []Action {
+	actions := make([]Action, 0, min(len(data)/2, cfg.MaxActions))
+	for i := 0; i+1 < len(data) && len(actions) < cfg.MaxActions; i += 2 {
+		actions = append(actions, decodeAction(cfg, data[i], data[i+1]))
+	}
+	return actions
+}
+
+// decodeAction decodes an action from its kind and parameter bytes.
+func decodeAction(cfg Config, kind, param byte) Action {
+	action := Action{Kind: Kind(kind % byte(numKinds))}
+	switch action.Kind {
+	case KindBlock:
+		action.Skip = uint64(param % (maxSkippedSlots + 1))
+	case KindInvalidBlock:
+		action.Mutation = Mutation(param % byte(numMutations))
+		action.Skip = uint64(param>>4) % (maxSkippedSlots + 1)
+	case KindDeposit:
+		action.Key = int(param) % cfg.Keys
+		action.Amount = depositAmount(cfg.ChainSpec, param>>4)
+	}
+	return action
+}
+
+// depositAmount returns the amount of a deposit picked by the given
+// selector: a single increment, half or all of the maximum effective
+// balance, or more than it, which makes the validator partially
+// withdrawable.
+func depositAmount(cs common.ChainSpec, selector byte) math.Gwei {
+	switch selector % 4 {
+	case 0:
+		return math.Gwei(cs.EffectiveBalanceIncrement())
+	case 1:
+		return math.Gwei(cs.MaxEffectiveBalance() / 2)
+	case 2:
+		return math.Gwei(cs.MaxEffectiveBalance())
+	default:
+		return math.Gwei(
+			cs.MaxEffectiveBalance() + 8*cs.EffectiveBalanceIncrement(),
+		)
+	}
+}
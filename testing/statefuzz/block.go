@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package statefuzz
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/testing/simulation"
+)
+
+// blockParts holds the parts of a block before it is assembled, so that
+// mutations can change any of them.
+type blockParts struct {
+	slot        math.Slot
+	proposer    math.ValidatorIndex
+	parentRoot  common.Root
+	eth1Data    *types.Eth1Data
+	deposits    []*types.Deposit
+	payload     *types.ExecutableDataDeneb
+	commitments eip4844.KZGCommitments[common.ExecutionHash]
+
+	// parentSlot is the slot of the parent block.
+	parentSlot math.Slot
+	// depositIndex is the index of the next deposit to process.
+	depositIndex uint64
+}
+
+// buildBlock builds the parts of a valid block following the given number
+// of empty slots. The parent root, randao mix and withdrawals are those
+// of the state once advanced to the slot of the block.
+func (h *Harness) buildBlock(skip uint64) (*blockParts, error) {
+	cs := h.cfg.ChainSpec
+	slot, err := h.st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	slot += math.Slot(skip + 1)
+
+	view := h.st.Copy()
+	if _, err = h.sp.ProcessSlots(view, slot); err != nil {
+		return nil, err
+	}
+	header, err := view.GetLatestBlockHeader()
+	if err != nil {
+		return nil, err
+	}
+	parentRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	numValidators, err := view.GetTotalValidators()
+	if err != nil {
+		return nil, err
+	}
+	lph, err := view.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+	mix, err := view.GetRandaoMixAtIndex(
+		uint64(cs.SlotToEpoch(slot)) % cs.EpochsPerHistoricalVector(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	withdrawals, err := view.ExpectedWithdrawals()
+	if err != nil {
+		return nil, err
+	}
+	depositIndex, err := view.GetEth1DepositIndex()
+	if err != nil {
+		return nil, err
+	}
+	deposits, depositRoot, depositCount, err := h.deposits.
+		GetDepositsWithProofs(depositIndex, cs.MaxDepositsPerBlock())
+	if err != nil {
+		return nil, err
+	}
+
+	return &blockParts{
+		slot:       slot,
+		proposer:   math.ValidatorIndex(uint64(slot) % numValidators),
+		parentRoot: parentRoot,
+		eth1Data: (&types.Eth1Data{}).New(
+			depositRoot, math.U64(depositCount), lph.GetBlockHash(),
+		),
+		deposits: deposits,
+		payload: &types.ExecutableDataDeneb{
+			ParentHash:   lph.GetBlockHash(),
+			FeeRecipient: simulation.NewExecutionAddress(uint64(slot)),
+			LogsBloom:    make([]byte, types.LogsBloomSize),
+			Random:       mix,
+			Number:       lph.GetNumber() + 1,
+			Timestamp:    lph.GetTimestamp() + 1,
+			ExtraData:    []byte{},
+			BlockHash:    h.nextBlockHash(),
+			Transactions: [][]byte{},
+			Withdrawals:  withdrawals,
+		},
+		parentSlot:   header.GetSlot(),
+		depositIndex: depositIndex,
+	}, nil
+}
+
+// nextBlockHash returns a hash no other execution block of the harness
+// has.
+func (h *Harness) nextBlockHash() common.ExecutionHash {
+	var hash common.ExecutionHash
+	h.nonce++
+	binary.BigEndian.PutUint64(hash[len(hash)-8:], h.nonce)
+	return hash
+}
+
+// assemble assembles the block from its parts.
+func (p *blockParts) assemble(cs common.ChainSpec) (*types.BeaconBlock, error) {
+	blk, err := (&types.BeaconBlock{}).NewWithVersion(
+		p.slot, p.proposer, p.parentRoot, cs.ActiveForkVersionForSlot(p.slot),
+	)
+	if err != nil {
+		return nil, err
+	}
+	body := blk.GetBody()
+	body.SetEth1Data(p.eth1Data)
+	body.SetDeposits(p.deposits)
+	body.SetDepositProofs(true)
+	body.SetBlobKzgCommitments(p.commitments)
+	return blk, body.SetExecutionData(
+		&types.ExecutionPayload{InnerExecutionPayload: p.payload},
+	)
+}
+
+// enqueueDeposit signs a deposit of the given key over the given fork
+// version and enqueues it in the deposit tree.
+func (h *Harness) enqueueDeposit(
+	key int,
+	amount math.Gwei,
+	forkVersion common.Version,
+) (*types.Deposit, error) {
+	//#nosec:G701 // keys are indexed from zero.
+	credentials := types.NewCredentialsFromExecutionAddress(
+		simulation.NewExecutionAddress(uint64(key)),
+	)
+	_, signature, err := types.CreateAndSignDepositMessage(
+		types.NewForkData(forkVersion, h.genesisValidatorsRoot),
+		h.cfg.ChainSpec.DomainTypeDeposit(),
+		h.keys[key],
+		credentials,
+		amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	deposit := types.NewDeposit(
+		h.keys[key].PublicKey(), credentials, amount, signature, h.numDeposits,
+	)
+	if err = h.deposits.EnqueueDeposit(deposit); err != nil {
+		return nil, err
+	}
+	h.numDeposits++
+	return deposit, nil
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package statefuzz
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidConfig is returned when a harness is configured without
+	// genesis validators or with fewer keys than genesis validators.
+	ErrInvalidConfig = errors.New("invalid harness configuration")
+
+	// ErrUnknownAction is returned when applying an action of an unknown
+	// kind.
+	ErrUnknownAction = errors.New("unknown action")
+
+	// ErrUnknownMutation is returned when applying a mutation of an
+	// unknown kind.
+	ErrUnknownMutation = errors.New("unknown mutation")
+
+	// ErrUnexpectedRejection is returned when the state processor rejects
+	// a valid block.
+	ErrUnexpectedRejection = errors.New("valid block rejected")
+
+	// ErrUnexpectedAcceptance is returned when the state processor accepts
+	// an invalid block.
+	ErrUnexpectedAcceptance = errors.New("invalid block accepted")
+
+	// ErrInvariantViolated is returned when a transition breaks an
+	// invariant of the beacon state.
+	ErrInvariantViolated = errors.New("state invariant violated")
+)
@@ -0,0 +1,369 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package statefuzz drives the state processor through generated sequences
+// of valid and invalid blocks and deposits over an in-memory beacon state,
+// and checks the invariants of the state after every transition.
+package statefuzz
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	storev2db "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/genesis"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/clock"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
+	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/berachain/beacon-kit/testing/simulation"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// Config configures a fuzzing harness.
+type Config struct {
+	// ChainSpec is the chain spec the blocks are processed under.
+	ChainSpec common.ChainSpec
+	// GenesisValidators is the number of validators at genesis.
+	GenesisValidators int
+	// Keys is the number of keys deposits are made from. The keys past the
+	// genesis validators join the validator set through deposits.
+	Keys int
+	// MaxActions is the maximum number of actions decoded from an input.
+	MaxActions int
+}
+
+// DefaultConfig returns the default configuration of a harness.
+func DefaultConfig() Config {
+	return Config{
+		ChainSpec:         ChainSpec(),
+		GenesisValidators: 4,
+		Keys:              12,
+		MaxActions:        64,
+	}
+}
+
+// ChainSpec returns a chain spec with short epochs, payloads and sweeps,
+// so that short sequences cross epoch boundaries and wrap the withdrawal
+// sweep around the validator set. Deposits are proven, payload timestamps
+// are checked and the staking fix applies from genesis, as the deposits and
+// timestamps are not checked and the balances are not conserved before.
+func ChainSpec() common.ChainSpec {
+	data := spec.BaseSpec()
+	data.DepositProofForkEpoch = 0
+	data.PayloadTimestampForkEpoch = 0
+	data.StakingFixForkEpoch = 0
+	data.SlotsPerEpoch = 4
+	data.MaxDepositsPerBlock = 4
+	data.MaxWithdrawalsPerPayload = 4
+	data.MaxValidatorsPerWithdrawalsSweep = 8
+	return chain.NewChainSpec(data)
+}
+
+// Harness applies actions to an in-memory beacon state and checks the
+// invariants of the state after each of them.
+type Harness struct {
+	cfg Config
+	sp  components.StateProcessor
+	// kv reads the committed state, for the fields the beacon state does
+	// not expose.
+	kv *components.KVStore
+	// st is the committed state. Blocks are applied to a copy of it, which
+	// is written back only if the block is valid.
+	st       components.BeaconState
+	deposits *components.DepositStore
+	keys     []*signer.LegacySigner
+
+	genesisValidatorsRoot common.Root
+	// numDeposits is the number of deposits enqueued so far.
+	numDeposits uint64
+	// nonce makes the hashes of the execution blocks unique.
+	nonce uint64
+}
+
+// NewHarness creates a harness whose state starts at the genesis of the
+// given number of validators. Each genesis validator deposits one more
+// gwei increment than the previous one over the maximum effective
+// balance, so that some of them are partially withdrawable.
+func NewHarness(cfg Config) (*Harness, error) {
+	if cfg.GenesisValidators <= 0 || cfg.Keys < cfg.GenesisValidators {
+		return nil, errors.Wrapf(
+			ErrInvalidConfig, "%d genesis validators, %d keys",
+			cfg.GenesisValidators, cfg.Keys,
+		)
+	}
+
+	h := &Harness{cfg: cfg}
+	for i := range cfg.Keys {
+		//#nosec:G701 // the number of keys is positive.
+		key, err := simulation.NewSigner(uint64(i))
+		if err != nil {
+			return nil, err
+		}
+		h.keys = append(h.keys, key)
+	}
+
+	var err error
+	h.deposits, err = depositstore.NewStore[*types.Deposit](
+		&depositstore.KVStoreProvider{
+			KVStoreWithBatch: storev2db.NewMemDB(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	slotClock, err := clock.NewSlotClock(time.Second, 0)
+	if err != nil {
+		return nil, err
+	}
+	h.sp = core.NewStateProcessor[
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*types.BeaconBlockHeader,
+		components.BeaconState,
+		*components.BlobSidecars,
+		*transition.Context,
+		*types.Deposit,
+		*types.Eth1Data,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.ForkData,
+		*types.Validator,
+		*engineprimitives.Withdrawal,
+		types.WithdrawalCredentials,
+	](
+		cfg.ChainSpec,
+		acceptingEngine{},
+		// Blocks are only verified, which requires no key.
+		signer.BLSSigner{},
+		h.deposits,
+		slotClock,
+		noop.NewTracerProvider().Tracer("statefuzz"),
+	)
+
+	if err = h.openState(); err != nil {
+		return nil, err
+	}
+	if err = h.initGenesis(); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// openState creates the in-memory store backing the beacon state.
+func (h *Harness) openState() error {
+	logger := log.NewNopLogger()
+	key := storetypes.NewKVStoreKey("beacon")
+	cms := store.NewCommitMultiStore(
+		dbm.NewMemDB(), logger, storemetrics.NewNoOpMetrics(),
+	)
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	if err := cms.LoadLatestVersion(); err != nil {
+		return err
+	}
+
+	h.kv = components.ProvideKVStore(components.KVStoreInput{
+		Environment: appmodule.Environment{
+			KVStoreService: runtime.NewKVStoreService(key),
+		},
+	}).WithContext(sdk.NewContext(cms.CacheMultiStore(), false, logger))
+	h.st = state.NewBeaconStateFromDB[
+		components.BeaconState, *components.BeaconStateMarshallable,
+	](h.kv, h.cfg.ChainSpec)
+	return nil
+}
+
+// initGenesis initializes the state from the deposits of the genesis
+// validators, which are also the first deposits of the deposit tree.
+func (h *Harness) initGenesis() error {
+	var (
+		cs  = h.cfg.ChainSpec
+		gen = genesis.DefaultGenesisDeneb()
+	)
+	for i := range h.cfg.GenesisValidators {
+		//#nosec:G701 // the number of validators is positive.
+		amount := math.Gwei(
+			cs.MaxEffectiveBalance() +
+				uint64(i)*cs.EffectiveBalanceIncrement(),
+		)
+		deposit, err := h.enqueueDeposit(i, amount, gen.ForkVersion)
+		if err != nil {
+			return err
+		}
+		gen.Deposits = append(gen.Deposits, deposit)
+	}
+
+	if _, err := h.sp.InitializePreminedBeaconStateFromEth1(
+		h.st, gen.Deposits, gen.ExecutionPayloadHeader, gen.ForkVersion,
+	); err != nil {
+		return err
+	}
+
+	var err error
+	h.genesisValidatorsRoot, err = h.st.GetGenesisValidatorsRoot()
+	return err
+}
+
+// Run applies the given actions in order. It stops at the first action
+// the state processor handles unexpectedly or that breaks an invariant.
+func (h *Harness) Run(actions []Action) error {
+	for i, action := range actions {
+		if err := h.Apply(action); err != nil {
+			return errors.Wrapf(err, "action %d (%s)", i, action)
+		}
+	}
+	return nil
+}
+
+// Apply applies a single action.
+func (h *Harness) Apply(action Action) error {
+	switch action.Kind {
+	case KindDeposit:
+		slot, err := h.st.GetSlot()
+		if err != nil {
+			return err
+		}
+		_, err = h.enqueueDeposit(
+			action.Key,
+			action.Amount,
+			version.FromUint32[common.Version](
+				h.cfg.ChainSpec.ActiveForkVersionForSlot(slot+1),
+			),
+		)
+		return err
+	case KindBlock, KindInvalidBlock:
+		parts, err := h.buildBlock(action.Skip)
+		if err != nil {
+			return err
+		}
+		if action.Kind == KindBlock {
+			return h.applyValid(parts)
+		}
+		if err = h.mutate(parts, action.Mutation); err != nil {
+			return err
+		}
+		return h.applyInvalid(parts)
+	default:
+		return errors.Wrapf(ErrUnknownAction, "%d", action.Kind)
+	}
+}
+
+// State returns the committed beacon state.
+func (h *Harness) State() components.BeaconState {
+	return h.st
+}
+
+// applyValid applies a valid block and checks the invariants relating
+// the states before and after it.
+func (h *Harness) applyValid(parts *blockParts) error {
+	blk, err := parts.assemble(h.cfg.ChainSpec)
+	if err != nil {
+		return err
+	}
+	pre, err := h.snapshot()
+	if err != nil {
+		return err
+	}
+	if _, err = h.sp.Transition(
+		h.transitionContext(), h.st.Copy(), blk,
+	); err != nil {
+		return errors.Wrap(ErrUnexpectedRejection, err.Error())
+	}
+	post, err := h.snapshot()
+	if err != nil {
+		return err
+	}
+	return checkInvariants(h.cfg.ChainSpec, pre, post, blk)
+}
+
+// applyInvalid applies an invalid block, which must be rejected without
+// changing the state.
+func (h *Harness) applyInvalid(parts *blockParts) error {
+	blk, err := parts.assemble(h.cfg.ChainSpec)
+	if err != nil {
+		return err
+	}
+	pre, err := h.st.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if _, err = h.sp.Transition(
+		h.transitionContext(), h.st.Copy(), blk,
+	); err == nil {
+		return ErrUnexpectedAcceptance
+	}
+	post, err := h.st.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if pre != post {
+		return errors.Wrapf(
+			ErrInvariantViolated,
+			"rejected block changed the state root from %s to %s",
+			common.Root(pre), common.Root(post),
+		)
+	}
+	return nil
+}
+
+// transitionContext returns the context blocks are processed with. The
+// randao reveals and state roots of the generated blocks are not signed
+// nor computed, hence not verified.
+func (h *Harness) transitionContext() *transition.Context {
+	return &transition.Context{
+		Context:            context.Background(),
+		SkipValidateRandao: true,
+		SkipValidateResult: true,
+	}
+}
+
+// acceptingEngine is an execution engine accepting every payload.
+type acceptingEngine struct{}
+
+// VerifyAndNotifyNewPayload accepts the payload.
+func (acceptingEngine) VerifyAndNotifyNewPayload(
+	context.Context,
+	*engineprimitives.NewPayloadRequest[
+		*types.ExecutionPayload, *engineprimitives.Withdrawal,
+	],
+) error {
+	return nil
+}
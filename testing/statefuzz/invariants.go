@@ -0,0 +1,238 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package statefuzz
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// snapshot holds the fields of a beacon state the invariants are checked
+// on.
+type snapshot struct {
+	root                    common.Root
+	copyRoot                common.Root
+	pubkeys                 []crypto.BLSPubkey
+	pubkeyIndices           []math.ValidatorIndex
+	totalBalance            math.Gwei
+	nextWithdrawalIndex     uint64
+	nextWithdrawalValidator math.ValidatorIndex
+	totalSlashing           math.Gwei
+	slashings               math.Gwei
+}
+
+// snapshot takes a snapshot of the committed state.
+func (h *Harness) snapshot() (*snapshot, error) {
+	var (
+		s   = new(snapshot)
+		err error
+	)
+	if s.root, err = h.st.HashTreeRoot(); err != nil {
+		return nil, err
+	}
+	if s.copyRoot, err = h.st.Copy().HashTreeRoot(); err != nil {
+		return nil, err
+	}
+
+	validators, err := h.st.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+	for i, val := range validators {
+		var (
+			balance math.Gwei
+			index   math.ValidatorIndex
+		)
+		//#nosec:G701 // validator indices are below the registry limit.
+		if balance, err = h.st.GetBalance(math.ValidatorIndex(i)); err != nil {
+			return nil, err
+		}
+		if index, err = h.st.ValidatorIndexByPubkey(
+			val.GetPubkey(),
+		); err != nil {
+			return nil, err
+		}
+		s.totalBalance += balance
+		s.pubkeys = append(s.pubkeys, val.GetPubkey())
+		s.pubkeyIndices = append(s.pubkeyIndices, index)
+	}
+
+	if s.nextWithdrawalIndex, err = h.st.GetNextWithdrawalIndex(); err != nil {
+		return nil, err
+	}
+	if s.nextWithdrawalValidator, err = h.st.
+		GetNextWithdrawalValidatorIndex(); err != nil {
+		return nil, err
+	}
+	if s.totalSlashing, err = h.st.GetTotalSlashing(); err != nil {
+		return nil, err
+	}
+	slashings, err := h.kv.GetSlashings()
+	if err != nil {
+		return nil, err
+	}
+	for _, slashing := range slashings {
+		s.slashings += math.Gwei(slashing)
+	}
+	return s, nil
+}
+
+// checkInvariants checks the invariants of the state after the given
+// block, and those relating it to the state before the block.
+func checkInvariants(
+	cs common.ChainSpec,
+	pre, post *snapshot,
+	blk *types.BeaconBlock,
+) error {
+	for _, check := range []func() error{
+		post.checkCopyRoot,
+		post.checkPubkeyIndex,
+		post.checkSlashings,
+		func() error { return checkBalances(pre, post, blk) },
+		func() error { return checkWithdrawalIndex(pre, post, blk) },
+		func() error { return checkWithdrawalSweep(cs, pre, post, blk) },
+	} {
+		if err := check(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkCopyRoot checks that a copy of the state has the same root.
+func (s *snapshot) checkCopyRoot() error {
+	if s.root != s.copyRoot {
+		return errors.Wrapf(
+			ErrInvariantViolated, "state root %s, state root of its copy %s",
+			s.root, s.copyRoot,
+		)
+	}
+	return nil
+}
+
+// checkPubkeyIndex checks that the pubkeys of the validators are unique
+// and index their validator.
+func (s *snapshot) checkPubkeyIndex() error {
+	seen := make(map[crypto.BLSPubkey]struct{}, len(s.pubkeys))
+	for i, pubkey := range s.pubkeys {
+		if _, ok := seen[pubkey]; ok {
+			return errors.Wrapf(
+				ErrInvariantViolated, "pubkey of validator %d is not unique", i,
+			)
+		}
+		seen[pubkey] = struct{}{}
+		//#nosec:G701 // validator indices are below the registry limit.
+		if index := s.pubkeyIndices[i]; index != math.ValidatorIndex(i) {
+			return errors.Wrapf(
+				ErrInvariantViolated,
+				"pubkey of validator %d indexes validator %d", i, index,
+			)
+		}
+	}
+	return nil
+}
+
+// checkSlashings checks that the total slashing is the sum of the
+// slashings.
+func (s *snapshot) checkSlashings() error {
+	if s.totalSlashing != s.slashings {
+		return errors.Wrapf(
+			ErrInvariantViolated, "total slashing %d, sum of the slashings %d",
+			s.totalSlashing, s.slashings,
+		)
+	}
+	return nil
+}
+
+// checkBalances checks that the block changes the total balance only by
+// its deposits and withdrawals.
+func checkBalances(pre, post *snapshot, blk *types.BeaconBlock) error {
+	var deposited, withdrawn math.Gwei
+	for _, deposit := range blk.GetBody().GetDeposits() {
+		deposited += deposit.GetAmount()
+	}
+	for _, wd := range blk.GetBody().GetExecutionPayload().GetWithdrawals() {
+		withdrawn += wd.GetAmount()
+	}
+	if post.totalBalance != pre.totalBalance+deposited-withdrawn {
+		return errors.Wrapf(
+			ErrInvariantViolated,
+			"total balance went from %d to %d, deposited %d, withdrawn %d",
+			pre.totalBalance, post.totalBalance, deposited, withdrawn,
+		)
+	}
+	return nil
+}
+
+// checkWithdrawalIndex checks that the next withdrawal index advances by
+// the number of withdrawals of the block.
+func checkWithdrawalIndex(pre, post *snapshot, blk *types.BeaconBlock) error {
+	withdrawals := blk.GetBody().GetExecutionPayload().GetWithdrawals()
+	if expected := pre.nextWithdrawalIndex +
+		uint64(len(withdrawals)); post.nextWithdrawalIndex != expected {
+		return errors.Wrapf(
+			ErrInvariantViolated,
+			"next withdrawal index went from %d to %d over %d withdrawals",
+			pre.nextWithdrawalIndex, post.nextWithdrawalIndex,
+			len(withdrawals),
+		)
+	}
+	return nil
+}
+
+// checkWithdrawalSweep checks that the withdrawal sweep resumes after the
+// validator of the last withdrawal if the payload is full, and moves by
+// a whole sweep otherwise, as per the specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#new-process_withdrawals
+//
+//nolint:lll
+func checkWithdrawalSweep(
+	cs common.ChainSpec,
+	pre, post *snapshot,
+	blk *types.BeaconBlock,
+) error {
+	var (
+		withdrawals = blk.GetBody().GetExecutionPayload().GetWithdrawals()
+		// Withdrawals are processed before the deposits of the block, hence
+		// over the validators before it.
+		numValidators = math.ValidatorIndex(len(pre.pubkeys))
+		expected      math.ValidatorIndex
+	)
+	if uint64(len(withdrawals)) == cs.MaxWithdrawalsPerPayload() {
+		expected = (withdrawals[len(withdrawals)-1].GetValidatorIndex() + 1) %
+			numValidators
+	} else {
+		expected = (pre.nextWithdrawalValidator +
+			math.ValidatorIndex(cs.MaxValidatorsPerWithdrawalsSweep())) %
+			numValidators
+	}
+	if post.nextWithdrawalValidator != expected {
+		return errors.Wrapf(
+			ErrInvariantViolated,
+			"next withdrawal validator index %d, expected %d",
+			post.nextWithdrawalValidator, expected,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package statefuzz
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Mutation is a change to a valid block that makes it invalid.
+type Mutation uint8
+
+const (
+	// MutateSlot moves the block to the slot of its parent.
+	MutateSlot Mutation = iota
+	// MutateParentRoot corrupts the parent block root.
+	MutateParentRoot
+	// MutateProposer sets a proposer index no validator has.
+	MutateProposer
+	// MutateParentHash corrupts the parent hash of the payload.
+	MutateParentHash
+	// MutateTimestamp sets the timestamp of the payload to the one of its
+	// parent.
+	MutateTimestamp
+	// MutatePrevRandao corrupts the randao mix of the payload.
+	MutatePrevRandao
+	// MutateWithdrawals changes the amount of the first withdrawal.
+	MutateWithdrawals
+	// MutateDepositCount votes for fewer deposits than already processed.
+	MutateDepositCount
+	// MutateDepositRoot corrupts the deposit root voted for.
+	MutateDepositRoot
+	// MutateDeposits omits the pending deposits, or includes a processed
+	// one if none is pending.
+	MutateDeposits
+	// MutateDepositProof corrupts the proof of the first pending deposit,
+	// or includes a processed deposit if none is pending.
+	MutateDepositProof
+	// MutateBlobs includes more blob commitments than allowed.
+	MutateBlobs
+	// numMutations is the number of mutations.
+	numMutations
+)
+
+// mutationNames are the names of the mutations, by mutation.
+//
+//nolint:gochecknoglobals // read-only lookup table.
+var mutationNames = [numMutations]string{
+	"slot", "parent root", "proposer", "parent hash", "timestamp",
+	"prev randao", "withdrawals", "deposit count", "deposit root",
+	"deposits", "deposit proof", "blobs",
+}
+
+// String returns the name of the mutation.
+func (m Mutation) String() string {
+	if m >= numMutations {
+		return "unknown mutation"
+	}
+	return mutationNames[m]
+}
+
+// mutate applies the given mutation to the parts of a valid block.
+func (h *Harness) mutate(p *blockParts, m Mutation) error {
+	cs := h.cfg.ChainSpec
+	switch m {
+	case MutateSlot:
+		p.slot = p.parentSlot
+	case MutateParentRoot:
+		p.parentRoot[0] ^= 0xff
+	case MutateProposer:
+		numValidators, err := h.st.GetTotalValidators()
+		if err != nil {
+			return err
+		}
+		p.proposer = math.ValidatorIndex(numValidators)
+	case MutateParentHash:
+		p.payload.ParentHash[0] ^= 0xff
+	case MutateTimestamp:
+		p.payload.Timestamp--
+	case MutatePrevRandao:
+		p.payload.Random[0] ^= 0xff
+	case MutateWithdrawals:
+		// Every validator swept yields a withdrawal, hence there is at
+		// least one.
+		p.payload.Withdrawals[0].Amount++
+	case MutateDepositCount:
+		p.eth1Data.DepositCount = p.depositIndex - 1
+	case MutateDepositRoot:
+		p.eth1Data.DepositRoot[0] ^= 0xff
+	case MutateDeposits, MutateDepositProof:
+		if len(p.deposits) == 0 {
+			return h.includeProcessedDeposit(p)
+		}
+		if m == MutateDeposits {
+			p.deposits = nil
+			break
+		}
+		proof := p.deposits[0].GetProof()
+		proof[0][0] ^= 0xff
+		p.deposits[0].SetProof(proof)
+	case MutateBlobs:
+		p.commitments = make(
+			eip4844.KZGCommitments[common.ExecutionHash],
+			cs.MaxBlobsPerBlock()+1,
+		)
+	default:
+		return errors.Wrapf(ErrUnknownMutation, "%d", m)
+	}
+	return nil
+}
+
+// includeProcessedDeposit includes the last processed deposit in a block
+// whose deposits are all processed. The genesis deposits ensure there is
+// one.
+func (h *Harness) includeProcessedDeposit(p *blockParts) error {
+	deposits, _, _, err := h.deposits.GetDepositsWithProofs(
+		p.depositIndex-1, 1,
+	)
+	if err != nil {
+		return err
+	}
+	p.deposits = deposits
+	return nil
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package statefuzz_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/berachain/beacon-kit/testing/statefuzz"
+	"github.com/stretchr/testify/require"
+)
+
+// run applies the actions decoded from the given input to a new harness.
+func run(t *testing.T, data []byte) {
+	t.Helper()
+	cfg := statefuzz.DefaultConfig()
+	h, err := statefuzz.NewHarness(cfg)
+	require.NoError(t, err)
+	require.NoError(t, h.Run(statefuzz.DecodeActions(cfg, data)))
+}
+
+func FuzzStateTransition(f *testing.F) {
+	// Blocks only, then blocks after empty slots.
+	f.Add([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 2, 0, 1})
+	// Deposits creating and topping up validators over an epoch.
+	f.Add([]byte{
+		2, 4, 2, 0x35, 0, 0, 2, 0x25, 2, 0x11, 0, 0,
+		2, 0x34, 0, 1, 0, 0, 0, 0,
+	})
+	// Every mutation, interleaved with valid blocks and deposits.
+	f.Add([]byte{
+		1, 0, 2, 5, 1, 1, 0, 0, 1, 2, 1, 3, 1, 4, 1, 5, 1, 6, 1, 7,
+		2, 6, 1, 8, 1, 9, 2, 7, 1, 10, 1, 11, 0, 0,
+	})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		run(t, data)
+	})
+}
+
+func TestStateTransition_RandomSequences(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for range 8 {
+		data := make([]byte, 2*statefuzz.DefaultConfig().MaxActions)
+		_, err := rng.Read(data)
+		require.NoError(t, err)
+		run(t, data)
+	}
+}